@@ -0,0 +1,82 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+type (
+	// RelayerAllowlistKeeper defines the interface required by the relayer allowlist decorator.
+	RelayerAllowlistKeeper interface {
+		IsRelayerAllowed(ctx sdk.Context, relayer sdk.AccAddress) bool
+	}
+
+	// RelayerAllowlistDecorator defines an AnteHandler decorator that rejects the
+	// CCV packets, acknowledgements and timeouts submitted by relayers that are not
+	// in the relayer allowlist set by the provider chain.
+	RelayerAllowlistDecorator struct {
+		ConsumerKeeper RelayerAllowlistKeeper
+	}
+)
+
+func NewRelayerAllowlistDecorator(k RelayerAllowlistKeeper) RelayerAllowlistDecorator {
+	return RelayerAllowlistDecorator{
+		ConsumerKeeper: k,
+	}
+}
+
+func (rad RelayerAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := rad.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs returns an error if any of the given messages, including the messages
+// executed on behalf of other accounts via authz, relays a CCV packet and is signed
+// by a relayer that is not allowed
+func (rad RelayerAllowlistDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := rad.checkMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+			continue
+		}
+		signer, isCCV := ccvPacketSigner(msg)
+		if !isCCV {
+			continue
+		}
+		relayer, err := sdk.AccAddressFromBech32(signer)
+		if err != nil || !rad.ConsumerKeeper.IsRelayerAllowed(ctx, relayer) {
+			return fmt.Errorf("relayer %s is not allowed to relay CCV packets", signer)
+		}
+	}
+	return nil
+}
+
+// ccvPacketSigner returns the signer of the given message and true
+// if the message relays a packet of the CCV channel
+func ccvPacketSigner(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *channeltypes.MsgRecvPacket:
+		return msg.Signer, msg.Packet.DestinationPort == ccv.ConsumerPortID
+	case *channeltypes.MsgAcknowledgement:
+		return msg.Signer, msg.Packet.SourcePort == ccv.ConsumerPortID
+	case *channeltypes.MsgTimeout:
+		return msg.Signer, msg.Packet.SourcePort == ccv.ConsumerPortID
+	case *channeltypes.MsgTimeoutOnClose:
+		return msg.Signer, msg.Packet.SourcePort == ccv.ConsumerPortID
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,125 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/interchain-security/app/consumer/ante"
+	"github.com/cosmos/interchain-security/app/params"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+	"github.com/stretchr/testify/require"
+)
+
+type relayerAllowlistKeeper struct {
+	allowed sdk.AccAddress
+}
+
+func (k relayerAllowlistKeeper) IsRelayerAllowed(_ sdk.Context, relayer sdk.AccAddress) bool {
+	return k.allowed == nil || k.allowed.Equals(relayer)
+}
+
+func TestRelayerAllowlistDecorator(t *testing.T) {
+	txCfg := params.MakeTestEncodingConfig().TxConfig
+
+	allowed := sdk.AccAddress([]byte("allowed_relayer_____"))
+	other := sdk.AccAddress([]byte("other_relayer_______"))
+	ccvPacket := channeltypes.Packet{SourcePort: ccv.ConsumerPortID, DestinationPort: ccv.ConsumerPortID}
+	transferPacket := channeltypes.Packet{SourcePort: transfertypes.PortID, DestinationPort: transfertypes.PortID}
+
+	testCases := []struct {
+		name      string
+		allowlist sdk.AccAddress
+		msgs      []sdk.Msg
+		expectErr bool
+	}{
+		{
+			name:      "CCV packet relayed by allowed relayer",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: ccvPacket, Signer: allowed.String()}},
+			expectErr: false,
+		},
+		{
+			name:      "CCV packet relayed by other relayer",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: ccvPacket, Signer: other.String()}},
+			expectErr: true,
+		},
+		{
+			name:      "CCV acknowledgement relayed by other relayer",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&channeltypes.MsgAcknowledgement{Packet: ccvPacket, Signer: other.String()}},
+			expectErr: true,
+		},
+		{
+			name:      "CCV timeout relayed by other relayer",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&channeltypes.MsgTimeout{Packet: ccvPacket, Signer: other.String()}},
+			expectErr: true,
+		},
+		{
+			name:      "transfer packet relayed by other relayer",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: transferPacket, Signer: other.String()}},
+			expectErr: false,
+		},
+		{
+			name:      "CCV packet relayed by any relayer without allowlist",
+			allowlist: nil,
+			msgs:      []sdk.Msg{&channeltypes.MsgRecvPacket{Packet: ccvPacket, Signer: other.String()}},
+			expectErr: false,
+		},
+		{
+			name:      "CCV packet relayed by other relayer via authz",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{newMsgExec(t, allowed, &channeltypes.MsgRecvPacket{Packet: ccvPacket, Signer: other.String()})},
+			expectErr: true,
+		},
+		{
+			name:      "CCV packet relayed by other relayer via nested authz",
+			allowlist: allowed,
+			msgs: []sdk.Msg{newMsgExec(t, allowed, newMsgExec(t, allowed,
+				&channeltypes.MsgAcknowledgement{Packet: ccvPacket, Signer: other.String()}))},
+			expectErr: true,
+		},
+		{
+			name:      "CCV packet relayed by allowed relayer via authz",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{newMsgExec(t, other, &channeltypes.MsgRecvPacket{Packet: ccvPacket, Signer: allowed.String()})},
+			expectErr: false,
+		},
+		{
+			name:      "non IBC message",
+			allowlist: allowed,
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			handler := ante.NewRelayerAllowlistDecorator(relayerAllowlistKeeper{allowed: tc.allowlist})
+
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := handler.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, noOpAnteDecorator())
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newMsgExec(t *testing.T, grantee sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+	t.Helper()
+	msg := authz.NewMsgExec(grantee, msgs)
+	return &msg
+}
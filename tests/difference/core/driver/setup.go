@@ -468,8 +468,10 @@ func (b *Builder) configurePath() {
 	b.path = ibctesting.NewPath(b.consumer(), b.provider())
 	b.consumerEndpoint().ChannelConfig.PortID = ccv.ConsumerPortID
 	b.providerEndpoint().ChannelConfig.PortID = ccv.ProviderPortID
-	b.consumerEndpoint().ChannelConfig.Version = ccv.Version
-	b.providerEndpoint().ChannelConfig.Version = ccv.Version
+	// The model does not include GenesisAccepted packets, which are
+	// only sent over channels negotiated with the current CCV version.
+	b.consumerEndpoint().ChannelConfig.Version = ccv.Version1
+	b.providerEndpoint().ChannelConfig.Version = ccv.Version1
 	b.consumerEndpoint().ChannelConfig.Order = channeltypes.ORDERED
 	b.providerEndpoint().ChannelConfig.Order = channeltypes.ORDERED
 }
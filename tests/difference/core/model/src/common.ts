@@ -210,6 +210,7 @@ type ModelInitState = {
     initialHeight: number;
     vscID: number;
     vscIDtoH: Record<number, number>;
+    vscIDtoT: Record<number, number>;
     vscIDtoOpIDs: Map<number, number[]>;
     downtimeSlashAcks: number[];
     tombstoned: boolean[];
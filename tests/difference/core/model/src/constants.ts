@@ -59,6 +59,7 @@ const MODEL_INIT_STATE: ModelInitState = {
     vscID: 0,
     // See ccvC.hToVscID
     vscIDtoH: { [-1]: 0 },
+    vscIDtoT: {},
     vscIDtoOpIDs: new Map(),
     downtimeSlashAcks: [],
     tombstoned: [false, false, false, false],
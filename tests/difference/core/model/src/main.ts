@@ -10,6 +10,7 @@ import {
 } from './properties.js';
 import { Model } from './model.js';
 import {
+  meta,
   createSmallSubsetOfCoveringTraces,
   dumpTrace,
   forceMakeEmptyDir,
@@ -451,6 +452,31 @@ function replayFile(fn: string, ix: number, numActions: number) {
   replayActions(actions);
 }
 
+/**
+ * Replays the actions of every trace in a file against new model instances
+ * and writes the traces, with the resulting model states and events, to
+ * another file. This is useful to update the traces after a change to the
+ * model, while keeping their actions, and thus their coverage.
+ *
+ * @param inFn filename of the file containing the json traces
+ * @param outFn filename of the file to write the regenerated traces to
+ */
+function regenerateFile(inFn: string, outFn: string) {
+  const traces = JSON.parse(fs.readFileSync(inFn, 'utf8'));
+  const regenerated = traces.map((trace: { actions: TraceAction[] }) => {
+    const hist = new BlockHistory();
+    const events: Event[] = [];
+    const model = new Model(hist, events, cloneDeep(MODEL_INIT_STATE));
+    const actions = trace.actions.map((a, ix) => ({
+      ix,
+      action: a.action,
+      partialState: cloneDeep(doAction(model, a.action)),
+    }));
+    return { ...trace, meta, actions, events };
+  });
+  fs.writeFileSync(outFn, JSON.stringify(regenerated, null));
+}
+
 console.log(`running main`);
 
 /*
@@ -524,6 +550,19 @@ if (process.argv[2] === 'gen') {
   console.log(`replaying the actions of a trace against a new model instance`);
   const [fn, traceNum, numActions] = process.argv.slice(3, 6);
   replayFile(fn, parseInt(traceNum), parseInt(numActions));
+} else if (process.argv[2] === 'regen') {
+  /*
+   * Regenerate the traces of a file against the current model.
+   *
+   * The actions of the traces are kept, while the model states
+   * and events are recomputed.
+   *
+   * yarn start regen <input file> <output file>
+   */
+  console.log(`regen`);
+  console.log(`replaying the actions of all traces against new model instances`);
+  const [inFn, outFn] = process.argv.slice(3, 5);
+  regenerateFile(inFn, outFn);
 } else {
   console.log(`did not execute any function, please specify a function to run`);
 }
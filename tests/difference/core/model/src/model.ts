@@ -369,6 +369,9 @@ class CCVProvider {
   // map ids to height of sending
   // used to calculate infraction height from consumer initiated slashing
   vscIDtoH: Record<number, number>;
+  // map ids to timestamp of sending
+  // used to drop slash requests for infractions outside the unbonding window
+  vscIDtoT: Record<number, number>;
   // map ids to unbonding operation ids
   // used to mature unbonding operations when receiving maturity packets
   vscIDtoOpIDs: Map<number, number[]>;
@@ -389,6 +392,7 @@ class CCVProvider {
 
   endBlockCIS = () => {
     this.vscIDtoH[this.vscID] = this.m.h[P] + 1;
+    this.vscIDtoT[this.vscID] = this.m.t[P];
     this.processPackets();
   };
 
@@ -529,6 +533,16 @@ class CCVProvider {
       return;
     }
 
+    // drop slash requests for infractions outside the unbonding window,
+    // the slash ack is still sent to the consumer
+    if (
+      data.vscID in this.vscIDtoT &&
+      this.m.t[P] >= this.vscIDtoT[data.vscID] + UNBONDING_SECONDS_P
+    ) {
+      this.downtimeSlashAcks.push(data.val);
+      return;
+    }
+
     // jail validator
     this.m.staking.jailUntil(data.val, this.m.t[P] + JAIL_SECONDS);
     // update slash acks
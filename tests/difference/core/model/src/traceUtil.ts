@@ -226,6 +226,7 @@ function logEventData(allEvents: Event[]) {
 }
 
 export {
+  meta,
   createSmallSubsetOfCoveringTraces,
   dumpTrace,
   forceMakeEmptyDir,
@@ -229,6 +229,7 @@ func (tr TestRun) submitConsumerAdditionProposal(
 		CcvTimeoutPeriod:                  params.CcvTimeoutPeriod,
 		TransferTimeoutPeriod:             params.TransferTimeoutPeriod,
 		UnbondingPeriod:                   params.UnbondingPeriod,
+		ConsumerNativeUnbondingPeriod:     params.UnbondingPeriod,
 		Deposit:                           fmt.Sprint(action.deposit) + `stake`,
 	}
 
@@ -1,5 +1,15 @@
 package integration
 
+import (
+	"strconv"
+	"time"
+
+	ibctesting "github.com/cosmos/interchain-security/legacy_ibc_testing/testing"
+	keepertestutil "github.com/cosmos/interchain-security/testutil/keeper"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
 // TestInitTimeout tests the init timeout
 func (suite *CCVTestSuite) TestInitTimeout() {
 	testCases := []struct {
@@ -94,3 +104,66 @@ func (suite *CCVTestSuite) TestInitTimeout() {
 		}
 	}
 }
+
+// TestConsumerSpawnAcrossBlocks tests that pending consumer addition proposals
+// are executed in the BeginBlock of the first block whose time reaches their spawn time,
+// and that every block processing proposals emits a summary event.
+func (suite *CCVTestSuite) TestConsumerSpawnAcrossBlocks() {
+	providerKeeper := suite.providerApp.GetProviderKeeper()
+	now := suite.providerCtx().BlockTime()
+
+	// queue one proposal per block, with the spawn times a block apart
+	chainIDs := []string{"chain-a", "chain-b", "chain-c"}
+	for i, chainID := range chainIDs {
+		prop := keepertestutil.GetTestConsumerAdditionProp()
+		prop.ChainId = chainID
+		prop.SpawnTime = now.Add(time.Duration(i+1) * ibctesting.TimeIncrement)
+		err := providerKeeper.HandleConsumerAdditionProposal(suite.providerCtx(), prop)
+		suite.Require().NoError(err)
+	}
+
+	for i, chainID := range chainIDs {
+		beginBlockRes := suite.nextProviderBlockWithTimeIncrement()
+
+		// check that the proposals are spawned one by one
+		for j, otherChainID := range chainIDs {
+			_, found := providerKeeper.GetConsumerClientId(suite.providerCtx(), otherChainID)
+			suite.Require().Equal(j <= i, found, "unexpected client of %s after spawning %s", otherChainID, chainID)
+		}
+		suite.Require().Len(providerKeeper.GetAllPendingConsumerAdditionProps(suite.providerCtx()), len(chainIDs)-i-1)
+
+		// check the summary event
+		var summary *abci.Event
+		for _, event := range beginBlockRes.Events {
+			if event.Type == ccv.EventTypeConsumerSpawnSummary {
+				event := event
+				summary = &event
+			}
+		}
+		suite.Require().NotNil(summary)
+		attributes := map[string]string{}
+		for _, attr := range summary.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+		suite.Require().Equal("1", attributes[ccv.AttributeSpawned])
+		suite.Require().Equal("0", attributes[ccv.AttributeFailed])
+		suite.Require().Equal(strconv.Itoa(len(chainIDs)-i-1), attributes[ccv.AttributeRemaining])
+	}
+
+	// no summary event is emitted once there are no proposals left to execute
+	beginBlockRes := suite.nextProviderBlockWithTimeIncrement()
+	for _, event := range beginBlockRes.Events {
+		suite.Require().NotEqual(ccv.EventTypeConsumerSpawnSummary, event.Type)
+	}
+}
+
+// nextProviderBlockWithTimeIncrement increments the time by ibctesting.TimeIncrement
+// and commits a provider block, returning the BeginBlock response of the next block.
+// Note that the coordinator's IncrementTime discards the BeginBlock response.
+func (suite *CCVTestSuite) nextProviderBlockWithTimeIncrement() abci.ResponseBeginBlock {
+	suite.coordinator.CurrentTime = suite.coordinator.CurrentTime.Add(ibctesting.TimeIncrement).UTC()
+	suite.providerChain.CurrentHeader.Time = suite.coordinator.CurrentTime
+	_, _, beginBlockRes := suite.providerChain.NextBlock()
+	suite.coordinator.UpdateTimeForChain(suite.consumerChain)
+	return beginBlockRes
+}
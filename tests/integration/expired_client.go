@@ -10,6 +10,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/interchain-security/legacy_ibc_testing/testing"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -75,8 +76,8 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 3)
 	// - increment time so that the unbonding period ends on the consumer
 	incrementTimeByUnbondingPeriod(s, Consumer)
-	// - relay all VSCMatured packet from consumer to provider
-	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 3)
+	// - relay the GenesisAccepted packet and all VSCMatured packet from consumer to provider
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 4)
 }
 
 // TestConsumerPacketSendExpiredClient tests the consumer sending packets when the provider client is expired.
@@ -108,6 +109,9 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	// relay all VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 2)
 
+	// relay the GenesisAccepted packet from consumer to provider
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+
 	// expire client to provider
 	expireClient(s, Provider)
 
@@ -165,6 +169,42 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
 }
 
+// TestResetConsumerClientProposalRecoversExpiredClient tests that a ResetConsumerClientProposal
+// recovers an expired consumer client via a substitute client, such that the consumer chain
+// keeps its client ID and CCV channel, and the pending VSC packets are sent again.
+func (s *CCVTestSuite) TestResetConsumerClientProposalRecoversExpiredClient() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+
+	s.SetupCCVChannel(s.path)
+
+	expireClient(s, Consumer)
+
+	// bond some tokens on provider to change validator powers
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), sdk.NewInt(1000000))
+	s.providerChain.NextBlock()
+	s.Require().Len(providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.consumerChain.ChainID), 1)
+
+	// reset the consumer client from a recent consensus state of the consumer chain
+	s.consumerChain.NextBlock()
+	header := s.consumerChain.LastHeader
+	prop := providertypes.NewResetConsumerClientProposal(ibctesting.Title, ibctesting.Description,
+		s.consumerChain.ChainID, header.GetHeight().(clienttypes.Height), header.ConsensusState(),
+	).(*providertypes.ResetConsumerClientProposal)
+	err := providerKeeper.HandleResetConsumerClientProposal(s.providerCtx(), prop)
+	s.Require().NoError(err)
+
+	// the consumer client is active again, under the same client ID
+	clientID, found := providerKeeper.GetConsumerClientId(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointB.ClientID, clientID)
+	checkClientExpired(s, Consumer, false)
+
+	// the pending VSC packet is sent over the existing CCV channel and relayed to the consumer
+	s.providerChain.NextBlock()
+	s.Require().Empty(providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.consumerChain.ChainID))
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+}
+
 // expireClient expires the client to the `clientTo` chain
 func expireClient(s *CCVTestSuite, clientTo ChainType) {
 	var hostEndpoint *ibctesting.Endpoint
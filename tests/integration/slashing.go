@@ -313,12 +313,11 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	// sync contexts block height
 	ctx := suite.providerCtx()
 
-	// Expect panic if ccv channel is not established via dest channel of packet
-	suite.Panics(func() {
-		providerKeeper.OnRecvSlashPacket(ctx, channeltypes.Packet{}, ccv.SlashPacketData{})
-	})
+	// Expect an error ack if ccv channel is not established via dest channel of packet
+	errAck := providerKeeper.OnRecvSlashPacket(ctx, channeltypes.Packet{}, ccv.SlashPacketData{})
+	suite.Require().Equal(ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorUnknownChannel), errAck)
 
-	// Add correct channelID to packet. Now we will not panic anymore.
+	// Add correct channelID to packet.
 	packet := channeltypes.Packet{DestinationChannel: firstBundle.Path.EndpointB.ChannelID}
 
 	// Init chain height is set by established CCV channel
@@ -328,11 +327,9 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	providerKeeper.DeleteInitChainHeight(ctx, consumerChainID)
 
 	packetData := ccv.SlashPacketData{ValsetUpdateId: 0}
-	errAck := providerKeeper.OnRecvSlashPacket(ctx, packet, packetData)
+	errAck = providerKeeper.OnRecvSlashPacket(ctx, packet, packetData)
 	suite.Require().False(errAck.Success())
-	errAckCast := errAck.(channeltypes.Acknowledgement)
-	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorStaleInfraction), errAck)
 
 	// Restore init chain height
 	providerKeeper.SetInitChainHeight(ctx, consumerChainID, initChainHeight)
@@ -341,9 +338,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	packetData.Infraction = stakingtypes.InfractionEmpty
 	errAck = providerKeeper.OnRecvSlashPacket(ctx, packet, packetData)
 	suite.Require().False(errAck.Success())
-	errAckCast = errAck.(channeltypes.Acknowledgement)
-	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidInfraction), errAck)
 
 	// save current VSC ID
 	vscID := providerKeeper.GetValidatorSetUpdateId(ctx)
@@ -357,9 +352,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	// expect an error if mapped block height is not found
 	errAck = providerKeeper.OnRecvSlashPacket(ctx, packet, packetData)
 	suite.Require().False(errAck.Success())
-	errAckCast = errAck.(channeltypes.Acknowledgement)
-	// TODO: see if there's a way to get error reason like before
-	suite.Require().Equal("ABCI code: 1: error handling packet: see events for details", errAckCast.GetError())
+	suite.Require().Equal(ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorStaleInfraction), errAck)
 
 	// construct slashing packet with non existing validator
 	slashingPkt := ccv.NewSlashPacketData(
@@ -633,10 +626,11 @@ func (suite *CCVTestSuite) TestQueueAndSendSlashPacket() {
 	}
 
 	// verify that all requests are stored except for
-	// the downtime slash request duplicates
+	// the downtime slash request duplicates, after
+	// the GenesisAccepted packet queued in InitGenesis
 	dataPackets := consumerKeeper.GetPendingPackets(ctx)
 	suite.Require().NotEmpty(dataPackets)
-	suite.Require().Len(dataPackets.GetList(), 12)
+	suite.Require().Len(dataPackets.GetList(), 13)
 
 	// save consumer next sequence
 	seq, _ := consumerIBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, ccv.ConsumerPortID, channelID)
@@ -645,7 +639,7 @@ func (suite *CCVTestSuite) TestQueueAndSendSlashPacket() {
 	suite.SendEmptyVSCPacket()
 
 	// check that each pending data packet is sent once
-	for i := 0; i < 12; i++ {
+	for i := 0; i < 13; i++ {
 		commit := consumerIBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, ccv.ConsumerPortID, channelID, seq+uint64(i))
 		suite.Require().NotNil(commit)
 	}
@@ -657,7 +651,8 @@ func (suite *CCVTestSuite) TestQueueAndSendSlashPacket() {
 		suite.Require().True(consumerKeeper.OutstandingDowntime(ctx, consAddr))
 	}
 
-	// send all pending packets - only slash packets should be queued in this test
+	// send all pending packets - only slash packets should be queued in this test,
+	// besides the GenesisAccepted packet
 	consumerKeeper.SendPackets(ctx)
 
 	// check that pending data packets got cleared
@@ -672,25 +667,26 @@ func (suite *CCVTestSuite) TestQueueAndSendSlashPacket() {
 func (suite *CCVTestSuite) TestCISBeforeCCVEstablished() {
 	consumerKeeper := suite.consumerApp.GetConsumerKeeper()
 
-	// Check pending packets is empty
+	// Check only the GenesisAccepted packet queued in InitGenesis is pending
 	pendingPackets := consumerKeeper.GetPendingPackets(suite.consumerCtx())
-	suite.Require().Len(pendingPackets.List, 0)
+	suite.Require().Len(pendingPackets.List, 1)
+	suite.Require().Equal(ccv.GenesisAcceptedPacket, pendingPackets.List[0].Type)
 
 	consumerKeeper.Slash(suite.consumerCtx(), []byte{0x01, 0x02, 0x3},
 		66, 4324, sdk.MustNewDecFromStr("0.05"), stakingtypes.Downtime)
 
 	// Check slash packet was queued
 	pendingPackets = consumerKeeper.GetPendingPackets(suite.consumerCtx())
-	suite.Require().Len(pendingPackets.List, 1)
+	suite.Require().Len(pendingPackets.List, 2)
 
 	// Pass 5 blocks, confirming the consumer doesn't panic
 	for i := 0; i < 5; i++ {
 		suite.consumerChain.NextBlock()
 	}
 
-	// Check packet is still queued
+	// Check packets are still queued
 	pendingPackets = consumerKeeper.GetPendingPackets(suite.consumerCtx())
-	suite.Require().Len(pendingPackets.List, 1)
+	suite.Require().Len(pendingPackets.List, 2)
 
 	// establish ccv channel
 	suite.SetupCCVChannel(suite.path)
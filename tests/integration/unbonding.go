@@ -18,8 +18,8 @@ func (s *CCVTestSuite) TestUndelegationNormalOperation() {
 		relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, expectedPackets)
 		// increment time so that the unbonding period ends on the consumer
 		incrementTimeByUnbondingPeriod(s, Consumer)
-		// relay 1 VSCMatured packet from consumer to provider
-		relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, expectedPackets)
+		// relay 1 VSCMatured packet and the GenesisAccepted packet from consumer to provider
+		relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, expectedPackets+1)
 	}
 
 	testCases := []struct {
@@ -271,8 +271,8 @@ func (s *CCVTestSuite) TestUndelegationDuringInit() {
 			// increment time so that the unbonding period ends on the consumer
 			incrementTimeByUnbondingPeriod(s, Consumer)
 
-			// relay VSCMatured packets from consumer to provider
-			relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 2)
+			// relay VSCMatured packets and the GenesisAccepted packet from consumer to provider
+			relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 3)
 
 			// check that the unbonding operation completed
 			// - check that ccv unbonding op has been deleted
@@ -430,6 +430,10 @@ func (s *CCVTestSuite) TestRedelegationProviderFirst() {
 	relayAllCommittedPackets(s, s.providerChain, s.path,
 		ccv.ProviderPortID, s.path.EndpointB.ChannelID, 2)
 
+	// Relay the GenesisAccepted packet from consumer to provider before it times out
+	relayAllCommittedPackets(s, s.consumerChain,
+		s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+
 	// Increment time so that the unbonding period ends on the provider
 	incrementTimeByUnbondingPeriod(s, Provider)
 
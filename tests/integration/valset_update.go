@@ -30,8 +30,109 @@ func (s *CCVTestSuite) TestPacketRoundtrip() {
 	// Increment time so that the unbonding period ends on the provider
 	incrementTimeByUnbondingPeriod(s, Provider)
 
-	// Relay 1 VSCMatured packet from consumer to provider
+	// Relay 1 GenesisAccepted packet and 1 VSCMatured packet from consumer to provider
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 2)
+}
+
+// TestVscMaturityTime tests that the provider tags VSC packets with their maturity time
+// and that the consumer chain does not notify maturity before that time
+func (s *CCVTestSuite) TestVscMaturityTime() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+
+	s.SetupCCVChannel(s.path)
+	s.SetupTransferChannel()
+
+	// Bond some tokens on provider to change validator powers
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), sdk.NewInt(1000000))
+
+	// Send CCV packet to consumer
+	s.providerChain.NextBlock()
+
+	sendTimestamps := providerKeeper.GetAllVscSendTimestamps(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().Len(sendTimestamps, 1)
+	vscID := sendTimestamps[0].VscId
+
+	// the maturity time is the send time plus the consumer unbonding period
+	consumerGenesis, found := providerKeeper.GetConsumerGenesis(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found)
+	maturityTime, found := providerKeeper.GetVscMaturityTime(s.providerCtx(), s.consumerChain.ChainID, vscID)
+	s.Require().True(found)
+	s.Require().Equal(sendTimestamps[0].Timestamp.Add(consumerGenesis.Params.UnbondingPeriod), maturityTime)
+
+	// Relay 1 VSC packet from provider to consumer
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+
+	packetMaturities := consumerKeeper.GetAllPacketMaturityTimes(s.consumerCtx())
+	s.Require().Len(packetMaturities, 1)
+	s.Require().Equal(vscID, packetMaturities[0].VscId)
+	s.Require().False(packetMaturities[0].MaturityTime.Before(maturityTime))
+
+	// Increment time so that the unbonding period ends on the consumer
+	incrementTimeByUnbondingPeriod(s, Consumer)
+
+	// Relay 1 GenesisAccepted packet and 1 VSCMatured packet from consumer to provider
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 2)
+
+	_, found = providerKeeper.GetVscMaturityTime(s.providerCtx(), s.consumerChain.ChainID, vscID)
+	s.Require().False(found)
+}
+
+// TestGenesisAcceptedPacketRoundtrip tests that the consumer chain confirms
+// to the provider chain the genesis state it started with
+func (s *CCVTestSuite) TestGenesisAcceptedPacketRoundtrip() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+
+	s.SetupCCVChannel(s.path)
+
+	consumerGenesis, found := providerKeeper.GetConsumerGenesis(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found)
+	expectedHash, err := consumerGenesis.CanonicalHash()
+	s.Require().NoError(err)
+
+	// the GenesisAccepted packet is queued in the consumer InitGenesis and
+	// sent once the consumer chain receives the first VSC packet
+	s.SendEmptyVSCPacket()
+	s.consumerChain.NextBlock()
+
+	// Relay 1 GenesisAccepted packet from consumer to provider
 	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+
+	acceptedHash, found := providerKeeper.GetConsumerAcceptedGenesisHash(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(expectedHash, acceptedHash)
+}
+
+// TestGenesisAcceptedPacketNotSentOverVersion1Channel tests that the consumer chain
+// does not send the GenesisAccepted packet over a CCV channel negotiated with ccv.Version1,
+// i.e., to a provider chain that does not support it
+func (s *CCVTestSuite) TestGenesisAcceptedPacketNotSentOverVersion1Channel() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+
+	s.path.EndpointA.ChannelConfig.Version = ccv.Version1
+	s.path.EndpointB.ChannelConfig.Version = ccv.Version1
+	s.SetupCCVChannel(s.path)
+
+	version, found := consumerKeeper.GetProviderChannelVersion(s.consumerCtx())
+	s.Require().False(found, "provider channel is set only once the first VSC packet is received")
+	s.Require().Empty(version)
+
+	s.SendEmptyVSCPacket()
+	s.consumerChain.NextBlock()
+
+	version, found = consumerKeeper.GetProviderChannelVersion(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(ccv.Version1, version)
+
+	// the GenesisAccepted packet is dropped instead of being sent
+	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()).List)
+	commitments := s.consumerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.consumerCtx(), ccv.ConsumerPortID, s.path.EndpointA.ChannelID)
+	s.Require().Empty(commitments)
+
+	_, found = providerKeeper.GetConsumerAcceptedGenesisHash(s.providerCtx(), s.consumerChain.ChainID)
+	s.Require().False(found)
 }
 
 // TestQueueAndSendVSCMaturedPackets tests the behavior of EndBlock QueueVSCMaturedPackets call
@@ -139,7 +240,9 @@ func (suite *CCVTestSuite) TestQueueAndSendVSCMaturedPackets() {
 		ccv.ConsumerPortID,
 		suite.path.EndpointA.ChannelID,
 	)
-	suite.Require().Equal(2, len(commitments), "did not find packet commitments")
-	suite.Require().Equal(uint64(1), commitments[0].Sequence, "did not send VSCMatured packet for VSC packet 1")
-	suite.Require().Equal(uint64(2), commitments[1].Sequence, "did not send VSCMatured packet for VSC packet 2")
+	// the first packet is the GenesisAccepted packet queued in InitGenesis
+	suite.Require().Equal(3, len(commitments), "did not find packet commitments")
+	suite.Require().Equal(uint64(1), commitments[0].Sequence, "did not send GenesisAccepted packet")
+	suite.Require().Equal(uint64(2), commitments[1].Sequence, "did not send VSCMatured packet for VSC packet 1")
+	suite.Require().Equal(uint64(3), commitments[2].Sequence, "did not send VSCMatured packet for VSC packet 2")
 }
@@ -57,6 +57,10 @@ func TestInitTimeout(t *testing.T) {
 	runCCVTestByName(t, "TestInitTimeout")
 }
 
+func TestConsumerSpawnAcrossBlocks(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerSpawnAcrossBlocks")
+}
+
 //
 // Consumer democracy tests
 //
@@ -97,6 +101,10 @@ func TestConsumerPacketSendExpiredClient(t *testing.T) {
 	runCCVTestByName(t, "TestConsumerPacketSendExpiredClient")
 }
 
+func TestResetConsumerClientProposalRecoversExpiredClient(t *testing.T) {
+	runCCVTestByName(t, "TestResetConsumerClientProposalRecoversExpiredClient")
+}
+
 //
 // Normal operations tests
 //
@@ -10,6 +10,7 @@ import (
 	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	"github.com/golang/mock/gomock"
 
@@ -50,13 +51,23 @@ func GetMocksForCreateConsumerClient(ctx sdk.Context, mocks *MockedKeepers,
 func GetMocksForMakeConsumerGenesis(ctx sdk.Context, mocks *MockedKeepers,
 	unbondingTimeToInject time.Duration,
 ) []*gomock.Call {
+	// a consumer chain cannot start with an empty validator set,
+	// thus a single bonded validator is injected
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+
 	return []*gomock.Call{
 		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTimeToInject).Times(1),
 
 		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
 			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{}, nil).Times(1),
 
-		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).Times(1),
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				cb(validator.SDKValOpAddress(), 1)
+			}).Times(1),
+
+		mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), validator.SDKValOpAddress()).Return(
+			validator.SDKStakingValidator(), true).Times(1),
 	}
 }
 
@@ -95,7 +106,7 @@ func GetMocksForStopConsumerChain(ctx sdk.Context, mocks *MockedKeepers) []*gomo
 
 func GetMocksForHandleSlashPacket(ctx sdk.Context, mocks MockedKeepers,
 	expectedProviderValConsAddr providertypes.ProviderConsAddress,
-	valToReturn stakingtypes.Validator, expectJailing bool,
+	valToReturn stakingtypes.Validator, expectJailing, expectSlashing bool,
 ) []*gomock.Call {
 	// These first two calls are always made.
 	calls := []*gomock.Call{
@@ -108,6 +119,14 @@ func GetMocksForHandleSlashPacket(ctx sdk.Context, mocks MockedKeepers,
 			expectedProviderValConsAddr.ToSdkConsAddr()).Return(false).Times(1),
 	}
 
+	// Slash is only called if the consumer chain opted into slashing for downtime.
+	if expectSlashing {
+		calls = append(calls, mocks.MockSlashingKeeper.EXPECT().SlashFractionDowntime(ctx).Return(sdk.NewDecWithPrec(1, 4)).Times(1))
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().Slash(ctx,
+			expectedProviderValConsAddr.ToSdkConsAddr(), gomock.Any(), gomock.Any(),
+			sdk.NewDecWithPrec(1, 4), stakingtypes.Downtime).Times(1))
+	}
+
 	if expectJailing {
 		calls = append(calls, mocks.MockStakingKeeper.EXPECT().Jail(
 			gomock.Eq(ctx),
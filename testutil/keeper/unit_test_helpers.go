@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	consumerkeeper "github.com/cosmos/interchain-security/x/ccv/consumer/keeper"
@@ -85,6 +86,7 @@ type MockedKeepers struct {
 	*MockSlashingKeeper
 	*MockAccountKeeper
 	*MockBankKeeper
+	*MockDistributionKeeper
 	*MockIBCTransferKeeper
 	*MockIBCCoreKeeper
 	*MockEvidenceKeeper
@@ -93,18 +95,19 @@ type MockedKeepers struct {
 // NewMockedKeepers instantiates a struct with pointers to properly instantiated mocked keepers.
 func NewMockedKeepers(ctrl *gomock.Controller) MockedKeepers {
 	return MockedKeepers{
-		MockScopedKeeper:      NewMockScopedKeeper(ctrl),
-		MockChannelKeeper:     NewMockChannelKeeper(ctrl),
-		MockPortKeeper:        NewMockPortKeeper(ctrl),
-		MockConnectionKeeper:  NewMockConnectionKeeper(ctrl),
-		MockClientKeeper:      NewMockClientKeeper(ctrl),
-		MockStakingKeeper:     NewMockStakingKeeper(ctrl),
-		MockSlashingKeeper:    NewMockSlashingKeeper(ctrl),
-		MockAccountKeeper:     NewMockAccountKeeper(ctrl),
-		MockBankKeeper:        NewMockBankKeeper(ctrl),
-		MockIBCTransferKeeper: NewMockIBCTransferKeeper(ctrl),
-		MockIBCCoreKeeper:     NewMockIBCCoreKeeper(ctrl),
-		MockEvidenceKeeper:    NewMockEvidenceKeeper(ctrl),
+		MockScopedKeeper:       NewMockScopedKeeper(ctrl),
+		MockChannelKeeper:      NewMockChannelKeeper(ctrl),
+		MockPortKeeper:         NewMockPortKeeper(ctrl),
+		MockConnectionKeeper:   NewMockConnectionKeeper(ctrl),
+		MockClientKeeper:       NewMockClientKeeper(ctrl),
+		MockStakingKeeper:      NewMockStakingKeeper(ctrl),
+		MockSlashingKeeper:     NewMockSlashingKeeper(ctrl),
+		MockAccountKeeper:      NewMockAccountKeeper(ctrl),
+		MockBankKeeper:         NewMockBankKeeper(ctrl),
+		MockDistributionKeeper: NewMockDistributionKeeper(ctrl),
+		MockIBCTransferKeeper:  NewMockIBCTransferKeeper(ctrl),
+		MockIBCCoreKeeper:      NewMockIBCCoreKeeper(ctrl),
+		MockEvidenceKeeper:     NewMockEvidenceKeeper(ctrl),
 	}
 }
 
@@ -123,7 +126,10 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		mocks.MockSlashingKeeper,
 		mocks.MockAccountKeeper,
 		mocks.MockEvidenceKeeper,
+		mocks.MockBankKeeper,
+		mocks.MockDistributionKeeper,
 		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -238,6 +244,7 @@ func GetTestConsumerAdditionProp() *providertypes.ConsumerAdditionProposal {
 		consumertypes.DefaultTransferTimeoutPeriod,
 		consumertypes.DefaultConsumerUnbondingPeriod,
 	).(*providertypes.ConsumerAdditionProposal)
+	prop.ConsumerNativeUnbondingPeriod = consumertypes.DefaultConsumerUnbondingPeriod
 
 	return prop
 }
@@ -32,6 +32,8 @@ func (am AppModule) OnChanOpenInit(
 ) (string, error) {
 	// set to the default version if the provided version is empty according to the ICS26 spec
 	// https://github.com/cosmos/ibc/blob/main/spec/core/ics-026-routing-module/README.md#technical-specification
+	// NOTE that providers that were not upgraded only accept the previous versions,
+	// i.e., types.Version2 or types.Version1, which must then be explicitly provided by the relayer
 	if strings.TrimSpace(version) == "" {
 		version = types.Version
 	}
@@ -66,6 +68,10 @@ func (am AppModule) OnChanOpenInit(
 		return "", err
 	}
 
+	if err := am.keeper.VerifyExpectedCCVIdentifiers(ctx, connectionHops, channelID); err != nil {
+		return "", err
+	}
+
 	return version, nil
 }
 
@@ -88,9 +94,9 @@ func validateCCVChannelParams(
 		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
 	}
 
-	// the version must match the expected version
-	if version != types.Version {
-		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	// the version must be supported
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s, %s or %s", version, types.Version, types.Version2, types.Version1)
 	}
 	return nil
 }
@@ -129,9 +135,9 @@ func (am AppModule) OnChanOpenAck(
 			"error unmarshalling ibc-ack metadata: \n%v; \nmetadata: %v", err, counterpartyMetadata)
 	}
 
-	if md.Version != types.Version {
+	if !types.IsSupportedVersion(md.Version) {
 		return sdkerrors.Wrapf(types.ErrInvalidVersion,
-			"invalid counterparty version: %s, expected %s", md.Version, types.Version)
+			"invalid counterparty version: %s, expected %s, %s or %s", md.Version, types.Version, types.Version2, types.Version1)
 	}
 
 	am.keeper.SetProviderFeePoolAddrStr(ctx, md.ProviderFeePoolAddr)
@@ -228,14 +234,18 @@ func (am AppModule) OnRecvPacket(
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	var (
-		ack  ibcexported.Acknowledgement
-		data types.ValidatorSetChangePacketData
+		ack   ibcexported.Acknowledgement
+		data  types.ValidatorSetChangePacketData
+		chunk types.ValidatorSetChangePacketChunkData
 	)
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		ack = am.keeper.OnRecvVSCPacket(ctx, packet, data)
+	} else if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &chunk); err == nil {
+		// large validator set changes are split into chunks over CCV channels negotiated with version 3
+		ack = am.keeper.OnRecvVSCPacketChunk(ctx, packet, chunk)
+	} else {
 		errAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal CCV packet data"))
 		ack = &errAck
-	} else {
-		ack = am.keeper.OnRecvVSCPacket(ctx, packet, data)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -67,10 +67,36 @@ func TestOnChanOpenInit(t *testing.T) {
 				)
 			}, true,
 		},
+		{
+			"should succeed with the previous CCV version", func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				params.version = ccv.Version2
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
+		{
+			"should succeed with the initial CCV version", func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				params.version = ccv.Version1
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
 		{
 			"invalid non-empty IBC module version",
 			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
-				params.version = "2"
+				params.version = "4"
 			}, false,
 		},
 		{
@@ -116,6 +142,49 @@ func TestOnChanOpenInit(t *testing.T) {
 				)
 			}, false,
 		},
+		{
+			"success with pinned CCV identifiers",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetExpectedCCVConnectionID(params.ctx, "connectionIDToProvider")
+				keeper.SetExpectedCCVChannelID(params.ctx, "consumerChannelID")
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
+		{
+			"invalid: unexpected CCV connection ID",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetExpectedCCVConnectionID(params.ctx, "connection-0")
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, false,
+		},
+		{
+			"invalid: unexpected CCV channel ID",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetExpectedCCVChannelID(params.ctx, "channel-0")
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -141,6 +210,10 @@ func TestOnChanOpenInit(t *testing.T) {
 		}
 
 		tc.setup(&consumerKeeper, &params, mocks)
+		expVersion := params.version
+		if expVersion == "" {
+			expVersion = ccv.Version
+		}
 
 		version, err := consumerModule.OnChanOpenInit(
 			params.ctx,
@@ -155,7 +228,7 @@ func TestOnChanOpenInit(t *testing.T) {
 
 		if tc.expPass {
 			// assert correct version
-			require.Equal(t, ccv.Version, version)
+			require.Equal(t, expVersion, version)
 			require.NoError(t, err)
 		} else {
 			require.Error(t, err)
@@ -67,6 +67,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *consumertypes.GenesisState)
 		// set default value for valset update ID
 		k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
 
+		// confirm to the provider the genesis state the chain started with,
+		// the packet is sent once the CCV channel is established
+		k.QueueGenesisAcceptedPacket(ctx, *state)
+
 	} else {
 		// chain restarts with the CCV channel established
 		if state.ProviderChannelId != "" {
@@ -102,6 +106,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *consumertypes.GenesisState)
 		k.SetProviderClientID(ctx, state.ProviderClientId)
 	}
 
+	// set the CCV identifiers pinned by the provider chain, if any
+	if state.CcvConnectionId != "" {
+		k.SetExpectedCCVConnectionID(ctx, state.CcvConnectionId)
+	}
+	if state.CcvChannelId != "" {
+		k.SetExpectedCCVChannelID(ctx, state.CcvChannelId)
+	}
+
+	// set the relayers allowed to relay CCV packets, if restricted by the provider chain
+	if len(state.RelayerAllowlist) > 0 {
+		if err := k.SetRelayerAllowlist(ctx, state.RelayerAllowlist); err != nil {
+			panic(err)
+		}
+	}
+
 	if state.PreCCV {
 		return []abci.ValidatorUpdate{}
 	}
@@ -161,7 +180,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *consumertypes.GenesisSt
 			consumertypes.LastTransmissionBlockHeight{},
 			params,
 		)
+		// the CCV identifiers pinned by the provider chain are still expected by the handshake
+		genesis.CcvConnectionId = k.GetExpectedCCVConnectionID(ctx)
+		genesis.CcvChannelId = k.GetExpectedCCVChannelID(ctx)
 	}
+	genesis.RelayerAllowlist = k.GetRelayerAllowlist(ctx)
 
 	return genesis
 }
@@ -97,6 +97,17 @@ func TestInitGenesis(t *testing.T) {
 
 	// define three test cases which respectively create a genesis struct, use it to call InitGenesis
 	// and finally check that the genesis states are successfully imported in the consumer keeper stores
+	// the provider chain pinned the identifiers of the CCV connection and channel
+	newChainGenesis := consumertypes.NewInitialGenesisState(
+		provClientState,
+		provConsState,
+		valset,
+		params,
+	)
+	newChainGenesis.CcvConnectionId = "connection-0"
+	newChainGenesis.CcvChannelId = "channel-0"
+	newChainGenesis.RelayerAllowlist = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+
 	testCases := []struct {
 		name         string
 		malleate     func(sdk.Context, testkeeper.MockedKeepers)
@@ -112,20 +123,26 @@ func TestInitGenesis(t *testing.T) {
 					testkeeper.ExpectGetCapabilityMock(ctx, mocks, 1),
 				)
 			},
-			consumertypes.NewInitialGenesisState(
-				provClientState,
-				provConsState,
-				valset,
-				params,
-			),
+			newChainGenesis,
 			func(ctx sdk.Context, ck consumerkeeper.Keeper, gs *consumertypes.GenesisState) {
 				assertConsumerPortIsBound(t, ctx, &ck)
 
 				assertProviderClientID(t, ctx, &ck, provClientID)
+				require.Equal(t, "connection-0", ck.GetExpectedCCVConnectionID(ctx))
+				require.Equal(t, "channel-0", ck.GetExpectedCCVChannelID(ctx))
+				require.Equal(t, gs.RelayerAllowlist, ck.GetRelayerAllowlist(ctx))
 				assertHeightValsetUpdateIDs(t, ctx, &ck, defaultHeightValsetUpdateIDs)
 
 				require.Equal(t, validator.Address.Bytes(), ck.GetAllCCValidator(ctx)[0].Address)
 				require.Equal(t, gs.Params, ck.GetParams(ctx))
+
+				// a GenesisAccepted packet with the genesis hash is queued
+				genesisHash, err := gs.CanonicalHash()
+				require.NoError(t, err)
+				pendingPackets := ck.GetPendingPackets(ctx).List
+				require.Len(t, pendingPackets, 1)
+				require.Equal(t, ccv.GenesisAcceptedPacket, pendingPackets[0].Type)
+				require.Equal(t, genesisHash, pendingPackets[0].GetGenesisAcceptedPacketData().GenesisHash)
 			},
 		}, {
 			"restart a chain without an established CCV channel",
@@ -273,6 +290,22 @@ func TestExportGenesis(t *testing.T) {
 	params := consumertypes.DefaultParams()
 	params.Enabled = true
 
+	// the CCV identifiers pinned by the provider chain are exported until the CCV channel is established
+	restartGenesis := consumertypes.NewRestartGenesisState(
+		provClientID,
+		"",
+		nil,
+		valset,
+		defaultHeightValsetUpdateIDs,
+		consPackets,
+		nil,
+		consumertypes.LastTransmissionBlockHeight{},
+		params,
+	)
+	restartGenesis.CcvConnectionId = "connection-0"
+	restartGenesis.CcvChannelId = "channel-0"
+	restartGenesis.RelayerAllowlist = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+
 	// define two test cases which respectively populate the consumer chain store
 	// using the states declared above then call ExportGenesis to finally check
 	// that the resulting genesis struct contains the same states
@@ -293,18 +326,11 @@ func TestExportGenesis(t *testing.T) {
 
 				ck.AppendPendingPacket(ctx, consPackets.List...)
 				ck.SetHeightValsetUpdateID(ctx, defaultHeightValsetUpdateIDs[0].Height, defaultHeightValsetUpdateIDs[0].ValsetUpdateId)
+				ck.SetExpectedCCVConnectionID(ctx, "connection-0")
+				ck.SetExpectedCCVChannelID(ctx, "channel-0")
+				require.NoError(t, ck.SetRelayerAllowlist(ctx, []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}))
 			},
-			consumertypes.NewRestartGenesisState(
-				provClientID,
-				"",
-				nil,
-				valset,
-				defaultHeightValsetUpdateIDs,
-				consPackets,
-				nil,
-				consumertypes.LastTransmissionBlockHeight{},
-				params,
-			),
+			restartGenesis,
 		},
 		{
 			"export a chain with an established CCV channel",
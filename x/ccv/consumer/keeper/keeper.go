@@ -19,6 +19,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	tmtypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -216,6 +217,24 @@ func (k Keeper) GetProviderChannel(ctx sdk.Context) (string, bool) {
 	return string(channelIdBytes), true
 }
 
+// GetProviderChannelVersion returns the CCV version negotiated for the channel to the provider,
+// i.e., the version in the handshake metadata of the provider, which is stored as the channel version.
+func (k Keeper) GetProviderChannelVersion(ctx sdk.Context) (string, bool) {
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found {
+		return "", false
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, channelID)
+	if !found {
+		return "", false
+	}
+	var md providertypes.HandshakeMetadata
+	if err := (&md).Unmarshal([]byte(channel.Version)); err != nil {
+		return "", false
+	}
+	return md.Version, true
+}
+
 // DeleteProviderChannel deletes the channelID for the channel to the provider.
 func (k Keeper) DeleteProviderChannel(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -383,6 +402,40 @@ func (k Keeper) SetPacketMaturityTime(ctx sdk.Context, vscId uint64, maturityTim
 	store.Set(types.PacketMaturityTimeKey(vscId, maturityTime), bz)
 }
 
+// SetPendingVSCChunks sets the received chunks of a VSC packet, accumulated into a single chunk,
+// until the last chunk of the VSC packet is received
+func (k Keeper) SetPendingVSCChunks(ctx sdk.Context, chunks ccv.ValidatorSetChangePacketChunkData) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := chunks.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to marshal PendingVSCChunks: %w", err))
+	}
+	store.Set(types.PendingVSCChunksKey(), bz)
+}
+
+// GetPendingVSCChunks gets the received chunks of a VSC packet whose last chunk was not received yet
+func (k Keeper) GetPendingVSCChunks(ctx sdk.Context) (ccv.ValidatorSetChangePacketChunkData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingVSCChunksKey())
+	if bz == nil {
+		return ccv.ValidatorSetChangePacketChunkData{}, false
+	}
+	var chunks ccv.ValidatorSetChangePacketChunkData
+	if err := chunks.Unmarshal(bz); err != nil {
+		// This should never happen as PendingVSCChunks is expected
+		// to be correctly serialized in SetPendingVSCChunks
+		panic(fmt.Errorf("failed to unmarshal PendingVSCChunks: %w", err))
+	}
+	return chunks, true
+}
+
+// DeletePendingVSCChunks deletes the received chunks of a VSC packet once its last chunk is received
+func (k Keeper) DeletePendingVSCChunks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingVSCChunksKey())
+}
+
 // PacketMaturityExists checks whether the packet maturity time for a given vscId and maturityTime exists.
 //
 // Note: this method is only used in testing.
@@ -421,6 +474,103 @@ func (k Keeper) VerifyProviderChain(ctx sdk.Context, connectionHops []string) er
 	return nil
 }
 
+// SetExpectedCCVConnectionID sets the expected connectionID of the CCV channel,
+// as pinned by the provider chain in the consumer genesis
+func (k Keeper) SetExpectedCCVConnectionID(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpectedCCVConnectionIDKey(), []byte(connectionID))
+}
+
+// GetExpectedCCVConnectionID returns the expected connectionID of the CCV channel,
+// or an empty string if it is not pinned
+func (k Keeper) GetExpectedCCVConnectionID(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ExpectedCCVConnectionIDKey()))
+}
+
+// SetExpectedCCVChannelID sets the expected channelID of the CCV channel,
+// as pinned by the provider chain in the consumer genesis
+func (k Keeper) SetExpectedCCVChannelID(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpectedCCVChannelIDKey(), []byte(channelID))
+}
+
+// GetExpectedCCVChannelID returns the expected channelID of the CCV channel,
+// or an empty string if it is not pinned
+func (k Keeper) GetExpectedCCVChannelID(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ExpectedCCVChannelIDKey()))
+}
+
+// VerifyExpectedCCVIdentifiers verifies that the CCV channel is opened with the connectionID and
+// channelID pinned by the provider chain in the consumer genesis, if any. Note that IBC allocates
+// the identifiers sequentially, i.e., pinning an identifier cannot reserve it, but only ensures that
+// the consumer chain and the relayers agree on the identifiers ahead of time.
+func (k Keeper) VerifyExpectedCCVIdentifiers(ctx sdk.Context, connectionHops []string, channelID string) error {
+	if expectedConnectionID := k.GetExpectedCCVConnectionID(ctx); expectedConnectionID != "" {
+		if len(connectionHops) != 1 || connectionHops[0] != expectedConnectionID {
+			return sdkerrors.Wrapf(conntypes.ErrInvalidConnection,
+				"invalid connection hops: %v, CCV channel must be built on top of connection: %s", connectionHops, expectedConnectionID)
+		}
+	}
+	if expectedChannelID := k.GetExpectedCCVChannelID(ctx); expectedChannelID != "" && channelID != expectedChannelID {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannel,
+			"invalid channel: %s, expected CCV channel: %s", channelID, expectedChannelID)
+	}
+	return nil
+}
+
+// SetRelayerAllowlist sets the relayers allowed to relay CCV packets to this chain,
+// replacing the previous allowlist. The addresses are stored with their original bech32 encoding.
+func (k Keeper) SetRelayerAllowlist(ctx sdk.Context, relayers []string) error {
+	addrs, err := ccv.ParseRelayerAllowlist(relayers)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.RelayerAllowlistBytePrefix})
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+
+	for i, addr := range addrs {
+		store.Set(types.RelayerAllowlistKey(addr), []byte(relayers[i]))
+	}
+	return nil
+}
+
+// GetRelayerAllowlist returns the relayers allowed to relay CCV packets to this chain.
+// If empty, CCV packets relayed by any address are accepted.
+func (k Keeper) GetRelayerAllowlist(ctx sdk.Context) (relayers []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.RelayerAllowlistBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		relayers = append(relayers, string(iterator.Value()))
+	}
+	return relayers
+}
+
+// IsRelayerAllowed returns true if the given address may relay CCV packets to this chain,
+// i.e., if it is in the relayer allowlist or if the allowlist is empty
+func (k Keeper) IsRelayerAllowed(ctx sdk.Context, relayer sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.RelayerAllowlistKey(relayer)) {
+		return true
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.RelayerAllowlistBytePrefix})
+	defer iterator.Close()
+	return !iterator.Valid()
+}
+
 // SetHeightValsetUpdateID sets the valset update id for a given block height
 func (k Keeper) SetHeightValsetUpdateID(ctx sdk.Context, height, valsetUpdateId uint64) {
 	store := ctx.KVStore(k.storeKey)
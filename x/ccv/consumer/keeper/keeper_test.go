@@ -565,3 +565,30 @@ func TestPrevStandaloneChainFlag(t *testing.T) {
 	ck.MarkAsPrevStandaloneChain(ctx)
 	require.True(t, ck.IsPrevStandaloneChain(ctx))
 }
+
+// TestRelayerAllowlist tests that only the relayers in the relayer allowlist are allowed,
+// irrespective of the bech32 prefix of their addresses, unless the allowlist is empty
+func TestRelayerAllowlist(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	allowed := sdk.AccAddress([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20})
+	other := sdk.AccAddress([]byte("relayer2____________"))
+
+	// without an allowlist, all relayers are allowed
+	require.Empty(t, consumerKeeper.GetRelayerAllowlist(ctx))
+	require.True(t, consumerKeeper.IsRelayerAllowed(ctx, other))
+
+	// the allowlist may use the bech32 prefix of the provider chain
+	require.NoError(t, consumerKeeper.SetRelayerAllowlist(ctx, []string{"neutron1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5ma9uum"}))
+	require.Equal(t, []string{"neutron1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5ma9uum"}, consumerKeeper.GetRelayerAllowlist(ctx))
+	require.True(t, consumerKeeper.IsRelayerAllowed(ctx, allowed))
+	require.False(t, consumerKeeper.IsRelayerAllowed(ctx, other))
+
+	// setting an allowlist replaces the previous one
+	require.NoError(t, consumerKeeper.SetRelayerAllowlist(ctx, []string{other.String()}))
+	require.False(t, consumerKeeper.IsRelayerAllowed(ctx, allowed))
+	require.True(t, consumerKeeper.IsRelayerAllowed(ctx, other))
+
+	require.Error(t, consumerKeeper.SetRelayerAllowlist(ctx, []string{"cosmos1invalid"}))
+}
@@ -87,6 +87,66 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 	return ack
 }
 
+// OnRecvVSCPacketChunk handles a chunk of a VSC packet split into chunks by the provider.
+// The chunks have the same VSC ID and are received in order over the ordered CCV channel,
+// possibly in different blocks. The chunks are accumulated until the last chunk is received,
+// such that the entire validator set change is applied at once by OnRecvVSCPacket.
+//
+// Note: the provider only splits VSC packets over CCV channels negotiated with version 3.
+func (k Keeper) OnRecvVSCPacketChunk(ctx sdk.Context, packet channeltypes.Packet, chunk ccv.ValidatorSetChangePacketChunkData) exported.Acknowledgement {
+	vsc, complete, err := k.accumulateVSCChunk(ctx, chunk)
+	if err != nil {
+		k.Logger(ctx).Error("cannot accumulate VSC packet chunk", "vscID", chunk.Data.ValsetUpdateId, "error", err)
+		errAck := channeltypes.NewErrorAcknowledgement(err)
+		return &errAck
+	}
+	if !complete {
+		k.Logger(ctx).Debug("VSC packet chunk received",
+			"vscID", chunk.Data.ValsetUpdateId,
+			"chunk index", chunk.ChunkIndex,
+			"chunk total", chunk.ChunkTotal,
+		)
+		return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	}
+	return k.OnRecvVSCPacket(ctx, packet, vsc)
+}
+
+// accumulateVSCChunk accumulates the given chunk of a VSC packet with the previously received chunks.
+// Once the last chunk is received, the entire validator set change is returned as complete.
+// An error is returned if the chunk is invalid or not the next expected chunk, which should never
+// happen as the chunks are sent in order over the ordered CCV channel.
+func (k Keeper) accumulateVSCChunk(ctx sdk.Context, chunk ccv.ValidatorSetChangePacketChunkData) (ccv.ValidatorSetChangePacketData, bool, error) {
+	if err := chunk.ValidateBasic(); err != nil {
+		return ccv.ValidatorSetChangePacketData{}, false, err
+	}
+	received, found := k.GetPendingVSCChunks(ctx)
+	expIndex := uint64(0)
+	if found {
+		expIndex = received.ChunkIndex + 1
+		if received.Data.ValsetUpdateId != chunk.Data.ValsetUpdateId || received.ChunkTotal != chunk.ChunkTotal {
+			return ccv.ValidatorSetChangePacketData{}, false, fmt.Errorf(
+				"unexpected chunk of VSC packet %d, expected chunk %d of %d of VSC packet %d",
+				chunk.Data.ValsetUpdateId, expIndex, received.ChunkTotal, received.Data.ValsetUpdateId)
+		}
+	}
+	if chunk.ChunkIndex != expIndex {
+		return ccv.ValidatorSetChangePacketData{}, false, fmt.Errorf(
+			"unexpected chunk %d of VSC packet %d, expected chunk %d",
+			chunk.ChunkIndex, chunk.Data.ValsetUpdateId, expIndex)
+	}
+
+	if found {
+		chunk.Data.ValidatorUpdates = append(received.Data.ValidatorUpdates, chunk.Data.ValidatorUpdates...)
+		chunk.Data.SlashAcks = append(received.Data.SlashAcks, chunk.Data.SlashAcks...)
+	}
+	if !chunk.IsLastChunk() {
+		k.SetPendingVSCChunks(ctx, chunk)
+		return chunk.Data, false, nil
+	}
+	k.DeletePendingVSCChunks(ctx)
+	return chunk.Data, true, nil
+}
+
 // QueueVSCMaturedPackets appends matured VSCs to an internal queue.
 //
 // Note: Per spec, a VSC reaching maturity on a consumer chain means that all the unbonding
@@ -122,6 +182,26 @@ func (k Keeper) QueueVSCMaturedPackets(ctx sdk.Context) {
 	}
 }
 
+// QueueGenesisAcceptedPacket appends to the queue a GenesisAccepted packet
+// containing the hash of the given genesis state, i.e., the genesis state
+// the consumer chain started with.
+func (k Keeper) QueueGenesisAcceptedPacket(ctx sdk.Context, state types.GenesisState) {
+	genesisHash, err := state.CanonicalHash()
+	if err != nil {
+		// the genesis state was already successfully decoded, this should never happen
+		panic(fmt.Errorf("failed to compute consumer genesis hash: %w", err))
+	}
+
+	k.AppendPendingPacket(ctx, ccv.ConsumerPacketData{
+		Type: ccv.GenesisAcceptedPacket,
+		Data: &ccv.ConsumerPacketData_GenesisAcceptedPacketData{
+			GenesisAcceptedPacketData: ccv.NewGenesisAcceptedPacketData(genesisHash),
+		},
+	})
+
+	k.Logger(ctx).Info("GenesisAcceptedPacket enqueued", "genesis hash", fmt.Sprintf("%X", genesisHash))
+}
+
 // QueueSlashPacket appends a slash packet containing the given validator data and slashing info to queue.
 func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, valsetUpdateID uint64, infraction stakingtypes.InfractionType) {
 	consAddr := sdk.ConsAddress(validator.Address)
@@ -181,8 +261,16 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 		return
 	}
 
+	// GenesisAccepted packets are not supported by CCV channels negotiated with ccv.Version1,
+	// i.e., a provider that was not upgraded would reply with an error acknowledgement
+	version, found := k.GetProviderChannelVersion(ctx)
+
 	pending := k.GetPendingPackets(ctx)
 	for _, p := range pending.GetList() {
+		if p.Type == ccv.GenesisAcceptedPacket && (!found || version == ccv.Version1) {
+			k.Logger(ctx).Info("GenesisAcceptedPacket dropped, not supported by the CCV channel", "version", version)
+			continue
+		}
 
 		// send packet over IBC
 		err := ccv.SendIBCPacket(
@@ -215,15 +303,21 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 // according to https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#processing-acknowledgements
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
 	if err := ack.GetError(); err != "" {
-		// Reasons for ErrorAcknowledgment
-		//  - packet data could not be successfully decoded
-		//  - invalid Slash packet
-		// None of these should ever happen.
+		reason, _ := ccv.ParseCcvAckError(err)
 		k.Logger(ctx).Error(
 			"recv ErrorAcknowledgement",
 			"channel", packet.SourceChannel,
 			"error", err,
+			"reason", reason.String(),
 		)
+		if k.handleRecoverableErrorAck(ctx, packet, reason) {
+			return nil
+		}
+		// Reasons for closing the channel, see ccv.CcvAckError
+		//  - packet data could not be successfully decoded
+		//  - invalid Slash packet
+		//  - packet received on an unknown channel
+		// None of these should ever happen.
 		// Initiate ChanCloseInit using packet source (non-counterparty) port and channel
 		err := k.ChanCloseInit(ctx, packet.SourcePort, packet.SourceChannel)
 		if err != nil {
@@ -242,6 +336,46 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 	return nil
 }
 
+// handleRecoverableErrorAck handles an error acknowledgement whose reason code does not require
+// closing the CCV channel, returning false if the reason code is not recoverable:
+//   - CcvAckErrorThrottleQueue: the provider could not queue the packet data, thus the packet is
+//     queued again to be resent. As the CCV channel is ordered, the acknowledgements are received
+//     in the order the packets were sent, i.e., the packets are resent in their original order.
+//   - CcvAckErrorStaleInfraction: the provider dropped the slash packet, as the infraction is too old
+//     to be handled, thus the outstanding downtime flag of the validator is cleared.
+func (k Keeper) handleRecoverableErrorAck(ctx sdk.Context, packet channeltypes.Packet, reason ccv.CcvAckError) bool {
+	if reason != ccv.CcvAckErrorThrottleQueue && reason != ccv.CcvAckErrorStaleInfraction {
+		return false
+	}
+	var consumerPacket ccv.ConsumerPacketData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &consumerPacket); err != nil {
+		// the packet data was sent by this module, this should never happen
+		k.Logger(ctx).Error("failed to unmarshal acknowledged packet data", "error", err.Error())
+		return false
+	}
+
+	switch reason {
+	case ccv.CcvAckErrorThrottleQueue:
+		k.AppendPendingPacket(ctx, consumerPacket)
+		k.Logger(ctx).Info("packet rejected by the provider throttle queue, packet re-enqueued",
+			"type", consumerPacket.Type.String(),
+		)
+	case ccv.CcvAckErrorStaleInfraction:
+		data := consumerPacket.GetSlashPacketData()
+		if data == nil {
+			return false
+		}
+		if data.Infraction == stakingtypes.Downtime {
+			k.DeleteOutstandingDowntime(ctx, sdk.ConsAddress(data.Validator.Address).String())
+		}
+		k.Logger(ctx).Info("slash packet of stale infraction dropped by the provider",
+			"vscID", data.ValsetUpdateId,
+			"validator cons addr", sdk.ConsAddress(data.Validator.Address).String(),
+		)
+	}
+	return true
+}
+
 // IsChannelClosed returns a boolean whether a given channel is in the CLOSED state
 func (k Keeper) IsChannelClosed(ctx sdk.Context, channelID string) bool {
 	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, channelID)
@@ -8,6 +8,7 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -207,6 +208,70 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 	require.Equal(t, valUpdates[1], gotPendingChanges.ValidatorUpdates[0]) // Only latest update should be kept
 }
 
+// TestOnRecvVSCPacketChunks tests that a VSC packet split into chunks by the provider
+// is applied once its last chunk is received, and matures a full unbonding period afterwards
+func TestOnRecvVSCPacketChunks(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	unbondingPeriod := consumertypes.DefaultConsumerUnbondingPeriod
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+	consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+	consumerKeeper.SetParams(ctx, consumertypes.DefaultParams())
+
+	ids := crypto.GenMultipleCryptoIds(3, 0)
+	vscData := types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{
+		{PubKey: ids[0].TMProtoCryptoPublicKey(), Power: 1},
+		{PubKey: ids[1].TMProtoCryptoPublicKey(), Power: 2},
+		{PubKey: ids[2].TMProtoCryptoPublicKey(), Power: 3},
+	}, 1, nil)
+	chunks := vscData.Split(2)
+	require.Len(t, chunks, 2)
+	recv := func(ctx sdk.Context, seq uint64, chunk types.ValidatorSetChangePacketChunkData) bool {
+		packet := channeltypes.NewPacket(chunk.GetBytes(), seq, types.ProviderPortID,
+			providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
+		return consumerKeeper.OnRecvVSCPacketChunk(ctx, packet, chunk).Success()
+	}
+
+	// the chunks are received in different blocks;
+	// the validator set change is not applied before its last chunk is received
+	require.True(t, recv(ctx, 1, chunks[0]))
+	_, found := consumerKeeper.GetPendingChanges(ctx)
+	require.False(t, found)
+	require.Empty(t, consumerKeeper.GetAllPacketMaturityTimes(ctx))
+	_, found = consumerKeeper.GetPendingVSCChunks(ctx)
+	require.True(t, found)
+
+	later := now.Add(time.Minute)
+	require.True(t, recv(ctx.WithBlockTime(later), 2, chunks[1]))
+	require.Equal(t, []consumertypes.MaturingVSCPacket{
+		{VscId: 1, MaturityTime: later.Add(unbondingPeriod)},
+	}, consumerKeeper.GetAllPacketMaturityTimes(ctx))
+	_, found = consumerKeeper.GetPendingVSCChunks(ctx)
+	require.False(t, found)
+
+	// all the validator updates of the chunks are applied
+	pendingChanges, found := consumerKeeper.GetPendingChanges(ctx)
+	require.True(t, found)
+	require.ElementsMatch(t, vscData.ValidatorUpdates, pendingChanges.ValidatorUpdates)
+
+	// chunks received out of order are rejected
+	vscData.ValsetUpdateId = 2
+	chunks = vscData.Split(2)
+	require.False(t, recv(ctx, 3, chunks[1]))
+	require.True(t, recv(ctx, 3, chunks[0]))
+	vscData.ValsetUpdateId = 3
+	require.False(t, recv(ctx, 4, vscData.Split(2)[1]))
+
+	// invalid chunks are rejected
+	chunks = vscData.Split(2)
+	chunks[0].ChunkTotal = 0
+	require.False(t, recv(ctx, 4, chunks[0]))
+}
+
 // TestOnAcknowledgementPacket tests application logic for acknowledgments of sent VSCMatured and Slash packets
 // in conjunction with the ibc module's execution of "acknowledgePacket",
 // according to https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#processing-acknowledgements
@@ -280,3 +345,40 @@ func TestOnAcknowledgementPacket(t *testing.T) {
 	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, ack)
 	require.Nil(t, err)
 }
+
+// TestOnRecoverableErrorAcknowledgementPacket tests that the error acknowledgements with a recoverable
+// reason code are handled without closing the CCV channel
+func TestOnRecoverableErrorAcknowledgementPacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+
+	consAddr := sdk.ConsAddress([]byte("validator"))
+	consumerPacket := types.ConsumerPacketData{
+		Type: types.SlashPacket,
+		Data: &types.ConsumerPacketData_SlashPacketData{
+			SlashPacketData: types.NewSlashPacketData(
+				abci.Validator{Address: consAddr, Power: 1}, 1, stakingtypes.Downtime,
+			),
+		},
+	}
+	packet := channeltypes.NewPacket(consumerPacket.GetBytes(), 1,
+		types.ConsumerPortID, "channel-0", types.ProviderPortID, "channel-1",
+		clienttypes.Height{}, uint64(time.Now().Add(time.Minute).UnixNano()))
+
+	// the packet rejected by the throttle queue of the provider is queued again,
+	// i.e., no ChanCloseInit is expected
+	err := consumerKeeper.OnAcknowledgementPacket(ctx, packet,
+		types.NewCcvErrorAcknowledgement(types.CcvAckErrorThrottleQueue))
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerPacketData{consumerPacket}, consumerKeeper.GetPendingPackets(ctx).List)
+
+	// the outstanding downtime of the slash packet of a stale infraction is cleared
+	consumerKeeper.DeletePendingDataPackets(ctx)
+	consumerKeeper.SetOutstandingDowntime(ctx, consAddr)
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet,
+		types.NewCcvErrorAcknowledgement(types.CcvAckErrorStaleInfraction))
+	require.NoError(t, err)
+	require.False(t, consumerKeeper.OutstandingDowntime(ctx, consAddr))
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx).List)
+}
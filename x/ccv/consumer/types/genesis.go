@@ -1,7 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 
@@ -54,6 +59,27 @@ func DefaultGenesisState() *GenesisState {
 	}
 }
 
+// CanonicalJSON returns the canonical JSON encoding of the genesis state, i.e.,
+// the proto JSON encoding with all object keys sorted and whitespace removed.
+// Nodes holding the same genesis state always produce byte-identical output.
+func (gs GenesisState) CanonicalJSON() ([]byte, error) {
+	bz, err := codec.ProtoMarshalJSON(&gs, nil)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// CanonicalHash returns the SHA256 hash of the canonical JSON encoding of the genesis state.
+func (gs GenesisState) CanonicalHash() ([]byte, error) {
+	bz, err := gs.CanonicalJSON()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
 // Validate performs basic genesis state validation returning an error upon any failure.
 //
 // The three cases where a consumer chain starts/restarts
@@ -153,6 +179,33 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 	}
+
+	if gs.CcvConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(gs.CcvConnectionId); err != nil {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid ccv connection id: %s", err)
+		}
+	}
+	if gs.CcvChannelId != "" {
+		if err := host.ChannelIdentifierValidator(gs.CcvChannelId); err != nil {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid ccv channel id: %s", err)
+		}
+	}
+	if gs.ExpectedProviderConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(gs.ExpectedProviderConnectionId); err != nil {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid expected provider connection id: %s", err)
+		}
+	}
+	if gs.ExpectedProviderChannelId != "" {
+		if err := host.ChannelIdentifierValidator(gs.ExpectedProviderChannelId); err != nil {
+			return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid expected provider channel id: %s", err)
+		}
+	}
+	if _, err := ccv.ParseRelayerAllowlist(gs.RelayerAllowlist); err != nil {
+		return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid relayer allowlist: %s", err)
+	}
+	if _, err := sdk.ParseDecCoins(gs.MinGasPrices); err != nil {
+		return sdkerrors.Wrapf(ccv.ErrInvalidGenesis, "invalid min gas prices: %s", err)
+	}
 	return nil
 }
 
@@ -10,17 +10,21 @@ import (
 	types2 "github.com/cosmos/interchain-security/x/ccv/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types1 "github.com/tendermint/tendermint/abci/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -51,6 +55,23 @@ type GenesisState struct {
 	// LastTransmissionBlockHeight nil on new chain, filled in on restart.
 	LastTransmissionBlockHeight LastTransmissionBlockHeight `protobuf:"bytes,12,opt,name=last_transmission_block_height,json=lastTransmissionBlockHeight,proto3" json:"last_transmission_block_height"`
 	PreCCV                      bool                        `protobuf:"varint,13,opt,name=preCCV,proto3" json:"preCCV,omitempty"`
+	// GenesisTime filled in on new chain, nil on restart.
+	// It is the time at which the consumer chain is expected to start, i.e., the block time
+	// at which the consumer client was created plus the genesis time offset of the proposal.
+	GenesisTime *time.Time `protobuf:"bytes,14,opt,name=genesis_time,json=genesisTime,proto3,stdtime" json:"genesis_time,omitempty"`
+	// The expected identifier of the CCV connection, empty if not pinned by the provider chain.
+	CcvConnectionId string `protobuf:"bytes,15,opt,name=ccv_connection_id,json=ccvConnectionId,proto3" json:"ccv_connection_id,omitempty"`
+	// The expected identifier of the CCV channel, empty if not pinned by the provider chain.
+	CcvChannelId string `protobuf:"bytes,16,opt,name=ccv_channel_id,json=ccvChannelId,proto3" json:"ccv_channel_id,omitempty"`
+	// The addresses of the relayers CCV packets are accepted from, empty if any relayer is accepted.
+	RelayerAllowlist []string `protobuf:"bytes,17,rep,name=relayer_allowlist,json=relayerAllowlist,proto3" json:"relayer_allowlist,omitempty"`
+	// The expected identifier of the connection on the provider chain, empty if not known (a hint for relayers).
+	ExpectedProviderConnectionId string `protobuf:"bytes,18,opt,name=expected_provider_connection_id,json=expectedProviderConnectionId,proto3" json:"expected_provider_connection_id,omitempty"`
+	// The expected identifier of the CCV channel on the provider chain, empty if not known (a hint for relayers).
+	ExpectedProviderChannelId string `protobuf:"bytes,19,opt,name=expected_provider_channel_id,json=expectedProviderChannelId,proto3" json:"expected_provider_channel_id,omitempty"`
+	// The minimum gas prices decided by the governance of the provider chain at launch,
+	// empty if chosen by the operators (a hint for the app config of the consumer nodes).
+	MinGasPrices string `protobuf:"bytes,20,opt,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -177,8 +198,57 @@ func (m *GenesisState) GetPreCCV() bool {
 	return false
 }
 
+func (m *GenesisState) GetGenesisTime() *time.Time {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return nil
+}
+
 // HeightValsetUpdateID defines the genesis information for the mapping
 // of each block height to a valset update id
+func (m *GenesisState) GetCcvConnectionId() string {
+	if m != nil {
+		return m.CcvConnectionId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetCcvChannelId() string {
+	if m != nil {
+		return m.CcvChannelId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRelayerAllowlist() []string {
+	if m != nil {
+		return m.RelayerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpectedProviderConnectionId() string {
+	if m != nil {
+		return m.ExpectedProviderConnectionId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetExpectedProviderChannelId() string {
+	if m != nil {
+		return m.ExpectedProviderChannelId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetMinGasPrices() string {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return ""
+}
+
 type HeightToValsetUpdateID struct {
 	Height         uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	ValsetUpdateId uint64 `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
@@ -288,56 +358,67 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0xeb, 0x34,
-	0x1c, 0x6f, 0xde, 0x1b, 0xa5, 0xf5, 0xde, 0xe3, 0x0d, 0x0f, 0xaa, 0xd0, 0x8a, 0x50, 0x06, 0x87,
-	0x4a, 0x40, 0xa2, 0x16, 0x09, 0x21, 0x90, 0x10, 0xac, 0x93, 0xa0, 0xd2, 0x80, 0xa9, 0xdd, 0x7a,
-	0xd8, 0x25, 0x72, 0x1d, 0x93, 0x58, 0x4b, 0xec, 0xc8, 0x76, 0x32, 0x76, 0xe0, 0xc2, 0x95, 0x0b,
-	0x7f, 0xd6, 0x8e, 0x3b, 0x72, 0x42, 0x68, 0xfb, 0x1f, 0x38, 0xa3, 0xd8, 0x4e, 0x7f, 0xb0, 0x4e,
-	0xaf, 0xa7, 0xc4, 0xf9, 0x7e, 0x7e, 0x7c, 0x7f, 0x38, 0x36, 0x18, 0x52, 0xa6, 0x88, 0xc0, 0x09,
-	0xa2, 0x2c, 0x94, 0x04, 0x17, 0x82, 0xaa, 0x9b, 0x00, 0xe3, 0x32, 0xc0, 0x9c, 0xc9, 0x22, 0x23,
-	0x22, 0x28, 0x87, 0x41, 0x4c, 0x18, 0x91, 0x54, 0xfa, 0xb9, 0xe0, 0x8a, 0xc3, 0x8f, 0xb6, 0x50,
-	0x7c, 0x8c, 0x4b, 0xbf, 0xa6, 0xf8, 0xe5, 0xb0, 0xfb, 0xf1, 0x53, 0xba, 0xe5, 0xb0, 0x7a, 0x18,
-	0xa9, 0xee, 0x68, 0x17, 0xf7, 0xa5, 0xac, 0xe1, 0xf4, 0x14, 0x61, 0x11, 0x11, 0x19, 0x65, 0x2a,
-	0x40, 0x0b, 0x4c, 0x03, 0x75, 0x93, 0x13, 0x9b, 0x5b, 0x37, 0xa0, 0x0b, 0x1c, 0xa4, 0x34, 0x4e,
-	0x14, 0x4e, 0x29, 0x61, 0x4a, 0x06, 0x6b, 0xe8, 0x72, 0xb8, 0xb6, 0xb2, 0x84, 0x0f, 0x2b, 0x02,
-	0xe6, 0x82, 0x04, 0x38, 0x41, 0x8c, 0x91, 0x54, 0x3b, 0x9a, 0x57, 0x0b, 0xf1, 0x62, 0xce, 0xe3,
-	0x94, 0x04, 0x7a, 0xb5, 0x28, 0x7e, 0x09, 0xa2, 0x42, 0x20, 0x45, 0x39, 0xb3, 0xf1, 0x77, 0x62,
-	0x1e, 0x73, 0xfd, 0x1a, 0x54, 0x6f, 0xe6, 0xeb, 0xd1, 0xbf, 0x2d, 0xf0, 0xe2, 0x7b, 0xd3, 0xb7,
-	0x99, 0x42, 0x8a, 0xc0, 0x09, 0x68, 0xe6, 0x48, 0xa0, 0x4c, 0xba, 0x4e, 0xdf, 0x19, 0xec, 0x8f,
-	0x3e, 0xf1, 0x77, 0xe8, 0xa3, 0x7f, 0xa6, 0x29, 0xc7, 0x7b, 0xb7, 0x7f, 0x7f, 0xd0, 0x98, 0x5a,
-	0x01, 0xf8, 0x29, 0x80, 0xb9, 0xe0, 0x25, 0x8d, 0x88, 0x08, 0x4d, 0x9d, 0x21, 0x8d, 0xdc, 0x67,
-	0x7d, 0x67, 0xd0, 0x9e, 0x1e, 0xd4, 0x91, 0xb1, 0x0e, 0x4c, 0x22, 0xe8, 0x83, 0xc3, 0x15, 0xda,
-	0x54, 0x56, 0xc1, 0x9f, 0x6b, 0xf8, 0xdb, 0x4b, 0xb8, 0x89, 0x4c, 0x22, 0xd8, 0x03, 0x6d, 0x46,
-	0xae, 0x43, 0x9d, 0x98, 0xbb, 0xd7, 0x77, 0x06, 0xad, 0x69, 0x8b, 0x91, 0xeb, 0x71, 0xb5, 0x86,
-	0x21, 0x78, 0xf7, 0xff, 0xd6, 0xb2, 0x2a, 0xcf, 0x7d, 0xa3, 0x2e, 0x6a, 0x81, 0xfd, 0xf5, 0x01,
-	0xf8, 0x6b, 0x2d, 0x2f, 0x87, 0xbe, 0xc9, 0x4a, 0x77, 0x64, 0x7a, 0xb8, 0x99, 0xaa, 0x69, 0x53,
-	0x02, 0xdc, 0x95, 0x01, 0x67, 0x92, 0x30, 0x59, 0x48, 0xeb, 0xd1, 0xd4, 0x1e, 0xfe, 0x6b, 0x3d,
-	0x6a, 0x9a, 0xb1, 0xe9, 0x2c, 0x6d, 0x36, 0xbe, 0xc3, 0x18, 0x1c, 0x64, 0x48, 0x15, 0x82, 0xb2,
-	0x38, 0xcc, 0x11, 0xbe, 0x22, 0x4a, 0xba, 0x6f, 0xf6, 0x9f, 0x0f, 0xf6, 0x47, 0x5f, 0xec, 0x34,
-	0x9a, 0x1f, 0x2d, 0x79, 0x3e, 0x1b, 0x9f, 0x69, 0xba, 0x9d, 0xd2, 0xab, 0x5a, 0xd5, 0x7c, 0x95,
-	0xf0, 0x27, 0xf0, 0x8a, 0x32, 0xaa, 0x28, 0x4a, 0xc3, 0x12, 0xa5, 0xa1, 0x24, 0xca, 0x6d, 0x69,
-	0x9f, 0xfe, 0x7a, 0xe2, 0xd5, 0x5e, 0xf6, 0xe7, 0x28, 0xa5, 0x11, 0x52, 0x5c, 0x5c, 0xe4, 0x11,
-	0x52, 0xc4, 0x2a, 0xbe, 0xb4, 0xf4, 0x39, 0x4a, 0x67, 0x44, 0xc1, 0xdf, 0x40, 0x37, 0x21, 0x55,
-	0xf9, 0xa1, 0xe2, 0x95, 0xa2, 0x24, 0x2a, 0x2c, 0x34, 0xbe, 0x9a, 0x6b, 0x5b, 0x4b, 0x7f, 0xbd,
-	0x53, 0x09, 0x3f, 0x68, 0x99, 0x73, 0x3e, 0xd7, 0x22, 0xc6, 0x73, 0x72, 0x62, 0x5d, 0x3b, 0xc9,
-	0xb6, 0x68, 0x04, 0x7f, 0x77, 0xc0, 0xfb, 0xbc, 0x50, 0x52, 0x21, 0x16, 0x55, 0xbd, 0x8b, 0xf8,
-	0x35, 0x53, 0x34, 0x23, 0xa1, 0x4c, 0x91, 0x4c, 0x28, 0x8b, 0x5d, 0xa0, 0x53, 0xf8, 0x72, 0xa7,
-	0x14, 0x7e, 0x5e, 0x29, 0x9d, 0x58, 0x21, 0xeb, 0xdf, 0xe3, 0x8f, 0x43, 0x33, 0x6b, 0x01, 0x05,
-	0x70, 0x73, 0x62, 0xfc, 0x6b, 0xb5, 0xe5, 0x10, 0xf7, 0xf5, 0x36, 0x19, 0x3d, 0x69, 0x6f, 0xb7,
-	0x48, 0xc5, 0x31, 0x23, 0x3a, 0x41, 0x0a, 0x9d, 0x52, 0x59, 0x0f, 0xb0, 0x63, 0x95, 0x37, 0x41,
-	0x12, 0xfe, 0xe1, 0x00, 0x2f, 0x45, 0x52, 0x85, 0x4a, 0x20, 0x26, 0x33, 0x2a, 0x25, 0xe5, 0x2c,
-	0x5c, 0xa4, 0x1c, 0x5f, 0x85, 0xa6, 0x57, 0xee, 0x0b, 0x6d, 0xfd, 0xed, 0x4e, 0x95, 0x9f, 0x22,
-	0xa9, 0xce, 0xd7, 0x94, 0x8e, 0x2b, 0x21, 0x33, 0x91, 0xba, 0x03, 0xe9, 0xd3, 0x10, 0xd8, 0x01,
-	0xcd, 0x5c, 0x90, 0xf1, 0x78, 0xee, 0xbe, 0xd4, 0xff, 0xa8, 0x5d, 0x1d, 0x5d, 0x82, 0xce, 0xf6,
-	0xb1, 0x56, 0x0c, 0x9b, 0x66, 0x75, 0x02, 0xed, 0x4d, 0xed, 0x0a, 0x0e, 0xc0, 0xc1, 0xa3, 0x5d,
-	0xf4, 0x4c, 0x23, 0xde, 0x2a, 0x37, 0x46, 0x7f, 0x74, 0x01, 0x0e, 0xb7, 0xcc, 0x0b, 0x7e, 0x03,
-	0x7a, 0x65, 0xbd, 0x71, 0xd7, 0x7e, 0x5a, 0x14, 0x45, 0x82, 0x48, 0x73, 0xde, 0xb5, 0xa7, 0xef,
-	0x2d, 0x21, 0xcb, 0xff, 0xf0, 0x3b, 0x03, 0x38, 0x3e, 0xbf, 0xbd, 0xf7, 0x9c, 0xbb, 0x7b, 0xcf,
-	0xf9, 0xe7, 0xde, 0x73, 0xfe, 0x7c, 0xf0, 0x1a, 0x77, 0x0f, 0x5e, 0xe3, 0xaf, 0x07, 0xaf, 0x71,
-	0xf9, 0x55, 0x4c, 0x55, 0x52, 0x2c, 0x7c, 0xcc, 0xb3, 0x00, 0x73, 0x99, 0x71, 0x19, 0xac, 0x5a,
-	0xfb, 0xd9, 0xf2, 0xca, 0xf8, 0x75, 0xf3, 0xd2, 0xd0, 0x37, 0xc2, 0xa2, 0xa9, 0x0f, 0xe2, 0xcf,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xab, 0x08, 0x12, 0x9c, 0xe3, 0x06, 0x00, 0x00,
+	// 958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0x1c, 0x35,
+	0x18, 0xce, 0x34, 0x21, 0x24, 0x4e, 0x9a, 0x0f, 0xa7, 0x44, 0xd3, 0xa4, 0x6c, 0x96, 0x90, 0xc3,
+	0x8a, 0xc2, 0x8c, 0x36, 0x48, 0x08, 0x81, 0x04, 0x34, 0x1b, 0x54, 0x56, 0x2a, 0x10, 0x6d, 0xd2,
+	0x3d, 0xf4, 0x62, 0x79, 0x3d, 0x66, 0xd6, 0xea, 0x8c, 0x3d, 0xb2, 0xbd, 0x93, 0xe6, 0xc0, 0x85,
+	0x2b, 0x97, 0xfe, 0xac, 0x1e, 0x7b, 0xe4, 0x04, 0x28, 0xb9, 0xf0, 0x33, 0x90, 0x3f, 0x66, 0x76,
+	0xb7, 0xd9, 0xa8, 0x7b, 0x9a, 0xb1, 0xfd, 0xbc, 0xcf, 0xf3, 0x7e, 0xf9, 0x35, 0x68, 0x33, 0xae,
+	0xa9, 0x24, 0x43, 0xcc, 0x38, 0x52, 0x94, 0x8c, 0x24, 0xd3, 0x57, 0x31, 0x21, 0x65, 0x4c, 0x04,
+	0x57, 0xa3, 0x9c, 0xca, 0xb8, 0x6c, 0xc7, 0x29, 0xe5, 0x54, 0x31, 0x15, 0x15, 0x52, 0x68, 0x01,
+	0x3f, 0x9d, 0x61, 0x12, 0x11, 0x52, 0x46, 0x95, 0x49, 0x54, 0xb6, 0xf7, 0x8e, 0xee, 0xe2, 0x2d,
+	0xdb, 0xe6, 0xe3, 0xa8, 0xf6, 0x8e, 0xe7, 0x51, 0xaf, 0x69, 0x9d, 0xcd, 0xbe, 0xa6, 0x3c, 0xa1,
+	0x32, 0x67, 0x5c, 0xc7, 0x78, 0x40, 0x58, 0xac, 0xaf, 0x0a, 0xea, 0x7d, 0xdb, 0x8b, 0xd9, 0x80,
+	0xc4, 0x19, 0x4b, 0x87, 0x9a, 0x64, 0x8c, 0x72, 0xad, 0xe2, 0x09, 0x74, 0xd9, 0x9e, 0x58, 0x79,
+	0x83, 0x4f, 0x8c, 0x01, 0x11, 0x92, 0xc6, 0x64, 0x88, 0x39, 0xa7, 0x99, 0x55, 0x74, 0xbf, 0x1e,
+	0xd2, 0x48, 0x85, 0x48, 0x33, 0x1a, 0xdb, 0xd5, 0x60, 0xf4, 0x5b, 0x9c, 0x8c, 0x24, 0xd6, 0x4c,
+	0x70, 0x7f, 0xfe, 0x20, 0x15, 0xa9, 0xb0, 0xbf, 0xb1, 0xf9, 0xf3, 0xbb, 0x07, 0xef, 0x5a, 0x69,
+	0x96, 0x53, 0xa5, 0x71, 0x5e, 0x38, 0xc0, 0xe1, 0x7f, 0x6b, 0x60, 0xfd, 0xa9, 0x4b, 0xec, 0xb9,
+	0xc6, 0x9a, 0xc2, 0x2e, 0x58, 0x2e, 0xb0, 0xc4, 0xb9, 0x0a, 0x83, 0x66, 0xd0, 0x5a, 0x3b, 0x7e,
+	0x1c, 0xcd, 0x91, 0xe8, 0xe8, 0xcc, 0x9a, 0x9c, 0x2c, 0xbd, 0xf9, 0xfb, 0x60, 0xa1, 0xe7, 0x09,
+	0xe0, 0xe7, 0x00, 0x16, 0x52, 0x94, 0x2c, 0xa1, 0x12, 0xb9, 0x44, 0x20, 0x96, 0x84, 0xf7, 0x9a,
+	0x41, 0x6b, 0xb5, 0xb7, 0x55, 0x9d, 0x74, 0xec, 0x41, 0x37, 0x81, 0x11, 0xd8, 0x19, 0xa3, 0x5d,
+	0xe8, 0x06, 0xbe, 0x68, 0xe1, 0xdb, 0x35, 0xdc, 0x9d, 0x74, 0x13, 0xb8, 0x0f, 0x56, 0x39, 0xbd,
+	0x44, 0xd6, 0xb1, 0x70, 0xa9, 0x19, 0xb4, 0x56, 0x7a, 0x2b, 0x9c, 0x5e, 0x76, 0xcc, 0x1a, 0x22,
+	0xf0, 0xd1, 0xbb, 0xd2, 0xca, 0x84, 0x17, 0x7e, 0x50, 0x05, 0x35, 0x20, 0xd1, 0x64, 0x85, 0xa2,
+	0x89, 0x9a, 0x94, 0xed, 0xc8, 0x79, 0x65, 0x33, 0xd2, 0xdb, 0x99, 0x76, 0xd5, 0xa5, 0x69, 0x08,
+	0xc2, 0xb1, 0x80, 0xe0, 0x8a, 0x72, 0x35, 0x52, 0x5e, 0x63, 0xd9, 0x6a, 0x44, 0xef, 0xd5, 0xa8,
+	0xcc, 0x9c, 0xcc, 0x6e, 0x2d, 0x33, 0xb5, 0x0f, 0x53, 0xb0, 0x95, 0x63, 0x3d, 0x92, 0x8c, 0xa7,
+	0xa8, 0xc0, 0xe4, 0x25, 0xd5, 0x2a, 0xfc, 0xb0, 0xb9, 0xd8, 0x5a, 0x3b, 0xfe, 0x6a, 0xae, 0xd2,
+	0xfc, 0xec, 0x8d, 0xfb, 0xe7, 0x9d, 0x33, 0x6b, 0xee, 0xab, 0xb4, 0x59, 0xb1, 0xba, 0x5d, 0x05,
+	0x7f, 0x01, 0x9b, 0x8c, 0x33, 0xcd, 0x70, 0x86, 0x4a, 0x9c, 0x21, 0x45, 0x75, 0xb8, 0x62, 0x75,
+	0x9a, 0x93, 0x8e, 0x9b, 0x66, 0x8f, 0xfa, 0x38, 0x63, 0x09, 0xd6, 0x42, 0x3e, 0x2f, 0x12, 0xac,
+	0xa9, 0x67, 0xbc, 0xef, 0xcd, 0xfb, 0x38, 0x3b, 0xa7, 0x1a, 0xfe, 0x0e, 0xf6, 0x86, 0xd4, 0x84,
+	0x8f, 0xb4, 0x30, 0x8c, 0x8a, 0x6a, 0x34, 0xb2, 0x78, 0x53, 0xd7, 0x55, 0x4b, 0xfd, 0xed, 0x5c,
+	0x21, 0xfc, 0x64, 0x69, 0x2e, 0x44, 0xdf, 0x92, 0x38, 0xcd, 0xee, 0xa9, 0x57, 0xdd, 0x1d, 0xce,
+	0x3a, 0x4d, 0xe0, 0x1f, 0x01, 0xf8, 0x58, 0x8c, 0xb4, 0xd2, 0x98, 0x27, 0x26, 0x77, 0x89, 0xb8,
+	0xe4, 0xa6, 0xfb, 0x91, 0xca, 0xb0, 0x1a, 0x32, 0x9e, 0x86, 0xc0, 0xba, 0xf0, 0xf5, 0x5c, 0x2e,
+	0xfc, 0x3a, 0x66, 0x3a, 0xf5, 0x44, 0x5e, 0x7f, 0x5f, 0xdc, 0x3e, 0x3a, 0xf7, 0x12, 0x50, 0x82,
+	0xb0, 0xa0, 0x4e, 0xbf, 0x62, 0xab, 0x8b, 0xb8, 0x66, 0xdb, 0xe4, 0xf8, 0x4e, 0x79, 0xdf, 0x22,
+	0xc6, 0xc6, 0x95, 0xe8, 0x14, 0x6b, 0xfc, 0x8c, 0xa9, 0xaa, 0x80, 0xbb, 0x9e, 0x79, 0x1a, 0xa4,
+	0xe0, 0x9f, 0x01, 0x68, 0x64, 0x58, 0x69, 0xa4, 0x25, 0xe6, 0x2a, 0x67, 0x4a, 0x31, 0xc1, 0xd1,
+	0x20, 0x13, 0xe4, 0x25, 0x72, 0xb9, 0x0a, 0xd7, 0xad, 0xf4, 0x0f, 0x73, 0x45, 0xfe, 0x0c, 0x2b,
+	0x7d, 0x31, 0xc1, 0x74, 0x62, 0x88, 0x5c, 0x45, 0xaa, 0x0c, 0x64, 0x77, 0x43, 0xe0, 0x2e, 0x58,
+	0x2e, 0x24, 0xed, 0x74, 0xfa, 0xe1, 0x7d, 0x7b, 0x47, 0xfd, 0x0a, 0x76, 0xc0, 0xba, 0x1f, 0xe8,
+	0xc8, 0x64, 0x2c, 0xdc, 0xb0, 0x2e, 0xed, 0x45, 0x6e, 0x60, 0x45, 0xd5, 0xc0, 0x8a, 0x2e, 0xaa,
+	0x81, 0x75, 0xb2, 0xf4, 0xfa, 0x9f, 0x83, 0xa0, 0xb7, 0xe6, 0xad, 0xcc, 0x3e, 0xfc, 0x0c, 0x6c,
+	0x13, 0x52, 0x9a, 0xd4, 0x72, 0x4a, 0xb4, 0x09, 0x93, 0x25, 0xe1, 0xa6, 0x9d, 0x18, 0x9b, 0x84,
+	0x94, 0x9d, 0x7a, 0xbf, 0x9b, 0xc0, 0x23, 0xb0, 0x61, 0xb1, 0xe3, 0xd1, 0xb2, 0x65, 0x81, 0xeb,
+	0x06, 0x58, 0x4f, 0x95, 0xc7, 0x60, 0x5b, 0xd2, 0x0c, 0x5f, 0x51, 0x89, 0x70, 0x96, 0x89, 0xcb,
+	0x8c, 0x29, 0x1d, 0x6e, 0x37, 0x17, 0xcd, 0xc8, 0xf2, 0x07, 0x4f, 0xaa, 0x7d, 0xf8, 0x23, 0x38,
+	0xa0, 0xaf, 0x0a, 0x4a, 0x34, 0x4d, 0xd0, 0xe4, 0x34, 0x98, 0x70, 0x06, 0x5a, 0x8d, 0x47, 0x15,
+	0xec, 0x6c, 0x7c, 0xc7, 0xc7, 0x9e, 0x7d, 0x0f, 0x1e, 0xcd, 0xa0, 0x19, 0xfb, 0xb9, 0x63, 0x39,
+	0x1e, 0xde, 0xe2, 0xa8, 0x9d, 0x3e, 0x02, 0x1b, 0x39, 0xe3, 0x28, 0xc5, 0x0a, 0x15, 0x92, 0x11,
+	0xaa, 0xc2, 0x07, 0x2e, 0xb4, 0x9c, 0xf1, 0xa7, 0x58, 0x9d, 0xd9, 0xbd, 0xc3, 0x17, 0x60, 0x77,
+	0xf6, 0x45, 0x32, 0x35, 0xf2, 0x8d, 0x61, 0x66, 0xfe, 0x52, 0xcf, 0xaf, 0x60, 0x0b, 0x6c, 0xdd,
+	0xba, 0xb7, 0xf7, 0x2c, 0x62, 0xa3, 0x9c, 0xba, 0x6c, 0x87, 0xcf, 0xc1, 0xce, 0x8c, 0x1b, 0x02,
+	0xbf, 0x03, 0xfb, 0x65, 0x35, 0x2a, 0x26, 0xc6, 0x24, 0x4e, 0x12, 0x49, 0x95, 0x7b, 0x61, 0x56,
+	0x7b, 0x0f, 0x6b, 0x48, 0x3d, 0xf9, 0x9e, 0x38, 0xc0, 0xc9, 0xc5, 0x8b, 0x6f, 0x52, 0xa6, 0x87,
+	0xa3, 0x41, 0x44, 0x44, 0x1e, 0x13, 0xa1, 0x72, 0xa1, 0xe2, 0x71, 0xd3, 0x7e, 0x51, 0xbf, 0xd6,
+	0xaf, 0xa6, 0xdf, 0x6b, 0xfb, 0x18, 0xbf, 0xb9, 0x6e, 0x04, 0x6f, 0xaf, 0x1b, 0xc1, 0xbf, 0xd7,
+	0x8d, 0xe0, 0xf5, 0x4d, 0x63, 0xe1, 0xed, 0x4d, 0x63, 0xe1, 0xaf, 0x9b, 0xc6, 0xc2, 0x60, 0xd9,
+	0x36, 0xd7, 0x97, 0xff, 0x0f, 0x00, 0x2b, 0xff, 0xed, 0x72, 0x76, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -360,6 +441,70 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPrices) > 0 {
+		i -= len(m.MinGasPrices)
+		copy(dAtA[i:], m.MinGasPrices)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinGasPrices)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ExpectedProviderChannelId) > 0 {
+		i -= len(m.ExpectedProviderChannelId)
+		copy(dAtA[i:], m.ExpectedProviderChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExpectedProviderChannelId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ExpectedProviderConnectionId) > 0 {
+		i -= len(m.ExpectedProviderConnectionId)
+		copy(dAtA[i:], m.ExpectedProviderConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExpectedProviderConnectionId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RelayerAllowlist) > 0 {
+		for iNdEx := len(m.RelayerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RelayerAllowlist[iNdEx])
+			copy(dAtA[i:], m.RelayerAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RelayerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.CcvChannelId) > 0 {
+		i -= len(m.CcvChannelId)
+		copy(dAtA[i:], m.CcvChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CcvChannelId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CcvConnectionId) > 0 {
+		i -= len(m.CcvConnectionId)
+		copy(dAtA[i:], m.CcvConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CcvConnectionId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.GenesisTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.GenesisTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.GenesisTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGenesis(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.PreCCV {
 		i--
 		if m.PreCCV {
@@ -639,6 +784,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.PreCCV {
 		n += 2
 	}
+	if m.GenesisTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GenesisTime)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CcvConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CcvChannelId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RelayerAllowlist) > 0 {
+		for _, s := range m.RelayerAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ExpectedProviderConnectionId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ExpectedProviderChannelId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MinGasPrices)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1116,6 +1291,234 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PreCCV = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisTime == nil {
+				m.GenesisTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CcvConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CcvChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAllowlist = append(m.RelayerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProviderConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedProviderConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProviderChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedProviderChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,9 +1,12 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -63,6 +66,240 @@ func TestValidateInitialGenesisState(t *testing.T) {
 			types.NewInitialGenesisState(cs, consensusState, valUpdates, params),
 			false,
 		},
+		{
+			"valid new consumer genesis state with pinned ccv identifiers",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"connection-0",
+				"channel-0",
+				nil,
+				"",
+				"",
+				"",
+			},
+			false,
+		},
+		{
+			"invalid new consumer genesis state: invalid ccv connection id",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"conn",
+				"",
+				nil,
+				"",
+				"",
+				"",
+			},
+			true,
+		},
+		{
+			"invalid new consumer genesis state: invalid ccv channel id",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"channel/0",
+				nil,
+				"",
+				"",
+				"",
+			},
+			true,
+		},
+		{
+			"valid new consumer genesis state with expected provider identifiers",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				nil,
+				"connection-1",
+				"channel-1",
+				"",
+			},
+			false,
+		},
+		{
+			"invalid new consumer genesis state: invalid expected provider connection id",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				nil,
+				"conn",
+				"",
+				"",
+			},
+			true,
+		},
+		{
+			"invalid new consumer genesis state: invalid expected provider channel id",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"channel/1",
+				"",
+			},
+			true,
+		},
+		{
+			"valid new consumer genesis state with min gas prices",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"0.01ufoo",
+			},
+			false,
+		},
+		{
+			"invalid new consumer genesis state: invalid min gas prices",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"ufoo",
+			},
+			true,
+		},
+		{
+			"invalid new consumer genesis state: invalid relayer allowlist",
+			&types.GenesisState{
+				params,
+				"",
+				"",
+				true,
+				cs,
+				consensusState,
+				nil,
+				valUpdates,
+				nil,
+				nil,
+				ccv.ConsumerPacketDataList{},
+				types.LastTransmissionBlockHeight{},
+				false,
+				nil,
+				"",
+				"",
+				[]string{"cosmos1invalid"},
+				"",
+				"",
+				"",
+			},
+			true,
+		},
 		{
 			"invalid new consumer genesis state: nil client state",
 			types.NewInitialGenesisState(nil, consensusState, valUpdates, params),
@@ -101,6 +338,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -120,6 +364,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -139,6 +390,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -158,6 +416,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{Height: 1},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -177,6 +442,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{{}}},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -343,6 +615,13 @@ func TestValidateRestartGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -362,6 +641,13 @@ func TestValidateRestartGenesisState(t *testing.T) {
 				ccv.ConsumerPacketDataList{},
 				types.LastTransmissionBlockHeight{},
 				false,
+				nil,
+				"",
+				"",
+				nil,
+				"",
+				"",
+				"",
 			},
 			true,
 		},
@@ -436,3 +722,40 @@ func TestValidateRestartGenesisState(t *testing.T) {
 		}
 	}
 }
+
+// TestGenesisStateCanonicalJSON tests that the canonical JSON encoding of a
+// genesis state is deterministic and that its hash matches the encoding
+func TestGenesisStateCanonicalJSON(t *testing.T) {
+	cId := crypto.NewCryptoIdentityFromIntSeed(238934)
+	pubKey := cId.TMCryptoPubKey()
+
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	valUpdates := tmtypes.TM2PB.ValidatorUpdates(valSet)
+
+	cs := ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+	consensusState := ibctmtypes.NewConsensusState(time.Now(), commitmenttypes.NewMerkleRoot([]byte("apphash")), valSet.Hash())
+
+	gs := types.NewInitialGenesisState(cs, consensusState, valUpdates, types.DefaultParams())
+
+	bz, err := gs.CanonicalJSON()
+	require.NoError(t, err)
+
+	// keys are already sorted and whitespace is removed
+	sorted, err := sdk.SortJSON(bz)
+	require.NoError(t, err)
+	require.Equal(t, sorted, bz)
+
+	// a genesis state decoded from the canonical encoding has the same encoding
+	decoded := types.GenesisState{}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	decodedBz, err := decoded.CanonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, bz, decodedBz)
+
+	hash, err := gs.CanonicalHash()
+	require.NoError(t, err)
+	expectedHash := sha256.Sum256(bz)
+	require.Equal(t, expectedHash[:], hash)
+}
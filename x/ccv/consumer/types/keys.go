@@ -89,6 +89,19 @@ const (
 	// CrossChainValidatorPrefix is the byte prefix that will store cross-chain validators by consensus address
 	CrossChainValidatorBytePrefix
 
+	// ExpectedCCVConnectionIDByteKey is the byte key storing the expected connectionID of the CCV channel
+	ExpectedCCVConnectionIDByteKey
+
+	// ExpectedCCVChannelIDByteKey is the byte key storing the expected channelID of the CCV channel
+	ExpectedCCVChannelIDByteKey
+
+	// RelayerAllowlistBytePrefix is the byte prefix that will store the relayers allowed to relay CCV packets by address
+	RelayerAllowlistBytePrefix
+
+	// PendingVSCChunksByteKey is the byte key storing the received chunks of a VSC packet
+	// split into chunks by the provider, until its last chunk is received
+	PendingVSCChunksByteKey
+
 	// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO getAllKeyPrefixes() IN keys_test.go
 )
 
@@ -160,6 +173,21 @@ func PrevStandaloneChainKey() []byte {
 	return []byte{PrevStandaloneChainByteKey}
 }
 
+// ExpectedCCVConnectionIDKey returns the key to the expected connectionID of the CCV channel
+func ExpectedCCVConnectionIDKey() []byte {
+	return []byte{ExpectedCCVConnectionIDByteKey}
+}
+
+// ExpectedCCVChannelIDKey returns the key to the expected channelID of the CCV channel
+func ExpectedCCVChannelIDKey() []byte {
+	return []byte{ExpectedCCVChannelIDByteKey}
+}
+
+// PendingVSCChunksKey returns the key to the received chunks of a VSC packet
+func PendingVSCChunksKey() []byte {
+	return []byte{PendingVSCChunksByteKey}
+}
+
 // HistoricalInfoKey returns the key to historical info to a given block height
 func HistoricalInfoKey(height int64) []byte {
 	hBytes := make([]byte, 8)
@@ -197,6 +225,11 @@ func CrossChainValidatorKey(addr []byte) []byte {
 	return append([]byte{CrossChainValidatorBytePrefix}, addr...)
 }
 
+// RelayerAllowlistKey returns the key to a relayer allowed to relay CCV packets by address
+func RelayerAllowlistKey(addr []byte) []byte {
+	return append([]byte{RelayerAllowlistBytePrefix}, addr...)
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
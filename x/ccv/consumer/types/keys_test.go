@@ -39,6 +39,10 @@ func getAllKeyPrefixes() []byte {
 		HeightValsetUpdateIDBytePrefix,
 		OutstandingDowntimeBytePrefix,
 		CrossChainValidatorBytePrefix,
+		ExpectedCCVConnectionIDByteKey,
+		ExpectedCCVChannelIDByteKey,
+		RelayerAllowlistBytePrefix,
+		PendingVSCChunksByteKey,
 	}
 }
 
@@ -73,5 +77,9 @@ func getAllFullyDefinedKeys() [][]byte {
 		HeightValsetUpdateIDKey(0),
 		OutstandingDowntimeKey([]byte{}),
 		CrossChainValidatorKey([]byte{}),
+		ExpectedCCVConnectionIDKey(),
+		ExpectedCCVChannelIDKey(),
+		RelayerAllowlistKey([]byte{}),
+		PendingVSCChunksKey(),
 	}
 }
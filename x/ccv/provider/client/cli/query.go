@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,6 +18,20 @@ import (
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 )
 
+const (
+	// FlagSHA256 is the flag used to print the SHA256 hash of the consumer genesis state
+	FlagSHA256 = "sha256"
+	// FlagCanonical is the flag used to print the canonical JSON encoding of the consumer genesis state
+	FlagCanonical = "canonical"
+	// FlagClientStatus is the flag used to filter consumer chains by the status of their clients
+	FlagClientStatus = "status"
+	// FlagAppState is the flag used to print the app_state of the consumer genesis,
+	// i.e., including the genesis states of other modules of the consumer chain
+	FlagAppState = "app-state"
+	// FlagProviderChainID is the flag used to print the provider chain id embedded in the consumer genesis
+	FlagProviderChainID = "provider-chain-id"
+)
+
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,17 +50,77 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdProviderValidatorKey())
 	cmd.AddCommand(CmdThrottleState())
 	cmd.AddCommand(CmdThrottledConsumerPacketData())
+	cmd.AddCommand(CmdConsumerSlashEnabled())
+	cmd.AddCommand(CmdRelayerPathConfig())
+	cmd.AddCommand(CmdConsumerRewardsTotals())
+	cmd.AddCommand(CmdProviderConsensusStateForConsumer())
+	cmd.AddCommand(CmdUnassignedValidators())
+	cmd.AddCommand(CmdConsumerChainClients())
+	cmd.AddCommand(CmdConsumerChainPhase())
+	cmd.AddCommand(CmdCcvVersion())
+	cmd.AddCommand(CmdVscMaturityTime())
+	cmd.AddCommand(CmdConsumerJailedValidators())
+	cmd.AddCommand(CmdConsumerClientInitialHeight())
+	cmd.AddCommand(CmdConsumerKeyAssignments())
+	cmd.AddCommand(CmdConsumerCreationUnbondingTime())
+	cmd.AddCommand(CmdValidatorByConsumerAddr())
+	cmd.AddCommand(CmdConsumerRelationships())
+	cmd.AddCommand(CmdRecentSpawns())
+	cmd.AddCommand(CmdTemplateClient())
+	cmd.AddCommand(CmdConsumerClientLatestUpdate())
+	cmd.AddCommand(CmdConsumerClientExpiry())
+	cmd.AddCommand(CmdRewardDenomAllowlist())
+	cmd.AddCommand(CmdRelayerAllowlist())
+	cmd.AddCommand(CmdValidatorConsumerChains())
+	cmd.AddCommand(CmdConsumerNextVscId())
+	cmd.AddCommand(CmdConsumerGenesisDiff())
+	cmd.AddCommand(CmdCcvStats())
+	cmd.AddCommand(CmdConsumerInitParams())
+	cmd.AddCommand(CmdConsumerSpawnHeight())
+	cmd.AddCommand(CmdPendingValidatorApprovals())
+	cmd.AddCommand(CmdFailedConsumerAdditionProposals())
+	cmd.AddCommand(CmdHasAssignedConsumerKey())
+	cmd.AddCommand(CmdConsumerIntendedParams())
+	cmd.AddCommand(CmdConsumerStateDump())
+	cmd.AddCommand(CmdPendingStoppedChains())
+	cmd.AddCommand(CmdConsumerLatestSeenHeight())
+	cmd.AddCommand(CmdPendingChainSpawnCountdown())
+	cmd.AddCommand(CmdConsumerOptedOutValidators())
+	cmd.AddCommand(CmdConsumerClientHistory())
 
 	return cmd
 }
 
-// NewQuerySubspaceParamsCmd returns a CLI command handler for querying subspace
-// parameters managed by the x/params module.
+// CmdConsumerGenesis returns a CLI command handler for querying the genesis state
+// of a consumer chain whose proposal has been accepted
 func CmdConsumerGenesis() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consumer-genesis [chainid]",
 		Short: "Query for consumer chain genesis state by chain id",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer chain CCV genesis state. With the --%s flag, the canonical JSON encoding
+of the genesis state is returned instead, i.e., with sorted keys and without whitespace, for which all nodes
+return byte-identical output for the same chain.
+The SHA256 hash of the genesis state can be obtained with the --%s flag. It must match the hash of the
+canonical output, e.g., with $(%s query provider consumer-genesis foochain --%s | tr -d '\n' | sha256sum).
+With the --%s flag, the app_state of the consumer genesis is returned instead, i.e., the CCV genesis state
+merged with the genesis states of other modules carried by the consumer addition proposal,
+and with the bank module balances of the accounts pre-funded by the proposal.
+With the --%s flag, only the chain id of the provider chain the consumer chain is anchored to is returned,
+e.g., to detect a consumer genesis downloaded from the wrong provider network.
+Example:
+$ %s query provider consumer-genesis foochain
+$ %s query provider consumer-genesis foochain --%s
+$ %s query provider consumer-genesis foochain --%s
+$ %s query provider consumer-genesis foochain --%s
+$ %s query provider consumer-genesis foochain --%s
+`,
+				FlagCanonical, FlagSHA256, version.AppName, FlagCanonical, FlagAppState, FlagProviderChainID,
+				version.AppName, version.AppName, FlagCanonical, version.AppName, FlagSHA256,
+				version.AppName, FlagAppState, version.AppName, FlagProviderChainID,
+			),
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -56,11 +134,50 @@ func CmdConsumerGenesis() *cobra.Command {
 				return err
 			}
 
+			bz, err := res.GenesisState.CanonicalJSON()
+			if err != nil {
+				return err
+			}
+
+			// verify that the received genesis state matches the hash computed by the node
+			hash := sha256.Sum256(bz)
+			if hex.EncodeToString(hash[:]) != res.CanonicalHash {
+				return fmt.Errorf("consumer genesis hash mismatch: expected %s, got %x", res.CanonicalHash, hash)
+			}
+
+			if printHash, _ := cmd.Flags().GetBool(FlagSHA256); printHash {
+				return clientCtx.PrintString(res.CanonicalHash + "\n")
+			}
+
+			if printProviderChainID, _ := cmd.Flags().GetBool(FlagProviderChainID); printProviderChainID {
+				return clientCtx.PrintString(res.ProviderChainId + "\n")
+			}
+
+			if printAppState, _ := cmd.Flags().GetBool(FlagAppState); printAppState {
+				appState, err := types.MergeConsumerAppState(nil, res.AdditionalGenesisState, res.InitialBalances, bz)
+				if err != nil {
+					return err
+				}
+				appStateBz, err := json.Marshal(appState)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintBytes(append(appStateBz, '\n'))
+			}
+
+			if printCanonical, _ := cmd.Flags().GetBool(FlagCanonical); printCanonical {
+				return clientCtx.PrintBytes(append(bz, '\n'))
+			}
+
 			return clientCtx.PrintProto(&res.GenesisState)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagSHA256, false, "Print only the SHA256 hash of the canonical consumer genesis state")
+	cmd.Flags().Bool(FlagCanonical, false, "Print the canonical JSON encoding of the consumer genesis state")
+	cmd.Flags().Bool(FlagAppState, false, "Print the app_state of the consumer genesis, including the genesis states of other modules")
+	cmd.Flags().Bool(FlagProviderChainID, false, "Print only the chain id of the provider chain embedded in the consumer genesis")
 
 	return cmd
 }
@@ -69,7 +186,16 @@ func CmdConsumerChains() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-consumer-chains",
 		Short: "Query active consumer chains for provider chain.",
-		Args:  cobra.ExactArgs(0),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query active consumer chains for provider chain, optionally filtered by the status of their clients.
+Example:
+$ %s query provider list-consumer-chains
+$ %s query provider list-consumer-chains --%s expired
+`,
+				version.AppName, version.AppName, FlagClientStatus,
+			),
+		),
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -77,7 +203,12 @@ func CmdConsumerChains() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryConsumerChainsRequest{}
+			clientStatus, err := cmd.Flags().GetString(FlagClientStatus)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainsRequest{Status: clientStatus}
 			res, err := queryClient.QueryConsumerChains(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -88,6 +219,7 @@ func CmdConsumerChains() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagClientStatus, "all", "Filter consumer chains by client status: active, expired, frozen or all")
 
 	return cmd
 }
@@ -320,3 +452,1467 @@ $ %s query provider throttled-consumer-packet-data foochain
 
 	return cmd
 }
+
+func CmdConsumerSlashEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-enabled [chainid]",
+		Short: "Query whether downtime infractions on a consumer chain are slashed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns whether downtime infractions committed on a consumer chain result in
+the validator being slashed on the provider chain. If not, the validator is only jailed.
+Example:
+$ %s query provider slash-enabled foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerChainSlashEnabledRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerChainSlashEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RelayerPathEnd defines one end of the CCV path in a relayer path config
+type RelayerPathEnd struct {
+	ChainId  string `json:"chain-id"`
+	ClientId string `json:"client-id"`
+}
+
+// RelayerPathConfig defines a relayer path config for the CCV path between
+// the provider chain and a consumer chain
+type RelayerPathConfig struct {
+	// Src is the provider chain end of the path
+	Src RelayerPathEnd `json:"src"`
+	// Dst is the consumer chain end of the path
+	Dst RelayerPathEnd `json:"dst"`
+	// TrustingPeriod is the trusting period of the consumer's client of the provider chain
+	TrustingPeriod string `json:"trusting-period"`
+	// UnbondingPeriod is the unbonding period of the consumer chain
+	UnbondingPeriod string `json:"unbonding-period"`
+}
+
+func CmdRelayerPathConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-path-config [chainid]",
+		Short: "Query the relayer path config for the CCV path of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns a relayer path config snippet for the CCV path between the provider chain and a consumer chain.
+The provider client ID is the client of the consumer chain on the provider chain. The consumer client ID is
+the client of the provider chain embedded in the consumer genesis; it is empty for a new consumer chain,
+in which case the client is created by the consumer chain on genesis.
+Example:
+$ %s query provider relayer-path-config foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID := args[0]
+			chainsRes, err := queryClient.QueryConsumerChains(cmd.Context(), &types.QueryConsumerChainsRequest{})
+			if err != nil {
+				return err
+			}
+			var providerClientID string
+			for _, chain := range chainsRes.Chains {
+				if chain.ChainId == chainID {
+					providerClientID = chain.ClientId
+					break
+				}
+			}
+			if providerClientID == "" {
+				return fmt.Errorf("cannot find client for consumer chain %s", chainID)
+			}
+
+			genRes, err := queryClient.QueryConsumerGenesis(cmd.Context(), &types.QueryConsumerGenesisRequest{ChainId: chainID})
+			if err != nil {
+				return err
+			}
+			gen := genRes.GenesisState
+			if gen.ProviderClientState == nil {
+				return fmt.Errorf("consumer genesis for chain %s has no provider client state", chainID)
+			}
+
+			config := RelayerPathConfig{
+				Src: RelayerPathEnd{
+					ChainId:  gen.ProviderClientState.ChainId,
+					ClientId: providerClientID,
+				},
+				Dst: RelayerPathEnd{
+					ChainId:  chainID,
+					ClientId: gen.ProviderClientId,
+				},
+				TrustingPeriod:  gen.ProviderClientState.TrustingPeriod.String(),
+				UnbondingPeriod: gen.Params.UnbondingPeriod.String(),
+			}
+
+			bz, err := json.MarshalIndent(config, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(append(bz, '\n'))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardsTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-rewards-totals [chainid]",
+		Short: "Query the accumulated rewards received from a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the accumulated rewards received from the given consumer chain,
+split between the community pool and the fee collector (i.e., validators and delegators).
+Example:
+$ %s query provider consumer-rewards-totals foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRewardsTotalsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerRewardsTotals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderConsensusStateForConsumer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-consensus-state [chainid]",
+		Short: "Query the provider consensus state used in the genesis of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider consensus state embedded in the genesis of a consumer chain,
+together with the provider chain height it corresponds to. For consumer chains whose addition
+proposal is still pending, the current self consensus state of the provider chain is returned.
+Example:
+$ %s query provider provider-consensus-state foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderConsensusStateForConsumerRequest{ChainId: args[0]}
+			res, err := queryClient.QueryProviderConsensusStateForConsumer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnassignedValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unassigned-validators [chainid]",
+		Short: "Query the validators that have not assigned a key for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider chain validators in the consumer validator set
+that have not assigned a consumer key for the given consumer chain, i.e., the validators
+that will use their provider chain key to validate the consumer chain.
+Example:
+$ %s query provider unassigned-validators foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnassignedValidatorsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryUnassignedValidators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-clients [chainid]",
+		Short: "Query the clients of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the client used by the provider chain for a consumer chain and,
+if a client migration is in progress, the candidate client that replaces it once promoted.
+Example:
+$ %s query provider consumer-clients foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerChainClientsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerChainClients(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainPhase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-phase [chainid]",
+		Short: "Query the lifecycle phase of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the current lifecycle phase of a consumer chain, i.e., one of
+pending, client created, channel established, active, stopping or stopped.
+For a stopping consumer chain, the time remaining until it is stopped and removed is returned as well.
+Example:
+$ %s query provider consumer-phase foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerChainPhaseRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerChainPhase(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCcvVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ccv-version",
+		Short: "Query the CCV protocol version supported by the provider chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the CCV protocol version supported by the provider chain.
+Consumer chains must open the CCV channel with this version.
+Example:
+$ %s query provider ccv-version
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCcvVersionRequest{}
+			res, err := queryClient.QueryCcvVersion(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVscMaturityTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vsc-maturity-time [chainid] [vscid]",
+		Short: "Query the maturity time of a VSC packet sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the time at which a VSC packet sent to a consumer chain
+matures on the consumer chain, i.e., the send time plus the consumer unbonding period.
+Example:
+$ %s query provider vsc-maturity-time foochain 10
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVscMaturityTimeRequest{ChainId: args[0], VscId: vscID}
+			res, err := queryClient.QueryVscMaturityTime(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerJailedValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-jailed-validators [chainid]",
+		Short: "Query the validators jailed due to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider consensus addresses of the validators that are
+jailed due to an infraction committed on the given consumer chain.
+Example:
+$ %s query provider consumer-jailed-validators foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerJailedValidatorsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerJailedValidators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientInitialHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-initial-height [chainid]",
+		Short: "Query the initial height of a consumer client",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the initial height the client of the given consumer chain was created with,
+regardless of the latest height of the client.
+Example:
+$ %s query provider consumer-client-initial-height foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientInitialHeightRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerClientInitialHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerKeyAssignments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-key-assignments [chainid]",
+		Short: "Query the consumer keys assigned by validators for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer keys assigned by validators for the given consumer chain,
+i.e., the mapping from validator addresses on the provider chain to consumer keys and addresses,
+and whether a validator has a pending key rotation.
+Example:
+$ %s query provider consumer-key-assignments foochain
+$ %s query provider consumer-key-assignments foochain --limit 100
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerKeyAssignmentsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryConsumerKeyAssignments(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer key assignments")
+
+	return cmd
+}
+
+func CmdConsumerCreationUnbondingTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-creation-unbonding-time [chainid]",
+		Short: "Query the provider unbonding time a consumer client was created with",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider unbonding time when the client of the given consumer chain was created,
+regardless of later changes to the staking unbonding time param.
+Example:
+$ %s query provider consumer-creation-unbonding-time foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerCreationUnbondingTimeRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerCreationUnbondingTime(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorByConsumerAddr() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-by-consumer-addr [chainid] [consumer-validator-address]",
+		Short: "Query the provider validator a consumer consensus address is attributed to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consensus and operator addresses of the provider validator
+that the given consumer consensus address is attributed to, e.g., when handling slash packets.
+Example:
+$ %s query provider validator-by-consumer-addr foochain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorByConsumerAddrRequest{
+				ChainId:         args[0],
+				ConsumerAddress: addr.String(),
+			}
+			res, err := queryClient.QueryValidatorByConsumerAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRelationships() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-relationships",
+		Short: "Query the relationships with all consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the chain id, client id, phase, init height and client status of all consumer chains
+that have a client, together with the provider block height at which they were read.
+A consistent view across pages can be obtained by querying all pages at the same --height.
+Example:
+$ %s query provider consumer-relationships
+$ %s query provider consumer-relationships --limit 100 --height 1000
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerRelationshipsRequest{Pagination: pageReq}
+			res, err := queryClient.QueryConsumerRelationships(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer relationships")
+
+	return cmd
+}
+
+func CmdRecentSpawns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-spawns",
+		Short: "Query the log of recent consumer chain spawns",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the chain id, client id, spawn time and block height of the recently
+created consumer clients, ordered from the most recent spawn.
+The number of logged spawns is bounded by the MaxRecentSpawns param.
+Example:
+$ %s query provider recent-spawns
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRecentSpawnsRequest{}
+			res, err := queryClient.QueryRecentSpawns(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTemplateClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template-client",
+		Short: "Query the template client in the provider params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the template client state, i.e., the base client state of the clients
+created for new consumer chains, including the proof specs, the max clock drift,
+the trust level and the upgrade path.
+Example:
+$ %s query provider template-client
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTemplateClientRequest{}
+			res, err := queryClient.QueryTemplateClient(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientLatestUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-latest-update [chainid]",
+		Short: "Query the timestamp of the latest consensus state of a consumer client",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the latest height and the timestamp of the latest consensus state of the client
+of the given consumer chain, together with the time elapsed since, relative to the latest block time.
+A growing elapsed time indicates that the headers of the consumer chain stopped arriving,
+before the client actually expires.
+Example:
+$ %s query provider consumer-client-latest-update foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientLatestUpdateRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerClientLatestUpdate(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-expiry [chainid]",
+		Short: "Query the time remaining until a consumer client expires",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the time at which the client of the given consumer chain expires if no header arrives,
+i.e., the timestamp of its latest consensus state plus its trusting period, together with the time remaining
+until then, relative to the latest block time. A zero or negative remaining time indicates that the client is expired.
+Example:
+$ %s query provider consumer-client-expiry foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientExpiryRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerClientExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerGenesisDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-genesis-diff [chainid]",
+		Short: "Query the differences between the stored and a fresh consumer genesis",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the validators added to, removed from, or with a changed power in the initial validator set
+of a consumer genesis computed in the latest block compared to the stored consumer genesis of the given consumer chain,
+whether the params differ, and the fresh consumer genesis, e.g., to plan a coordinated consumer restart.
+Example:
+$ %s query provider consumer-genesis-diff foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerGenesisDiffRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerGenesisDiff(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRewardDenomAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-denom-allowlist [chainid]",
+		Short: "Query the denoms a consumer chain may send as rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the reward denom allowlist of the given consumer chain, i.e., the denoms
+(as denominated on the consumer chain) the consumer chain may send to the consumer rewards pool.
+An empty list indicates that all denoms are accepted.
+Example:
+$ %s query provider reward-denom-allowlist foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardDenomAllowlistRequest{ChainId: args[0]}
+			res, err := queryClient.QueryRewardDenomAllowlist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRelayerAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-allowlist [chainid]",
+		Short: "Query the relayers a consumer chain accepts CCV packets from",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the relayer allowlist of the given consumer chain, i.e., the addresses
+of the relayers the consumer chain accepts CCV packets and acknowledgements from.
+An empty list indicates that all relayers are accepted.
+Example:
+$ %s query provider relayer-allowlist foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRelayerAllowlistRequest{ChainId: args[0]}
+			res, err := queryClient.QueryRelayerAllowlist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorConsumerChains() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-consumer-chains [provider-validator-address]",
+		Short: "Query the consumer chains a validator is in the validator set of",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the chain ids of the consumer chains whose current validator set
+the given validator is in, i.e., the consumer chains the validator must run a node for.
+Example:
+$ %s query provider validator-consumer-chains %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorConsumerChainsRequest{ProviderAddress: args[0]}
+			res, err := queryClient.QueryValidatorConsumerChains(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerNextVscId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-next-vsc-id [chainid]",
+		Short: "Query the next VSC ID and the last matured VSC ID of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the ID the provider assigns to the next VSC packet and the highest ID
+of the VSC packets matured on the given consumer chain, i.e., the VSC packets with IDs
+in between are not matured yet.
+Example:
+$ %s query provider consumer-next-vsc-id foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerNextVscIdRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerNextVscId(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCcvStats returns a CLI command handler for querying aggregate counts across all consumer chains
+func CmdCcvStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ccv-stats",
+		Short: "Query aggregate counts across all consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the number of active, pending and stopped consumer chains, the total number of
+VSC packets queued to be sent to the consumer chains, and the total provider voting power of the
+validators validating at least one consumer chain.
+Example:
+$ %s query provider ccv-stats
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCcvStatsRequest{}
+			res, err := queryClient.QueryCcvStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumerInitParams returns a CLI command handler for querying the initialization parameters of a consumer chain
+func CmdConsumerInitParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-init-params [chainid]",
+		Short: "Query the initialization parameters a consumer chain was spawned with",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the initialization parameters a consumer chain was spawned with, i.e., the
+parameters of its consumer addition proposal with the defaults applied.
+Example:
+$ %s query provider consumer-init-params foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerInitParamsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerInitParams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumerSpawnHeight returns a CLI command handler for querying the provider block height
+// at which a consumer chain was spawned
+func CmdConsumerSpawnHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-spawn-height [chainid]",
+		Short: "Query the provider block height at which a consumer chain was spawned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider block height at which the client of a consumer chain was created.
+Note that this is distinct from the initial height of the client, which is a height of the consumer chain.
+Example:
+$ %s query provider consumer-spawn-height foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerSpawnHeightRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerSpawnHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdPendingValidatorApprovals returns a CLI command handler for querying the validators
+// waiting for an approval to join the validator set of a consumer chain
+func CmdPendingValidatorApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-validator-approvals [chainid]",
+		Short: "Query the validators waiting for an approval to join the validator set of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the provider consensus addresses of the validators that joined the top N
+but are left out of the validator set of a consumer chain until they are approved.
+Example:
+$ %s query provider pending-validator-approvals foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingValidatorApprovalsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryPendingValidatorApprovals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFailedConsumerAdditionProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-consumer-addition-proposals",
+		Short: "Query the consumer addition proposals for which the consumer client could not be created",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the failed consumer addition proposals, ordered by chain ID,
+together with the last error and the number of failures of each consumer chain.
+Example:
+$ %s query provider failed-consumer-addition-proposals
+$ %s query provider failed-consumer-addition-proposals --limit 100
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedConsumerAdditionProposalsRequest{Pagination: pageReq}
+			res, err := queryClient.QueryFailedConsumerAdditionProposals(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed consumer addition proposals")
+
+	return cmd
+}
+
+func CmdHasAssignedConsumerKey() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "has-assigned-consumer-key [chainid] [provider-validator-address]",
+		Short: "Query whether a validator has assigned a consumer key for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns whether a validator has assigned a consensus public key for a consumer chain
+and, if so, the assigned key, e.g., to verify a key assignment before the consumer chain is spawned.
+Example:
+$ %s query provider has-assigned-consumer-key foochain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHasAssignedConsumerKeyRequest{
+				ChainId:         args[0],
+				ProviderAddress: addr.String(),
+			}
+			res, err := queryClient.QueryHasAssignedConsumerKey(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerIntendedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-intended-params [chainid]",
+		Short: "Query the consumer CCV params the provider set in the consumer genesis",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer CCV params the provider set in the consumer genesis of a consumer chain,
+i.e., the params the consumer chain is intended to run with, e.g., to detect params modified after launch.
+Example:
+$ %s query provider consumer-intended-params foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerIntendedParamsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerIntendedParams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerStateDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-state-dump [chainid]",
+		Short: "Query a snapshot of the state the provider keeps for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns in one call the client id, channel id, phase, init params, current validator set,
+outstanding VSC IDs, slash history count and metadata the provider keeps for a consumer chain,
+e.g., for migration tooling and debugging.
+Example:
+$ %s query provider consumer-state-dump foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerStateDumpRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerStateDump(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingStoppedChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-stopped-chains",
+		Short: "Query the consumer chains scheduled to stop and their stop times",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the consumer chains with a pending consumer removal proposal, ordered by the time
+at which they are stopped and removed (i.e., including the cooldown, if any), e.g., to wind down
+the relaying of CCV packets ahead of a planned consumer decommission.
+Example:
+$ %s query provider pending-stopped-chains
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingStoppedChainsRequest{}
+			res, err := queryClient.QueryPendingStoppedChains(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerLatestSeenHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-latest-seen-height [chainid]",
+		Short: "Query the latest height of a consumer chain seen by the provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the latest height of a consumer chain seen by the provider via the updates
+of the consumer client, as recorded at the end of every block.
+Example:
+$ %s query provider consumer-latest-seen-height foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerLatestSeenHeightRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerLatestSeenHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingChainSpawnCountdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-chain-spawn-countdown [chainid]",
+		Short: "Query the time remaining until a pending consumer chain is spawned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the spawn time of a pending consumer chain, i.e., of its consumer addition proposal,
+and the time remaining until then. The remaining time is zero if the spawn time has passed,
+i.e., if the consumer chain is spawned in the next block.
+Example:
+$ %s query provider pending-chain-spawn-countdown foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingChainSpawnCountdownRequest{ChainId: args[0]}
+			res, err := queryClient.QueryPendingChainSpawnCountdown(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerOptedOutValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-opted-out-validators [chainid]",
+		Short: "Query the validators that soft opted out on a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the soft opt-out threshold of a consumer chain and the validators that are
+currently exempt from downtime slashing on it, i.e., the validators at the bottom of the validator set
+whose total power is at most the threshold of the total power, ordered by ascending power.
+Example:
+$ %s query provider consumer-opted-out-validators foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerOptedOutValidatorsRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerOptedOutValidators(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-history [chainid]",
+		Short: "Query the history of the clients of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the clients a consumer chain has used, i.e., the current consumer client and the retired ones,
+e.g., replaced via a client reset, ordered from the oldest client. Every client is returned with the provider
+block height and time from which on it was the consumer client. Only the most recent clients are retained.
+Example:
+$ %s query provider consumer-client-history foochain
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientHistoryRequest{ChainId: args[0]}
+			res, err := queryClient.QueryConsumerClientHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
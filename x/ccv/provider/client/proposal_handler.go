@@ -13,19 +13,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	"github.com/spf13/cobra"
 )
 
 var (
-	ConsumerAdditionProposalHandler = govclient.NewProposalHandler(SubmitConsumerAdditionPropTxCmd, ConsumerAdditionProposalRESTHandler)
-	ConsumerRemovalProposalHandler  = govclient.NewProposalHandler(SubmitConsumerRemovalProposalTxCmd, ConsumerRemovalProposalRESTHandler)
-	EquivocationProposalHandler     = govclient.NewProposalHandler(SubmitEquivocationProposalTxCmd, EquivocationProposalRESTHandler)
+	ConsumerAdditionProposalHandler    = govclient.NewProposalHandler(SubmitConsumerAdditionPropTxCmd, ConsumerAdditionProposalRESTHandler)
+	ConsumerRemovalProposalHandler     = govclient.NewProposalHandler(SubmitConsumerRemovalProposalTxCmd, ConsumerRemovalProposalRESTHandler)
+	EquivocationProposalHandler        = govclient.NewProposalHandler(SubmitEquivocationProposalTxCmd, EquivocationProposalRESTHandler)
+	ResetConsumerClientProposalHandler = govclient.NewProposalHandler(SubmitResetConsumerClientProposalTxCmd, ResetConsumerClientProposalRESTHandler)
 )
 
 // SubmitConsumerAdditionPropTxCmd returns a CLI command handler for submitting
@@ -39,6 +42,31 @@ func SubmitConsumerAdditionPropTxCmd() *cobra.Command {
 Submit a consumer addition proposal along with an initial deposit.
 The proposal details must be supplied via a JSON file.
 Unbonding period, transfer timeout period and ccv timeout period should be provided as nanosecond time periods.
+If slash_enabled is false, validators are only jailed (not slashed) for downtime on the consumer chain.
+The consumer native unbonding period defaults to unbonding_period if omitted.
+Only the top_n bonded validators by power validate the consumer chain; top_n defaults to the default_top_n param if omitted,
+and all the bonded validators validate the consumer chain if neither is set.
+The genesis time of the consumer chain is the spawn block time plus genesis_time_offset (in nanoseconds, at most 24h).
+The optional idempotency_token is used to reject duplicate proposals for the same chain, e.g., submitted by retrying tooling.
+The launch is deferred while the total power of the initial validator set is below the optional min_provider_power.
+If the optional consumer_power_reduction is set, the consumer voting powers are computed as the validator tokens divided by it.
+If the optional power_multiplier is set (a positive decimal of at most 100), the consumer voting powers are scaled by it.
+The optional ccv_connection_id and ccv_channel_id pin the identifiers of the CCV connection and channel on the consumer chain.
+If the optional reward_denom_allowlist is set, only transfers of these denoms (as denominated on the consumer chain) are accepted as rewards.
+If the optional relayer_allowlist is set, the consumer chain only accepts CCV packets relayed by these addresses.
+If standalone_changeover is set, the consumer genesis is for an existing standalone chain changing over to a consumer chain.
+The consumer downtime jail duration (in nanoseconds) defaults to the provider downtime jail duration if omitted.
+The optional expected_provider_connection_id and expected_provider_channel_id are passed to the consumer genesis as hints for relayers.
+The max clock drift (in nanoseconds, at most one hour) of the clients of the consumer chain defaults to the template client one if omitted.
+If validator_approval_required is set, validators joining the top N are only added to the consumer chain once approved.
+The optional consumer_min_gas_prices (decimal coins, e.g., 0.01ufoo) are passed in the consumer genesis for the app config of the consumer nodes.
+The optional additional_genesis_state (a JSON object mapping module names to their genesis states, encoded as a string) is merged into the consumer app_state.
+The VSC packet timeout period (in nanoseconds) defaults to the provider ccv_timeout_period if omitted.
+If initial_val_set_height is set, the initial validator set is the one at this provider height, which must still be retained in the historical info.
+If standalone_changeover is set, standalone_latest_height must be the height of a recent header of the standalone chain; the initial height cannot be below it.
+The optional trust_level (within [1/3, 1]) of the consumer client and of the provider client in the consumer genesis defaults to the one of the template client.
+The optional initial_balances (at most 100 accounts) pre-fund accounts in the consumer bank genesis; the total supply is derived from them.
+If use_real_commitment_root is set, the launch fails unless the provider client in the consumer genesis carries the actual commitment root of the provider.
 
 Example:
 $ <appd> tx gov submit-proposal consumer-addition <path/to/proposal.json> --from=<key_or_address>
@@ -62,6 +90,32 @@ Where proposal.json contains:
     "transfer_timeout_period": 3600000000000,
     "ccv_timeout_period": 2419200000000000,
     "unbonding_period": 1728000000000000,
+    "slash_enabled": false,
+    "consumer_native_unbonding_period": 1814400000000000,
+    "top_n": 100,
+    "genesis_time_offset": 600000000000,
+    "idempotency_token": "foochain-launch-1",
+    "min_provider_power": 1000000,
+    "consumer_power_reduction": "1000",
+    "power_multiplier": "1.5",
+    "ccv_connection_id": "connection-0",
+    "ccv_channel_id": "channel-0",
+    "reward_denom_allowlist": ["ufoo"],
+    "relayer_allowlist": ["cosmos1..."],
+    "standalone_changeover": false,
+    "consumer_downtime_jail_duration": 600000000000,
+    "expected_provider_connection_id": "connection-1",
+    "expected_provider_channel_id": "channel-1",
+    "max_clock_drift": 30000000000,
+    "validator_approval_required": false,
+    "consumer_min_gas_prices": "0.01ufoo",
+    "additional_genesis_state": "{\"tokenfactory\":{\"params\":{}}}",
+    "vsc_packet_timeout_period": 2419200000000000,
+    "initial_val_set_height": 0,
+    "standalone_latest_height": 0,
+    "trust_level": {"numerator": 1, "denominator": 3},
+    "initial_balances": [{"address": "cosmos1...", "coins": [{"denom": "ufoo", "amount": "1000000"}]}],
+    "use_real_commitment_root": false,
     "deposit": "10000stake"
 }
 		`,
@@ -79,11 +133,47 @@ Where proposal.json contains:
 			// do not fail for errors regarding the unbonding period, but just log a warning
 			CheckPropUnbondingPeriod(clientCtx, proposal.UnbondingPeriod)
 
-			content := types.NewConsumerAdditionProposal(
-				proposal.Title, proposal.Description, proposal.ChainId, proposal.InitialHeight,
-				proposal.GenesisHash, proposal.BinaryHash, proposal.SpawnTime,
-				proposal.ConsumerRedistributionFraction, proposal.BlocksPerDistributionTransmission, proposal.HistoricalEntries,
-				proposal.CcvTimeoutPeriod, proposal.TransferTimeoutPeriod, proposal.UnbondingPeriod)
+			content := &types.ConsumerAdditionProposal{
+				Title:                             proposal.Title,
+				Description:                       proposal.Description,
+				ChainId:                           proposal.ChainId,
+				InitialHeight:                     proposal.InitialHeight,
+				GenesisHash:                       proposal.GenesisHash,
+				BinaryHash:                        proposal.BinaryHash,
+				SpawnTime:                         proposal.SpawnTime,
+				ConsumerRedistributionFraction:    proposal.ConsumerRedistributionFraction,
+				BlocksPerDistributionTransmission: proposal.BlocksPerDistributionTransmission,
+				HistoricalEntries:                 proposal.HistoricalEntries,
+				CcvTimeoutPeriod:                  proposal.CcvTimeoutPeriod,
+				TransferTimeoutPeriod:             proposal.TransferTimeoutPeriod,
+				UnbondingPeriod:                   proposal.UnbondingPeriod,
+				SlashEnabled:                      proposal.SlashEnabled,
+				ConsumerNativeUnbondingPeriod:     proposal.ConsumerNativeUnbondingPeriod,
+				TopN:                              proposal.TopN,
+				GenesisTimeOffset:                 proposal.GenesisTimeOffset,
+				IdempotencyToken:                  proposal.IdempotencyToken,
+				MinProviderPower:                  proposal.MinProviderPower,
+				ConsumerPowerReduction:            proposal.ConsumerPowerReduction,
+				PowerMultiplier:                   proposal.PowerMultiplier,
+				CcvConnectionId:                   proposal.CcvConnectionId,
+				CcvChannelId:                      proposal.CcvChannelId,
+				RewardDenomAllowlist:              proposal.RewardDenomAllowlist,
+				RelayerAllowlist:                  proposal.RelayerAllowlist,
+				StandaloneChangeover:              proposal.StandaloneChangeover,
+				ConsumerDowntimeJailDuration:      proposal.ConsumerDowntimeJailDuration,
+				ExpectedProviderConnectionId:      proposal.ExpectedProviderConnectionId,
+				ExpectedProviderChannelId:         proposal.ExpectedProviderChannelId,
+				MaxClockDrift:                     proposal.MaxClockDrift,
+				ValidatorApprovalRequired:         proposal.ValidatorApprovalRequired,
+				ConsumerMinGasPrices:              proposal.ConsumerMinGasPrices,
+				AdditionalGenesisState:            proposal.AdditionalGenesisState,
+				VscPacketTimeoutPeriod:            proposal.VscPacketTimeoutPeriod,
+				InitialValSetHeight:               proposal.InitialValSetHeight,
+				StandaloneLatestHeight:            proposal.StandaloneLatestHeight,
+				TrustLevel:                        proposal.TrustLevel,
+				InitialBalances:                   proposal.InitialBalances,
+				UseRealCommitmentRoot:             proposal.UseRealCommitmentRoot,
+			}
 
 			from := clientCtx.GetFromAddress()
 
@@ -122,6 +212,8 @@ Where proposal.json contains:
 	 "description": "It was a great chain",
 	 "chain_id": "foochain",
 	 "stop_time": "2022-01-27T15:59:50.121607-08:00",
+	 "preserve_state": false,
+	 "cooldown": 86400000000000,
 	 "deposit": "10000stake"
 }
 			`, RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,7 +228,8 @@ Where proposal.json contains:
 			}
 
 			content := types.NewConsumerRemovalProposal(
-				proposal.Title, proposal.Description, proposal.ChainId, proposal.StopTime)
+				proposal.Title, proposal.Description, proposal.ChainId, proposal.StopTime, proposal.PreserveState,
+				proposal.Cooldown)
 
 			from := clientCtx.GetFromAddress()
 
@@ -213,6 +306,73 @@ Where proposal.json contains:
 	}
 }
 
+// SubmitResetConsumerClientProposalTxCmd returns a CLI command handler for submitting
+// a reset consumer client proposal via a transaction.
+func SubmitResetConsumerClientProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset-consumer-client [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a reset consumer client proposal",
+		Long: `Submit a reset consumer client proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+If the proposal passes, the current client of the consumer chain, e.g., a frozen client, is recovered
+from a substitute client created from the trusted consensus state at the trusted height.
+
+Example:
+$ <appd> tx gov submit-proposal reset-consumer-client <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Reset the client of the FooChain",
+    "description": "The client of the FooChain is frozen, but the chain is healthy",
+    "chain_id": "foochain",
+    "trusted_height": {
+        "revision_number": 1,
+        "revision_height": 1000
+    },
+    "trusted_consensus_state": {
+        "timestamp": "2023-05-03T12:00:00Z",
+        "root": {
+            "hash": "c2VudGluZWxfcm9vdA=="
+        },
+        "next_validators_hash": "E1A3C4A4B5A5C0C1F6E0C2D1B8A6F3E9D6C2B4A3F1E2D3C4B5A6F7E8D9C0B1A2"
+    },
+    "deposit": "10000stake"
+}
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseResetConsumerClientProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewResetConsumerClientProposal(
+				proposal.Title, proposal.Description, proposal.ChainId,
+				proposal.TrustedHeight, proposal.TrustedConsensusState,
+			)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 type ConsumerAdditionProposalJSON struct {
 	Title         string             `json:"title"`
 	Description   string             `json:"description"`
@@ -222,12 +382,38 @@ type ConsumerAdditionProposalJSON struct {
 	BinaryHash    []byte             `json:"binary_hash"`
 	SpawnTime     time.Time          `json:"spawn_time"`
 
-	ConsumerRedistributionFraction    string        `json:"consumer_redistribution_fraction"`
-	BlocksPerDistributionTransmission int64         `json:"blocks_per_distribution_transmission"`
-	HistoricalEntries                 int64         `json:"historical_entries"`
-	CcvTimeoutPeriod                  time.Duration `json:"ccv_timeout_period"`
-	TransferTimeoutPeriod             time.Duration `json:"transfer_timeout_period"`
-	UnbondingPeriod                   time.Duration `json:"unbonding_period"`
+	ConsumerRedistributionFraction    string               `json:"consumer_redistribution_fraction"`
+	BlocksPerDistributionTransmission int64                `json:"blocks_per_distribution_transmission"`
+	HistoricalEntries                 int64                `json:"historical_entries"`
+	CcvTimeoutPeriod                  time.Duration        `json:"ccv_timeout_period"`
+	TransferTimeoutPeriod             time.Duration        `json:"transfer_timeout_period"`
+	UnbondingPeriod                   time.Duration        `json:"unbonding_period"`
+	SlashEnabled                      bool                 `json:"slash_enabled"`
+	ConsumerNativeUnbondingPeriod     time.Duration        `json:"consumer_native_unbonding_period"`
+	TopN                              uint32               `json:"top_n"`
+	GenesisTimeOffset                 time.Duration        `json:"genesis_time_offset"`
+	IdempotencyToken                  string               `json:"idempotency_token"`
+	MinProviderPower                  int64                `json:"min_provider_power"`
+	ConsumerPowerReduction            string               `json:"consumer_power_reduction"`
+	PowerMultiplier                   string               `json:"power_multiplier"`
+	CcvConnectionId                   string               `json:"ccv_connection_id"`
+	CcvChannelId                      string               `json:"ccv_channel_id"`
+	RewardDenomAllowlist              []string             `json:"reward_denom_allowlist"`
+	RelayerAllowlist                  []string             `json:"relayer_allowlist"`
+	StandaloneChangeover              bool                 `json:"standalone_changeover"`
+	ConsumerDowntimeJailDuration      time.Duration        `json:"consumer_downtime_jail_duration"`
+	ExpectedProviderConnectionId      string               `json:"expected_provider_connection_id"`
+	ExpectedProviderChannelId         string               `json:"expected_provider_channel_id"`
+	MaxClockDrift                     time.Duration        `json:"max_clock_drift"`
+	ValidatorApprovalRequired         bool                 `json:"validator_approval_required"`
+	ConsumerMinGasPrices              string               `json:"consumer_min_gas_prices"`
+	AdditionalGenesisState            string               `json:"additional_genesis_state"`
+	VscPacketTimeoutPeriod            time.Duration        `json:"vsc_packet_timeout_period"`
+	InitialValSetHeight               uint64               `json:"initial_val_set_height"`
+	StandaloneLatestHeight            uint64               `json:"standalone_latest_height"`
+	TrustLevel                        *ibctmtypes.Fraction `json:"trust_level"`
+	InitialBalances                   []banktypes.Balance  `json:"initial_balances"`
+	UseRealCommitmentRoot             bool                 `json:"use_real_commitment_root"`
 
 	Deposit string `json:"deposit"`
 }
@@ -244,12 +430,38 @@ type ConsumerAdditionProposalReq struct {
 	BinaryHash    []byte             `json:"binaryHash"`
 	SpawnTime     time.Time          `json:"spawnTime"`
 
-	ConsumerRedistributionFraction    string        `json:"consumer_redistribution_fraction"`
-	BlocksPerDistributionTransmission int64         `json:"blocks_per_distribution_transmission"`
-	HistoricalEntries                 int64         `json:"historical_entries"`
-	CcvTimeoutPeriod                  time.Duration `json:"ccv_timeout_period"`
-	TransferTimeoutPeriod             time.Duration `json:"transfer_timeout_period"`
-	UnbondingPeriod                   time.Duration `json:"unbonding_period"`
+	ConsumerRedistributionFraction    string               `json:"consumer_redistribution_fraction"`
+	BlocksPerDistributionTransmission int64                `json:"blocks_per_distribution_transmission"`
+	HistoricalEntries                 int64                `json:"historical_entries"`
+	CcvTimeoutPeriod                  time.Duration        `json:"ccv_timeout_period"`
+	TransferTimeoutPeriod             time.Duration        `json:"transfer_timeout_period"`
+	UnbondingPeriod                   time.Duration        `json:"unbonding_period"`
+	SlashEnabled                      bool                 `json:"slash_enabled"`
+	ConsumerNativeUnbondingPeriod     time.Duration        `json:"consumer_native_unbonding_period"`
+	TopN                              uint32               `json:"top_n"`
+	GenesisTimeOffset                 time.Duration        `json:"genesis_time_offset"`
+	IdempotencyToken                  string               `json:"idempotency_token"`
+	MinProviderPower                  int64                `json:"min_provider_power"`
+	ConsumerPowerReduction            string               `json:"consumer_power_reduction"`
+	PowerMultiplier                   string               `json:"power_multiplier"`
+	CcvConnectionId                   string               `json:"ccv_connection_id"`
+	CcvChannelId                      string               `json:"ccv_channel_id"`
+	RewardDenomAllowlist              []string             `json:"reward_denom_allowlist"`
+	RelayerAllowlist                  []string             `json:"relayer_allowlist"`
+	StandaloneChangeover              bool                 `json:"standalone_changeover"`
+	ConsumerDowntimeJailDuration      time.Duration        `json:"consumer_downtime_jail_duration"`
+	ExpectedProviderConnectionId      string               `json:"expected_provider_connection_id"`
+	ExpectedProviderChannelId         string               `json:"expected_provider_channel_id"`
+	MaxClockDrift                     time.Duration        `json:"max_clock_drift"`
+	ValidatorApprovalRequired         bool                 `json:"validator_approval_required"`
+	ConsumerMinGasPrices              string               `json:"consumer_min_gas_prices"`
+	AdditionalGenesisState            string               `json:"additional_genesis_state"`
+	VscPacketTimeoutPeriod            time.Duration        `json:"vsc_packet_timeout_period"`
+	InitialValSetHeight               uint64               `json:"initial_val_set_height"`
+	StandaloneLatestHeight            uint64               `json:"standalone_latest_height"`
+	TrustLevel                        *ibctmtypes.Fraction `json:"trust_level"`
+	InitialBalances                   []banktypes.Balance  `json:"initial_balances"`
+	UseRealCommitmentRoot             bool                 `json:"use_real_commitment_root"`
 
 	Deposit sdk.Coins `json:"deposit"`
 }
@@ -270,11 +482,13 @@ func ParseConsumerAdditionProposalJSON(proposalFile string) (ConsumerAdditionPro
 }
 
 type ConsumerRemovalProposalJSON struct {
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	ChainId     string    `json:"chain_id"`
-	StopTime    time.Time `json:"stop_time"`
-	Deposit     string    `json:"deposit"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description"`
+	ChainId       string        `json:"chain_id"`
+	StopTime      time.Time     `json:"stop_time"`
+	PreserveState bool          `json:"preserve_state"`
+	Cooldown      time.Duration `json:"cooldown"`
+	Deposit       string        `json:"deposit"`
 }
 
 type ConsumerRemovalProposalReq struct {
@@ -285,8 +499,10 @@ type ConsumerRemovalProposalReq struct {
 	Description string `json:"description"`
 	ChainId     string `json:"chainId"`
 
-	StopTime time.Time `json:"stopTime"`
-	Deposit  sdk.Coins `json:"deposit"`
+	StopTime      time.Time     `json:"stopTime"`
+	PreserveState bool          `json:"preserveState"`
+	Cooldown      time.Duration `json:"cooldown"`
+	Deposit       sdk.Coins     `json:"deposit"`
 }
 
 type EquivocationProposalJSON struct {
@@ -306,6 +522,44 @@ type EquivocationProposalReq struct {
 	Deposit sdk.Coins `json:"deposit"`
 }
 
+type ResetConsumerClientProposalJSON struct {
+	types.ResetConsumerClientProposal
+
+	Deposit string `json:"deposit"`
+}
+
+type ResetConsumerClientProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer"`
+
+	types.ResetConsumerClientProposal
+
+	Deposit sdk.Coins `json:"deposit"`
+}
+
+func ParseResetConsumerClientProposalJSON(proposalFile string) (ResetConsumerClientProposalJSON, error) {
+	proposal := ResetConsumerClientProposalJSON{}
+
+	contents, err := os.ReadFile(filepath.Clean(proposalFile))
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ResetConsumerClientProposalRESTHandler returns a ProposalRESTHandler that exposes the reset consumer client rest handler.
+func ResetConsumerClientProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reset_consumer_client",
+		Handler:  postResetConsumerClientProposalHandlerFn(clientCtx),
+	}
+}
+
 func ParseEquivocationProposalJSON(proposalFile string) (EquivocationProposalJSON, error) {
 	proposal := EquivocationProposalJSON{}
 
@@ -372,11 +626,47 @@ func postConsumerAdditionProposalHandlerFn(clientCtx client.Context) http.Handle
 			return
 		}
 
-		content := types.NewConsumerAdditionProposal(
-			req.Title, req.Description, req.ChainId, req.InitialHeight,
-			req.GenesisHash, req.BinaryHash, req.SpawnTime,
-			req.ConsumerRedistributionFraction, req.BlocksPerDistributionTransmission, req.HistoricalEntries,
-			req.CcvTimeoutPeriod, req.TransferTimeoutPeriod, req.UnbondingPeriod)
+		content := &types.ConsumerAdditionProposal{
+			Title:                             req.Title,
+			Description:                       req.Description,
+			ChainId:                           req.ChainId,
+			InitialHeight:                     req.InitialHeight,
+			GenesisHash:                       req.GenesisHash,
+			BinaryHash:                        req.BinaryHash,
+			SpawnTime:                         req.SpawnTime,
+			ConsumerRedistributionFraction:    req.ConsumerRedistributionFraction,
+			BlocksPerDistributionTransmission: req.BlocksPerDistributionTransmission,
+			HistoricalEntries:                 req.HistoricalEntries,
+			CcvTimeoutPeriod:                  req.CcvTimeoutPeriod,
+			TransferTimeoutPeriod:             req.TransferTimeoutPeriod,
+			UnbondingPeriod:                   req.UnbondingPeriod,
+			SlashEnabled:                      req.SlashEnabled,
+			ConsumerNativeUnbondingPeriod:     req.ConsumerNativeUnbondingPeriod,
+			TopN:                              req.TopN,
+			GenesisTimeOffset:                 req.GenesisTimeOffset,
+			IdempotencyToken:                  req.IdempotencyToken,
+			MinProviderPower:                  req.MinProviderPower,
+			ConsumerPowerReduction:            req.ConsumerPowerReduction,
+			PowerMultiplier:                   req.PowerMultiplier,
+			CcvConnectionId:                   req.CcvConnectionId,
+			CcvChannelId:                      req.CcvChannelId,
+			RewardDenomAllowlist:              req.RewardDenomAllowlist,
+			RelayerAllowlist:                  req.RelayerAllowlist,
+			StandaloneChangeover:              req.StandaloneChangeover,
+			ConsumerDowntimeJailDuration:      req.ConsumerDowntimeJailDuration,
+			ExpectedProviderConnectionId:      req.ExpectedProviderConnectionId,
+			ExpectedProviderChannelId:         req.ExpectedProviderChannelId,
+			MaxClockDrift:                     req.MaxClockDrift,
+			ValidatorApprovalRequired:         req.ValidatorApprovalRequired,
+			ConsumerMinGasPrices:              req.ConsumerMinGasPrices,
+			AdditionalGenesisState:            req.AdditionalGenesisState,
+			VscPacketTimeoutPeriod:            req.VscPacketTimeoutPeriod,
+			InitialValSetHeight:               req.InitialValSetHeight,
+			StandaloneLatestHeight:            req.StandaloneLatestHeight,
+			TrustLevel:                        req.TrustLevel,
+			InitialBalances:                   req.InitialBalances,
+			UseRealCommitmentRoot:             req.UseRealCommitmentRoot,
+		}
 
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
 		if rest.CheckBadRequestError(w, err) {
@@ -404,7 +694,8 @@ func postConsumerRemovalProposalHandlerFn(clientCtx client.Context) http.Handler
 		}
 
 		content := types.NewConsumerRemovalProposal(
-			req.Title, req.Description, req.ChainId, req.StopTime,
+			req.Title, req.Description, req.ChainId, req.StopTime, req.PreserveState,
+			req.Cooldown,
 		)
 
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
@@ -447,6 +738,35 @@ func postEquivocationProposalHandlerFn(clientCtx client.Context) http.HandlerFun
 	}
 }
 
+func postResetConsumerClientProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ResetConsumerClientProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewResetConsumerClientProposal(
+			req.Title, req.Description, req.ChainId, req.TrustedHeight, req.TrustedConsensusState,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
 func CheckPropUnbondingPeriod(clientCtx client.Context, propUnbondingPeriod time.Duration) {
 	queryClient := stakingtypes.NewQueryClient(clientCtx)
 
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	ibc "github.com/cosmos/ibc-go/v4/modules/core"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+var _ clienttypes.MsgServer = ClientMsgServer{}
+
+// ClientMsgServer wraps the client msg server of the IBC module of the provider chain and flags
+// the consumer clients that are successfully updated or upgraded, such that the provider module
+// records their latest heights at the end of the block, see EndBlockCHT.
+type ClientMsgServer struct {
+	clienttypes.MsgServer
+	keeper *keeper.Keeper
+}
+
+// NewClientMsgServer creates a new ClientMsgServer wrapping the given client msg server
+func NewClientMsgServer(server clienttypes.MsgServer, k *keeper.Keeper) ClientMsgServer {
+	return ClientMsgServer{
+		MsgServer: server,
+		keeper:    k,
+	}
+}
+
+// UpdateClient implements the client MsgServer interface. The client is flagged
+// as updated only if the update succeeds.
+func (s ClientMsgServer) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
+	res, err := s.MsgServer.UpdateClient(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	s.keeper.SetConsumerClientUpdated(sdk.UnwrapSDKContext(goCtx), msg.ClientId)
+	return res, nil
+}
+
+// UpgradeClient implements the client MsgServer interface. The client is flagged
+// as updated only if the upgrade succeeds.
+func (s ClientMsgServer) UpgradeClient(goCtx context.Context, msg *clienttypes.MsgUpgradeClient) (*clienttypes.MsgUpgradeClientResponse, error) {
+	res, err := s.MsgServer.UpgradeClient(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	s.keeper.SetConsumerClientUpdated(sdk.UnwrapSDKContext(goCtx), msg.ClientId)
+	return res, nil
+}
+
+// IBCAppModule wraps the IBC module of the provider chain, such that
+// its client msg server is wrapped by a ClientMsgServer
+type IBCAppModule struct {
+	ibc.AppModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCAppModule creates a new IBCAppModule for the given IBC keeper
+func NewIBCAppModule(ibcKeeper *ibckeeper.Keeper, k *keeper.Keeper) IBCAppModule {
+	return IBCAppModule{
+		AppModule: ibc.NewAppModule(ibcKeeper),
+		keeper:    k,
+	}
+}
+
+// RegisterServices implements the AppModule interface, registering the services
+// of the IBC module with its client msg server wrapped by a ClientMsgServer
+func (am IBCAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(clientMsgServerConfigurator{Configurator: cfg, keeper: am.keeper})
+}
+
+// clientMsgServerConfigurator wraps a configurator, such that
+// the client msg server registered with it is wrapped by a ClientMsgServer
+type clientMsgServerConfigurator struct {
+	module.Configurator
+	keeper *keeper.Keeper
+}
+
+func (c clientMsgServerConfigurator) MsgServer() gogogrpc.Server {
+	return clientMsgServerRegistrar{Server: c.Configurator.MsgServer(), keeper: c.keeper}
+}
+
+// clientMsgServerRegistrar wraps a msg server registrar, such that
+// the client msg server registered with it is wrapped by a ClientMsgServer
+type clientMsgServerRegistrar struct {
+	gogogrpc.Server
+	keeper *keeper.Keeper
+}
+
+func (r clientMsgServerRegistrar) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if _, ok := sd.HandlerType.(*clienttypes.MsgServer); ok {
+		ss = NewClientMsgServer(ss.(clienttypes.MsgServer), r.keeper)
+	}
+	r.Server.RegisterService(sd, ss)
+}
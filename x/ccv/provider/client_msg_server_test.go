@@ -0,0 +1,103 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/provider"
+	"github.com/gogo/protobuf/grpc"
+	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+)
+
+// clientMsgServerStub is a client msg server stub whose updates and upgrades fail if err is set
+type clientMsgServerStub struct {
+	clienttypes.MsgServer
+	err error
+}
+
+func (s clientMsgServerStub) UpdateClient(context.Context, *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &clienttypes.MsgUpdateClientResponse{}, nil
+}
+
+func (s clientMsgServerStub) UpgradeClient(context.Context, *clienttypes.MsgUpgradeClient) (*clienttypes.MsgUpgradeClientResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &clienttypes.MsgUpgradeClientResponse{}, nil
+}
+
+// TestClientMsgServer tests that only the consumer clients that are successfully updated or upgraded are flagged
+func TestClientMsgServer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		clientID   string
+		upgrade    bool
+		err        error
+		expUpdated []string
+	}{
+		{"consumer client update", "clientID", false, nil, []string{"clientID"}},
+		{"consumer client upgrade", "clientID", true, nil, []string{"clientID"}},
+		{"failed consumer client update", "clientID", false, clienttypes.ErrInvalidHeader, nil},
+		{"failed consumer client upgrade", "clientID", true, clienttypes.ErrInvalidUpgradeClient, nil},
+		{"other client update", "otherClientID", false, nil, nil},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+
+		server := provider.NewClientMsgServer(clientMsgServerStub{err: tc.err}, &providerKeeper)
+		var err error
+		if tc.upgrade {
+			_, err = server.UpgradeClient(sdk.WrapSDKContext(ctx), &clienttypes.MsgUpgradeClient{ClientId: tc.clientID})
+		} else {
+			_, err = server.UpdateClient(sdk.WrapSDKContext(ctx), &clienttypes.MsgUpdateClient{ClientId: tc.clientID})
+		}
+		require.ErrorIs(t, err, tc.err, tc.name)
+		require.Equal(t, tc.expUpdated, providerKeeper.GetAllUpdatedConsumerClients(ctx), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// serviceRecorder is a configurator stub recording the registered msg servers by service name
+type serviceRecorder struct {
+	module.Configurator
+	msgServers map[string]interface{}
+}
+
+func (r *serviceRecorder) MsgServer() grpc.Server { return r }
+
+func (r *serviceRecorder) QueryServer() grpc.Server { return r }
+
+func (r *serviceRecorder) RegisterService(sd *ggrpc.ServiceDesc, ss interface{}) {
+	r.msgServers[sd.ServiceName] = ss
+}
+
+func (r *serviceRecorder) RegisterMigration(string, uint64, module.MigrationHandler) error {
+	return nil
+}
+
+// TestIBCAppModuleRegisterServices tests that only the client msg server
+// of the IBC module is wrapped by a ClientMsgServer
+func TestIBCAppModuleRegisterServices(t *testing.T) {
+	providerKeeper, _, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ibcKeeper := &ibckeeper.Keeper{}
+	cfg := &serviceRecorder{msgServers: map[string]interface{}{}}
+	provider.NewIBCAppModule(ibcKeeper, &providerKeeper).RegisterServices(cfg)
+
+	require.IsType(t, provider.ClientMsgServer{}, cfg.msgServers["ibc.core.client.v1.Msg"])
+	require.Equal(t, ibcKeeper, cfg.msgServers["ibc.core.connection.v1.Msg"])
+	require.Equal(t, ibcKeeper, cfg.msgServers["ibc.core.channel.v1.Msg"])
+}
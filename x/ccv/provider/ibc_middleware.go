@@ -0,0 +1,84 @@
+package provider
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+)
+
+var _ porttypes.IBCModule = RewardDenomMiddleware{}
+
+// RewardDenomMiddleware wraps the transfer module of the provider chain and rejects
+// the transfers to the consumer rewards pool whose denoms are not in the reward
+// denom allowlist of the sending consumer chain. The accepted transfers are accounted
+// to the pending rewards of the sending consumer chain. The transfers from consumer chains
+// to the fee collector are redirected to the consumer rewards pool.
+type RewardDenomMiddleware struct {
+	porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewRewardDenomMiddleware creates a new RewardDenomMiddleware wrapping the given transfer module
+func NewRewardDenomMiddleware(app porttypes.IBCModule, k *keeper.Keeper) RewardDenomMiddleware {
+	return RewardDenomMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. An error acknowledgement is returned
+// if a consumer chain sends rewards in a denom that is not in its reward denom allowlist,
+// otherwise the packet is passed to the wrapped transfer module.
+func (im RewardDenomMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	// packets that cannot be decoded are rejected by the transfer module
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	poolAddr := im.keeper.GetConsumerRewardsPoolAddressStr(ctx)
+	if data.Receiver != poolAddr {
+		// consumer chains whose CCV channel was established before the consumer rewards pool
+		// was introduced send their rewards to the fee collector, as set in their params
+		// during the handshake, hence these transfers are redirected to the consumer rewards pool
+		if data.Receiver != im.keeper.GetFeeCollectorAddressStr(ctx) ||
+			!im.keeper.IsConsumerChainChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+			return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+		}
+		data.Receiver = poolAddr
+		packet.Data = data.GetBytes()
+	}
+
+	if err := im.keeper.ValidateConsumerRewardDenom(
+		ctx, packet.GetDestPort(), packet.GetDestChannel(), data.Denom,
+	); err != nil {
+		im.keeper.Logger(ctx).Info("rejected consumer rewards",
+			"channel", packet.GetDestChannel(),
+			"denom", data.Denom,
+			"error", err,
+		)
+		errAck := channeltypes.NewErrorAcknowledgement(err)
+		return &errAck
+	}
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	// the rewards are accounted to the sending consumer chain, see BeginBlockRD
+	if err := im.keeper.AddReceivedConsumerRewards(ctx, packet, data); err != nil {
+		im.keeper.Logger(ctx).Error("cannot account received consumer rewards",
+			"channel", packet.GetDestChannel(),
+			"denom", data.Denom,
+			"error", err,
+		)
+	}
+	return ack
+}
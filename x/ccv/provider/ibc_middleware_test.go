@@ -0,0 +1,136 @@
+package provider_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/provider"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// recvRecorder is a transfer module stub recording the received packet
+type recvRecorder struct {
+	porttypes.IBCModule
+	received bool
+	packet   channeltypes.Packet
+}
+
+func (r *recvRecorder) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	r.received = true
+	r.packet = packet
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// TestRewardDenomMiddlewareOnRecvPacket tests that the transfers of denoms not in the reward denom allowlist
+// of a consumer chain to the consumer rewards pool are rejected, while all other transfers are passed through,
+// that the accepted rewards are added to the pending rewards of the consumer chain, and that the transfers
+// from a consumer chain to the fee collector are redirected to the consumer rewards pool
+func TestRewardDenomMiddlewareOnRecvPacket(t *testing.T) {
+	poolAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPoolName)
+	feeCollectorAcct := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name            string
+		receiver        string
+		denom           string
+		consumerChannel bool
+		expReceived     bool
+		expRedirected   bool
+	}{
+		{"allowed denom to the consumer rewards pool", poolAcct.GetAddress().String(), "ufoo", true, true, false},
+		{"not allowed denom to the consumer rewards pool", poolAcct.GetAddress().String(), "ubar", true, false, false},
+		{"not allowed denom to another account", sdk.AccAddress([]byte("other")).String(), "ubar", true, true, false},
+		{"allowed denom to the fee collector", feeCollectorAcct.GetAddress().String(), "ufoo", true, true, true},
+		{"not allowed denom to the fee collector", feeCollectorAcct.GetAddress().String(), "ubar", true, false, true},
+		{"not allowed denom to the fee collector from another chain", feeCollectorAcct.GetAddress().String(), "ubar", false, true, false},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+		providerKeeper.SetRewardDenomAllowlist(ctx, "chainID", []string{"ufoo"})
+
+		clientChainID := "chainID"
+		if !tc.consumerChannel {
+			clientChainID = "otherChainID"
+		}
+		// expectChannelResolution expects the channel to be resolved to the chain of its client
+		expectChannelResolution := func() []*gomock.Call {
+			return []*gomock.Call{
+				mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, transfertypes.PortID, "channel-1").Return(
+					channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connectionID"}}, true,
+				).Times(1),
+				mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionID").Return(
+					conntypes.ConnectionEnd{ClientId: "clientID"}, true,
+				).Times(1),
+				mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+					&ibctmtypes.ClientState{ChainId: clientChainID}, true,
+				).Times(1),
+			}
+		}
+
+		expectations := []*gomock.Call{
+			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPoolName).Return(poolAcct).Times(1),
+		}
+		if tc.receiver != poolAcct.GetAddress().String() {
+			expectations = append(expectations,
+				mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(feeCollectorAcct).Times(1),
+			)
+			if tc.receiver == feeCollectorAcct.GetAddress().String() {
+				// the channel is resolved to check whether the transfer is from a consumer chain
+				expectations = append(expectations, expectChannelResolution()...)
+			}
+		}
+		if tc.receiver == poolAcct.GetAddress().String() || tc.expRedirected {
+			// the channel is resolved to the consumer chain to validate the denom and,
+			// once received, to add the rewards to the pending rewards of the consumer chain
+			expectations = append(expectations, expectChannelResolution()...)
+			if tc.expReceived {
+				expectations = append(expectations, expectChannelResolution()...)
+			}
+		}
+		gomock.InOrder(expectations...)
+
+		transferModule := &recvRecorder{}
+		middleware := provider.NewRewardDenomMiddleware(transferModule, &providerKeeper)
+
+		data := transfertypes.NewFungibleTokenPacketData(tc.denom, "100", "sender", tc.receiver)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-0",
+			transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+
+		ack := middleware.OnRecvPacket(ctx, packet, sdk.AccAddress{})
+		require.Equal(t, tc.expReceived, ack.Success(), tc.name)
+		require.Equal(t, tc.expReceived, transferModule.received, tc.name)
+
+		if tc.expReceived {
+			var recvData transfertypes.FungibleTokenPacketData
+			require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(transferModule.packet.GetData(), &recvData))
+			if tc.expRedirected {
+				require.Equal(t, poolAcct.GetAddress().String(), recvData.Receiver, tc.name)
+			} else {
+				require.Equal(t, tc.receiver, recvData.Receiver, tc.name)
+			}
+		}
+
+		pending := providerKeeper.GetPendingConsumerRewards(ctx, "chainID")
+		if tc.expReceived && (tc.receiver == poolAcct.GetAddress().String() || tc.expRedirected) {
+			expDenom := transfertypes.ParseDenomTrace("transfer/channel-1/" + tc.denom).IBCDenom()
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(expDenom, 100)), pending, tc.name)
+		} else {
+			require.Empty(t, pending, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
@@ -59,11 +59,12 @@ func (am AppModule) OnChanOpenTry(
 			"invalid counterparty port: %s, expected %s", counterparty.PortId, ccv.ConsumerPortID)
 	}
 
-	// ensure the counter party version matches the expected version
-	if counterpartyVersion != ccv.Version {
+	// ensure the counter party version is supported;
+	// the provider accepts the version proposed by the consumer
+	if !ccv.IsSupportedVersion(counterpartyVersion) {
 		return "", sdkerrors.Wrapf(
-			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
-			counterpartyVersion, ccv.Version)
+			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s, %s or %s",
+			counterpartyVersion, ccv.Version, ccv.Version2, ccv.Version1)
 	}
 
 	// Claim channel capability
@@ -80,12 +81,12 @@ func (am AppModule) OnChanOpenTry(
 	}
 
 	md := providertypes.HandshakeMetadata{
-		// NOTE that the fee pool collector address string provided to the
+		// NOTE that the consumer rewards pool address string provided to the
 		// the consumer chain must be excluded from the blocked addresses
 		// blacklist or all all ibc-transfers from the consumer chain to the
 		// provider chain will fail
-		ProviderFeePoolAddr: am.keeper.GetFeeCollectorAddressStr(ctx),
-		Version:             ccv.Version,
+		ProviderFeePoolAddr: am.keeper.GetConsumerRewardsPoolAddressStr(ctx),
+		Version:             counterpartyVersion,
 	}
 	mdBz, err := (&md).Marshal()
 	if err != nil {
@@ -177,7 +178,7 @@ func (am AppModule) OnRecvPacket(
 	)
 	// unmarshall consumer packet
 	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &consumerPacket); err != nil {
-		errAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal CCV packet data"))
+		errAck := ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidPacketData)
 		ack = &errAck
 	} else {
 		// TODO: call ValidateBasic method on consumer packet data
@@ -190,8 +191,11 @@ func (am AppModule) OnRecvPacket(
 		case ccv.SlashPacket:
 			// handle SlashPacket
 			ack = am.keeper.OnRecvSlashPacket(ctx, packet, *consumerPacket.GetSlashPacketData())
+		case ccv.GenesisAcceptedPacket:
+			// handle GenesisAcceptedPacket
+			ack = am.keeper.OnRecvGenesisAcceptedPacket(ctx, packet, *consumerPacket.GetGenesisAcceptedPacketData())
 		default:
-			errAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid consumer packet type: %q", consumerPacket.Type))
+			errAck := ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidPacketType)
 			ack = &errAck
 		}
 	}
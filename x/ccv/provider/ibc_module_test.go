@@ -68,6 +68,16 @@ func TestOnChanOpenTry(t *testing.T) {
 		{
 			"success", func(*params, *providerkeeper.Keeper) {}, true,
 		},
+		{
+			"success with the previous CCV version", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = ccv.Version2
+			}, true,
+		},
+		{
+			"success with the initial CCV version", func(params *params, keeper *providerkeeper.Keeper) {
+				params.counterpartyVersion = ccv.Version1
+			}, true,
+		},
 		{
 			"invalid order", func(params *params, keeper *providerkeeper.Keeper) {
 				params.order = channeltypes.UNORDERED
@@ -133,7 +143,7 @@ func TestOnChanOpenTry(t *testing.T) {
 
 		// Expected mock calls
 		moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
-		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPoolName).String()
 
 		// Number of calls is not asserted, since not all code paths are hit for failures
 		gomock.InOrder(
@@ -145,7 +155,7 @@ func TestOnChanOpenTry(t *testing.T) {
 			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIDToConsumer").Return(
 				&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
 			).AnyTimes(),
-			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(&moduleAcct).AnyTimes(),
+			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPoolName).Return(&moduleAcct).AnyTimes(),
 		)
 
 		tc.mutateParams(&params, &providerKeeper)
@@ -168,7 +178,7 @@ func TestOnChanOpenTry(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, moduleAcct.BaseAccount.Address, md.ProviderFeePoolAddr,
 				"returned dist account metadata must match expected")
-			require.Equal(t, ccv.Version, md.Version, "returned ccv version metadata must match expected")
+			require.Equal(t, params.counterpartyVersion, md.Version, "returned ccv version metadata must match expected")
 			ctrl.Finish()
 		} else {
 			require.Error(t, err)
@@ -301,6 +311,9 @@ func TestOnChanOpenConfirm(t *testing.T) {
 
 		gomock.InOrder(tc.mockExpectations(ctx, mocks)...)
 
+		// the consumer client is created before the CCV channel is established
+		providerKeeper.SetConsumerClientId(ctx, "consumerChainID", "clientID")
+
 		if tc.setDuplicateChannel {
 			providerKeeper.SetChainToChannel(ctx, "consumerChainID", "existingChannelID")
 		}
@@ -312,6 +325,8 @@ func TestOnChanOpenConfirm(t *testing.T) {
 		if tc.expPass {
 
 			require.NoError(t, err)
+			require.Equal(t, providertypes.ConsumerPhaseChannelEstablished,
+				providerKeeper.GetConsumerPhase(ctx, "consumerChainID"))
 			// Validate channel mappings
 			channelID, found := providerKeeper.GetChainToChannel(ctx, "consumerChainID")
 			require.True(t, found)
@@ -1,10 +1,338 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 )
 
 func (k Keeper) GetFeeCollectorAddressStr(ctx sdk.Context) string {
 	return k.accountKeeper.GetModuleAccount(
 		ctx, k.feeCollectorName).GetAddress().String()
 }
+
+// GetConsumerRewardsPoolAddressStr returns the address of the consumer rewards pool,
+// i.e., the address to which consumer chains send their rewards
+func (k Keeper) GetConsumerRewardsPoolAddressStr(ctx sdk.Context) string {
+	return k.accountKeeper.GetModuleAccount(
+		ctx, types.ConsumerRewardsPoolName).GetAddress().String()
+}
+
+// BeginBlockRD contains the BeginBlock logic needed for the Reward Distribution sub-protocol.
+// The rewards received from consumer chains are split between the community pool and the
+// fee collector according to the ConsumerRewardsToCommunityPoolFraction param.
+// The rewards sent to the fee collector are distributed to validators and delegators.
+//
+// The rewards are distributed per consumer chain they were received from, so that the totals
+// are accumulated per consumer chain. The remaining balance of the consumer rewards pool,
+// e.g., tokens sent to it from other chains, is distributed without being accounted.
+func (k Keeper) BeginBlockRD(ctx sdk.Context) {
+	poolAddr := k.accountKeeper.GetModuleAccount(ctx, types.ConsumerRewardsPoolName).GetAddress()
+	balance := k.bankKeeper.GetAllBalances(ctx, poolAddr)
+	if balance.IsZero() {
+		return
+	}
+
+	frac, err := sdk.NewDecFromStr(k.GetConsumerRewardsToCommunityPoolFraction(ctx))
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the fraction is validated in the param set.
+		panic(fmt.Errorf("invalid consumer rewards to community pool fraction: %w", err))
+	}
+
+	for _, chainID := range k.getChainsWithPendingConsumerRewards(ctx) {
+		// the pending rewards are capped by the balance of the pool
+		rewards := sdk.NewCoins()
+		for _, coin := range k.GetPendingConsumerRewards(ctx, chainID) {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, balance.AmountOf(coin.Denom))))
+		}
+		balance = balance.Sub(rewards)
+
+		// distribute the rewards of each consumer chain in a cached context, so that
+		// the rewards of a consumer chain that cannot be distributed remain pending
+		// without preventing the distribution of the rewards of the other consumer chains
+		cachedCtx, writeCache := ctx.CacheContext()
+		communityPoolRewards, feeCollectorRewards, err := k.distributeConsumerRewards(cachedCtx, poolAddr, rewards, frac)
+		if err != nil {
+			k.Logger(ctx).Error("cannot distribute consumer rewards", "chainID", chainID, "error", err)
+			continue
+		}
+		k.DeletePendingConsumerRewards(cachedCtx, chainID)
+
+		totals := k.GetConsumerRewardsTotals(cachedCtx, chainID)
+		totals.CommunityPool = totals.CommunityPool.Add(communityPoolRewards...)
+		totals.FeeCollector = totals.FeeCollector.Add(feeCollectorRewards...)
+		k.SetConsumerRewardsTotals(cachedCtx, chainID, totals)
+		writeCache()
+
+		k.Logger(ctx).Info("distributed consumer rewards",
+			"chainID", chainID,
+			"community pool", communityPoolRewards.String(),
+			"fee collector", feeCollectorRewards.String(),
+		)
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if _, _, err := k.distributeConsumerRewards(cachedCtx, poolAddr, balance, frac); err != nil {
+		k.Logger(ctx).Error("cannot distribute consumer rewards", "error", err)
+		return
+	}
+	writeCache()
+}
+
+// distributeConsumerRewards sends the given fraction of the given rewards of the consumer rewards pool
+// to the community pool and the rest to the fee collector. The rewards may be partially distributed
+// if an error is returned, i.e., the caller is expected to discard the state changes of the given context.
+func (k Keeper) distributeConsumerRewards(
+	ctx sdk.Context,
+	poolAddr sdk.AccAddress,
+	rewards sdk.Coins,
+	frac sdk.Dec,
+) (communityPoolRewards, feeCollectorRewards sdk.Coins, err error) {
+	communityPoolRewards, _ = sdk.NewDecCoinsFromCoins(rewards...).MulDec(frac).TruncateDecimal()
+	feeCollectorRewards = rewards.Sub(communityPoolRewards)
+
+	if !communityPoolRewards.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, communityPoolRewards, poolAddr); err != nil {
+			return nil, nil, sdkerrors.Wrap(err, "cannot send consumer rewards to community pool")
+		}
+	}
+	if !feeCollectorRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.ConsumerRewardsPoolName, k.feeCollectorName, feeCollectorRewards,
+		); err != nil {
+			return nil, nil, sdkerrors.Wrap(err, "cannot send consumer rewards to fee collector")
+		}
+	}
+	return communityPoolRewards, feeCollectorRewards, nil
+}
+
+// SetConsumerRewardsTotals sets the accumulated rewards received from the given consumer chain
+func (k Keeper) SetConsumerRewardsTotals(ctx sdk.Context, chainID string, totals types.ConsumerRewardsTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := totals.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong
+		panic(fmt.Errorf("failed to marshal consumer rewards totals: %w", err))
+	}
+	store.Set(types.ConsumerRewardsTotalsKey(chainID), bz)
+}
+
+// GetConsumerRewardsTotals returns the accumulated rewards received from the given consumer chain
+func (k Keeper) GetConsumerRewardsTotals(ctx sdk.Context, chainID string) (totals types.ConsumerRewardsTotals) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerRewardsTotalsKey(chainID))
+	if bz == nil {
+		return totals
+	}
+	if err := totals.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the totals are assumed to be correctly serialized in SetConsumerRewardsTotals.
+		panic(fmt.Errorf("failed to unmarshal consumer rewards totals: %w", err))
+	}
+	return totals
+}
+
+// DeleteConsumerRewardsTotals deletes the accumulated rewards received from the given consumer chain
+func (k Keeper) DeleteConsumerRewardsTotals(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerRewardsTotalsKey(chainID))
+}
+
+// AddPendingConsumerRewards adds the given rewards to the undistributed rewards
+// received from the given consumer chain
+func (k Keeper) AddPendingConsumerRewards(ctx sdk.Context, chainID string, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	pending := k.GetPendingConsumerRewards(ctx, chainID)
+	for _, coin := range rewards {
+		amount := pending.AmountOf(coin.Denom).Add(coin.Amount)
+		bz, err := amount.Marshal()
+		if err != nil {
+			// An error here would indicate something is very wrong
+			panic(fmt.Errorf("failed to marshal pending consumer rewards: %w", err))
+		}
+		store.Set(types.PendingConsumerRewardsKey(chainID, coin.Denom), bz)
+	}
+}
+
+// GetPendingConsumerRewards returns the undistributed rewards received from the given consumer chain
+func (k Keeper) GetPendingConsumerRewards(ctx sdk.Context, chainID string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.PendingConsumerRewardsBytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	rewards := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the amounts are assumed to be correctly serialized in AddPendingConsumerRewards.
+			panic(fmt.Errorf("failed to unmarshal pending consumer rewards: %w", err))
+		}
+		rewards = rewards.Add(sdk.NewCoin(string(iterator.Key()[len(prefix):]), amount))
+	}
+	return rewards
+}
+
+// DeletePendingConsumerRewards deletes the undistributed rewards received from the given consumer chain
+func (k Keeper) DeletePendingConsumerRewards(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.PendingConsumerRewardsBytePrefix, chainID))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// getChainsWithPendingConsumerRewards returns the IDs of the consumer chains with undistributed rewards
+func (k Keeper) getChainsWithPendingConsumerRewards(ctx sdk.Context) (chainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.PendingConsumerRewardsBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is prefix | len(chainID) | chainID | denom
+		chainIDLen := sdk.BigEndianToUint64(iterator.Key()[1:9])
+		chainID := string(iterator.Key()[9 : 9+chainIDLen])
+		if len(chainIDs) == 0 || chainIDs[len(chainIDs)-1] != chainID {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	return chainIDs
+}
+
+// SetRewardDenomAllowlist sets the denoms the given consumer chain may send as rewards,
+// replacing the previous allowlist
+func (k Keeper) SetRewardDenomAllowlist(ctx sdk.Context, chainID string, denoms []string) {
+	k.DeleteRewardDenomAllowlist(ctx, chainID)
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Set(types.RewardDenomAllowlistKey(chainID, denom), []byte{})
+	}
+}
+
+// GetRewardDenomAllowlist returns the denoms the given consumer chain may send as rewards.
+// If empty, all denoms are accepted.
+func (k Keeper) GetRewardDenomAllowlist(ctx sdk.Context, chainID string) (denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.RewardDenomAllowlistBytePrefix, chainID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+	return denoms
+}
+
+// IsRewardDenomAllowed returns whether the given consumer chain may send the given denom as rewards,
+// i.e., whether the denom is in its reward denom allowlist or the allowlist is empty
+func (k Keeper) IsRewardDenomAllowed(ctx sdk.Context, chainID, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.RewardDenomAllowlistKey(chainID, denom)) {
+		return true
+	}
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.RewardDenomAllowlistBytePrefix, chainID))
+	defer iterator.Close()
+	return !iterator.Valid()
+}
+
+// DeleteRewardDenomAllowlist deletes the reward denom allowlist of the given consumer chain
+func (k Keeper) DeleteRewardDenomAllowlist(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.RewardDenomAllowlistBytePrefix, chainID))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// ValidateConsumerRewardDenom returns an error if the given denom, as denominated on the sending chain,
+// is received on the given channel from a consumer chain whose reward denom allowlist does not contain it.
+// Transfers from chains other than consumer chains are not restricted.
+func (k Keeper) ValidateConsumerRewardDenom(ctx sdk.Context, portID, channelID, denom string) error {
+	chainID, found, err := k.getConsumerChainIdOfChannel(ctx, portID, channelID)
+	if err != nil || !found {
+		return err
+	}
+	if !k.IsRewardDenomAllowed(ctx, chainID, denom) {
+		return sdkerrors.Wrapf(types.ErrRewardDenomNotAllowed,
+			"denom %s is not in the reward denom allowlist of consumer chain %s", denom, chainID)
+	}
+	return nil
+}
+
+// AddReceivedConsumerRewards adds the tokens of the given transfer packet, received on the provider chain,
+// to the pending rewards of the consumer chain that sent them. Transfers from chains other than consumer
+// chains are ignored.
+func (k Keeper) AddReceivedConsumerRewards(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) error {
+	chainID, found, err := k.getConsumerChainIdOfChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil || !found {
+		return err
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	// the denom of the received tokens, as computed by the transfer module
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	} else {
+		sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		denom = transfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+	}
+
+	k.AddPendingConsumerRewards(ctx, chainID, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+	return nil
+}
+
+// IsConsumerChainChannel returns true if the given channel is on top of the client of a consumer chain
+func (k Keeper) IsConsumerChainChannel(ctx sdk.Context, portID, channelID string) bool {
+	_, found, err := k.getConsumerChainIdOfChannel(ctx, portID, channelID)
+	return err == nil && found
+}
+
+// getConsumerChainIdOfChannel returns the chain ID of the consumer chain at the other end of the given channel,
+// i.e., found is false if the channel is not on top of the client of a consumer chain
+func (k Keeper) getConsumerChainIdOfChannel(ctx sdk.Context, portID, channelID string) (chainID string, found bool, err error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", false, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	if len(channel.ConnectionHops) != 1 {
+		return "", false, nil
+	}
+	clientID, tmClient, err := k.getUnderlyingClient(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return "", false, err
+	}
+	consumerClientID, found := k.GetConsumerClientId(ctx, tmClient.ChainId)
+	if !found || consumerClientID != clientID {
+		return "", false, nil
+	}
+	return tmClient.ChainId, true, nil
+}
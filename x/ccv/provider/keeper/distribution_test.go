@@ -0,0 +1,391 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+// TestBeginBlockRD tests that the rewards received from consumer chains are split
+// between the community pool and the fee collector, and that the totals are accumulated
+// per consumer chain
+func TestBeginBlockRD(t *testing.T) {
+	poolAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPoolName)
+	poolAddr := poolAcct.GetAddress()
+
+	testCases := []struct {
+		name                 string
+		fraction             string
+		rewards              sdk.Coins
+		expCommunityPool     sdk.Coins
+		expFeeCollector      sdk.Coins
+		communityPoolFailure bool
+	}{
+		{
+			name:     "no rewards",
+			fraction: "0.5",
+			rewards:  sdk.NewCoins(),
+		},
+		{
+			name:            "all rewards to fee collector",
+			fraction:        "0",
+			rewards:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expFeeCollector: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:             "all rewards to community pool",
+			fraction:         "1",
+			rewards:          sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expCommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			name:             "rewards are split, community pool amount is truncated",
+			fraction:         "0.25",
+			rewards:          sdk.NewCoins(sdk.NewInt64Coin("stake", 101), sdk.NewInt64Coin("ibc/denom", 10)),
+			expCommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 25), sdk.NewInt64Coin("ibc/denom", 2)),
+			expFeeCollector:  sdk.NewCoins(sdk.NewInt64Coin("stake", 76), sdk.NewInt64Coin("ibc/denom", 8)),
+		},
+		{
+			name:                 "rewards remain in the pool if the community pool cannot be funded",
+			fraction:             "0.25",
+			rewards:              sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			communityPoolFailure: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		params := providertypes.DefaultParams()
+		params.ConsumerRewardsToCommunityPoolFraction = tc.fraction
+		providerKeeper.SetParams(ctx, params)
+		providerKeeper.AddPendingConsumerRewards(ctx, "chainID", tc.rewards)
+
+		calls := []*gomock.Call{
+			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPoolName).Return(poolAcct).Times(1),
+			mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, poolAddr).Return(tc.rewards).Times(1),
+		}
+		if tc.communityPoolFailure {
+			calls = append(calls, mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+				gomock.Any(), gomock.Any(), poolAddr).Return(sdkerrors.ErrInsufficientFunds).Times(1))
+		}
+		if !tc.expCommunityPool.IsZero() {
+			calls = append(calls, mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+				gomock.Any(), tc.expCommunityPool, poolAddr).Return(nil).Times(1))
+		}
+		if !tc.expFeeCollector.IsZero() {
+			calls = append(calls, mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
+				gomock.Any(), providertypes.ConsumerRewardsPoolName, authtypes.FeeCollectorName, tc.expFeeCollector,
+			).Return(nil).Times(1))
+		}
+		gomock.InOrder(calls...)
+
+		providerKeeper.BeginBlockRD(ctx)
+
+		totals := providerKeeper.GetConsumerRewardsTotals(ctx, "chainID")
+		require.True(t, totals.CommunityPool.IsEqual(tc.expCommunityPool), tc.name)
+		require.True(t, totals.FeeCollector.IsEqual(tc.expFeeCollector), tc.name)
+		// the pending rewards are kept until they are distributed
+		if tc.communityPoolFailure {
+			require.Equal(t, tc.rewards, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"), tc.name)
+		} else {
+			require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"), tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestBeginBlockRDUnaccountedRewards tests that the pending rewards of a consumer chain are capped
+// by the balance of the consumer rewards pool, and that the rest of the balance is distributed
+// without being accounted to a consumer chain
+func TestBeginBlockRDUnaccountedRewards(t *testing.T) {
+	poolAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPoolName)
+	poolAddr := poolAcct.GetAddress()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ConsumerRewardsToCommunityPoolFraction = "0.5"
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainID", sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPoolName).Return(poolAcct).Times(1),
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, poolAddr).Return(
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ufoo", 10))).Times(1),
+		// the rewards of the consumer chain
+		mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+			gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), poolAddr).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), providertypes.ConsumerRewardsPoolName,
+			authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))).Return(nil).Times(1),
+		// the unaccounted rewards
+		mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+			gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("ufoo", 5)), poolAddr).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), providertypes.ConsumerRewardsPoolName,
+			authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("ufoo", 5))).Return(nil).Times(1),
+	)
+
+	providerKeeper.BeginBlockRD(ctx)
+
+	require.Equal(t, providertypes.ConsumerRewardsTotals{
+		CommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+		FeeCollector:  sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	}, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID"))
+	require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"))
+}
+
+// TestBeginBlockRDFailure tests that the rewards of a consumer chain that cannot be distributed
+// remain pending, while the rewards of the other consumer chains are distributed
+func TestBeginBlockRDFailure(t *testing.T) {
+	poolAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPoolName)
+	poolAddr := poolAcct.GetAddress()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ConsumerRewardsToCommunityPoolFraction = "0.5"
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainA", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainB", sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPoolName).Return(poolAcct).Times(1),
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, poolAddr).Return(
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 150))).Times(1),
+		// the rewards of chainA cannot be sent to the fee collector
+		mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+			gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), poolAddr).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), providertypes.ConsumerRewardsPoolName,
+			authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))).Return(sdkerrors.ErrInsufficientFunds).Times(1),
+		// the rewards of chainB
+		mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(
+			gomock.Any(), sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), poolAddr).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), providertypes.ConsumerRewardsPoolName,
+			authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 25))).Return(nil).Times(1),
+	)
+
+	providerKeeper.BeginBlockRD(ctx)
+
+	// the rewards of chainA are neither distributed as unaccounted rewards nor accounted
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), providerKeeper.GetPendingConsumerRewards(ctx, "chainA"))
+	require.Equal(t, providertypes.ConsumerRewardsTotals{}, providerKeeper.GetConsumerRewardsTotals(ctx, "chainA"))
+	require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainB"))
+	require.Equal(t, providertypes.ConsumerRewardsTotals{
+		CommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 25)),
+		FeeCollector:  sdk.NewCoins(sdk.NewInt64Coin("stake", 25)),
+	}, providerKeeper.GetConsumerRewardsTotals(ctx, "chainB"))
+}
+
+// TestConsumerRewardsTotals tests the getter, setter and deleter of the consumer rewards totals
+func TestConsumerRewardsTotals(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID").CommunityPool)
+	require.Empty(t, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID").FeeCollector)
+
+	totals := providertypes.ConsumerRewardsTotals{
+		CommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		FeeCollector:  sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+	}
+	providerKeeper.SetConsumerRewardsTotals(ctx, "chainID", totals)
+	require.Equal(t, totals, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID"))
+	require.Empty(t, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID2").CommunityPool)
+
+	providerKeeper.DeleteConsumerRewardsTotals(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetConsumerRewardsTotals(ctx, "chainID").CommunityPool)
+}
+
+// TestPendingConsumerRewards tests the adder, getter and deleter of the pending consumer rewards
+func TestPendingConsumerRewards(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"))
+
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainID", sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainID",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("ufoo", 1)))
+	providerKeeper.AddPendingConsumerRewards(ctx, "chainID2", sdk.NewCoins(sdk.NewInt64Coin("ubar", 3)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("ufoo", 1)),
+		providerKeeper.GetPendingConsumerRewards(ctx, "chainID"))
+
+	providerKeeper.DeletePendingConsumerRewards(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubar", 3)), providerKeeper.GetPendingConsumerRewards(ctx, "chainID2"))
+}
+
+// TestRewardDenomAllowlist tests the setter, getter and deleter of the reward denom allowlist
+func TestRewardDenomAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// without an allowlist, all denoms are allowed
+	require.Empty(t, providerKeeper.GetRewardDenomAllowlist(ctx, "chainID"))
+	require.True(t, providerKeeper.IsRewardDenomAllowed(ctx, "chainID", "ufoo"))
+
+	providerKeeper.SetRewardDenomAllowlist(ctx, "chainID", []string{"ufoo", "ubar"})
+	providerKeeper.SetRewardDenomAllowlist(ctx, "chainID2", []string{"ubaz"})
+	require.Equal(t, []string{"ubar", "ufoo"}, providerKeeper.GetRewardDenomAllowlist(ctx, "chainID"))
+	require.True(t, providerKeeper.IsRewardDenomAllowed(ctx, "chainID", "ufoo"))
+	require.False(t, providerKeeper.IsRewardDenomAllowed(ctx, "chainID", "ubaz"))
+
+	// setting an allowlist replaces the previous one
+	providerKeeper.SetRewardDenomAllowlist(ctx, "chainID", []string{"ubaz"})
+	require.Equal(t, []string{"ubaz"}, providerKeeper.GetRewardDenomAllowlist(ctx, "chainID"))
+	require.False(t, providerKeeper.IsRewardDenomAllowed(ctx, "chainID", "ufoo"))
+
+	providerKeeper.DeleteRewardDenomAllowlist(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetRewardDenomAllowlist(ctx, "chainID"))
+	require.True(t, providerKeeper.IsRewardDenomAllowed(ctx, "chainID", "ufoo"))
+	require.Equal(t, []string{"ubaz"}, providerKeeper.GetRewardDenomAllowlist(ctx, "chainID2"))
+}
+
+// TestValidateConsumerRewardDenom tests that only the denoms in the reward denom allowlist
+// of a consumer chain are accepted on the channels to the consumer chain
+func TestValidateConsumerRewardDenom(t *testing.T) {
+	testCases := []struct {
+		name       string
+		clientID   string
+		allowlist  []string
+		denom      string
+		expAllowed bool
+	}{
+		{"no allowlist", "clientID", nil, "ufoo", true},
+		{"denom in allowlist", "clientID", []string{"ufoo"}, "ufoo", true},
+		{"denom not in allowlist", "clientID", []string{"ubar"}, "ufoo", false},
+		{"channel to another client of the chain", "otherClientID", []string{"ubar"}, "ufoo", true},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+		providerKeeper.SetRewardDenomAllowlist(ctx, "chainID", tc.allowlist)
+		gomock.InOrder(
+			mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, transfertypes.PortID, "channel-1").Return(
+				channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connectionID"}}, true,
+			).Times(1),
+			mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionID").Return(
+				conntypes.ConnectionEnd{ClientId: tc.clientID}, true,
+			).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, tc.clientID).Return(
+				&ibctmtypes.ClientState{ChainId: "chainID"}, true,
+			).Times(1),
+		)
+
+		err := providerKeeper.ValidateConsumerRewardDenom(ctx, transfertypes.PortID, "channel-1", tc.denom)
+		if tc.expAllowed {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, providertypes.ErrRewardDenomNotAllowed, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestAddReceivedConsumerRewards tests that the tokens received from a consumer chain are added
+// to its pending rewards with the denom they are received in on the provider chain
+func TestAddReceivedConsumerRewards(t *testing.T) {
+	testCases := []struct {
+		name     string
+		clientID string
+		denom    string
+		expDenom string
+	}{
+		{
+			"tokens native to the consumer chain", "clientID", "ufoo",
+			transfertypes.ParseDenomTrace("transfer/channel-1/ufoo").IBCDenom(),
+		},
+		{"tokens native to the provider chain", "clientID", "transfer/channel-0/stake", "stake"},
+		{"channel to another client of the chain", "otherClientID", "ufoo", ""},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+		gomock.InOrder(
+			mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, transfertypes.PortID, "channel-1").Return(
+				channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connectionID"}}, true,
+			).Times(1),
+			mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionID").Return(
+				conntypes.ConnectionEnd{ClientId: tc.clientID}, true,
+			).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, tc.clientID).Return(
+				&ibctmtypes.ClientState{ChainId: "chainID"}, true,
+			).Times(1),
+		)
+
+		packet := channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-0",
+			transfertypes.PortID, "channel-1", clienttypes.NewHeight(1, 100), 0)
+		data := transfertypes.NewFungibleTokenPacketData(tc.denom, "10", "sender", "receiver")
+		require.NoError(t, providerKeeper.AddReceivedConsumerRewards(ctx, packet, data), tc.name)
+
+		if tc.expDenom == "" {
+			require.Empty(t, providerKeeper.GetPendingConsumerRewards(ctx, "chainID"), tc.name)
+		} else {
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(tc.expDenom, 10)),
+				providerKeeper.GetPendingConsumerRewards(ctx, "chainID"), tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestUpdateRewardDenomAllowlist tests that governance can amend the reward denom allowlist
+// of an existing consumer chain and that the allowlist is returned by the query
+func TestUpdateRewardDenomAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	query := func() []string {
+		res, err := providerKeeper.QueryRewardDenomAllowlist(sdk.WrapSDKContext(ctx),
+			&providertypes.QueryRewardDenomAllowlistRequest{ChainId: "chainID"})
+		require.NoError(t, err)
+		return res.Denoms
+	}
+
+	// the allowlist can only be amended for existing consumer chains
+	_, err := msgServer.UpdateRewardDenomAllowlist(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateRewardDenomAllowlist(providerKeeper.GetAuthority(), "chainID", []string{"ufoo"}))
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetRewardDenomAllowlist(ctx, "chainID", []string{"ufoo"})
+
+	// only the authority can amend the allowlist
+	_, err = msgServer.UpdateRewardDenomAllowlist(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateRewardDenomAllowlist(sdk.AccAddress([]byte("other")).String(), "chainID", []string{"ubar"}))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, []string{"ufoo"}, query())
+
+	_, err = msgServer.UpdateRewardDenomAllowlist(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateRewardDenomAllowlist(providerKeeper.GetAuthority(), "chainID", []string{"ufoo", "ubar"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"ubar", "ufoo"}, query())
+
+	// an empty allowlist accepts all denoms
+	_, err = msgServer.UpdateRewardDenomAllowlist(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateRewardDenomAllowlist(providerKeeper.GetAuthority(), "chainID", nil))
+	require.NoError(t, err)
+	require.Empty(t, query())
+}
@@ -27,6 +27,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetValidatorSetUpdateId(ctx, genState.ValsetUpdateId)
 	for _, v2h := range genState.ValsetUpdateIdToHeight {
 		k.SetValsetUpdateBlockHeight(ctx, v2h.ValsetUpdateId, v2h.Height)
+		if !v2h.BlockTime.IsZero() {
+			k.SetValsetUpdateBlockTime(ctx, v2h.ValsetUpdateId, v2h.BlockTime)
+		}
 	}
 
 	for _, prop := range genState.ConsumerAdditionProposals {
@@ -52,6 +55,69 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, cs := range genState.ConsumerStates {
 		chainID := cs.ChainId
 		k.SetConsumerClientId(ctx, chainID, cs.ClientId)
+		if cs.CandidateClientId != "" {
+			k.setConsumerCandidateClientId(ctx, chainID, cs.CandidateClientId)
+		}
+		// the initialization parameters are set first, as they store the per-chain settings below
+		if cs.InitParams != nil {
+			k.SetConsumerInitParams(ctx, chainID, *cs.InitParams)
+		}
+		k.SetSlashEnabled(ctx, chainID, cs.SlashEnabled)
+		// a zero top N means that all the bonded validators validate the consumer chain
+		if cs.TopN != 0 {
+			k.SetConsumerTopN(ctx, chainID, cs.TopN)
+		}
+		if cs.TopN != 0 || cs.ValidatorApprovalRequired || cs.PowerReduction != "" {
+			k.SetConsumerValSet(ctx, chainID, cs.ValidatorSet)
+		}
+		if len(cs.AcceptedGenesisHash) != 0 {
+			k.SetConsumerAcceptedGenesisHash(ctx, chainID, cs.AcceptedGenesisHash)
+		}
+		if cs.PowerReduction != "" {
+			powerReduction, err := types.ParseConsumerPowerReduction(cs.PowerReduction)
+			if err != nil {
+				panic(fmt.Errorf("invalid power reduction for consumer chain %s: %w", chainID, err))
+			}
+			k.SetConsumerPowerReduction(ctx, chainID, powerReduction)
+		}
+		if cs.PowerMultiplier != "" {
+			powerMultiplier, err := types.ParsePowerMultiplier(cs.PowerMultiplier)
+			if err != nil {
+				panic(fmt.Errorf("invalid power multiplier for consumer chain %s: %w", chainID, err))
+			}
+			k.SetConsumerPowerMultiplier(ctx, chainID, powerMultiplier)
+		}
+		if cs.DowntimeJailDuration != 0 {
+			k.SetConsumerDowntimeJailDuration(ctx, chainID, cs.DowntimeJailDuration)
+		}
+		if cs.VscPacketTimeoutPeriod != 0 {
+			k.SetConsumerVscPacketTimeoutPeriod(ctx, chainID, cs.VscPacketTimeoutPeriod)
+		}
+		if len(cs.RewardDenomAllowlist) > 0 {
+			k.SetRewardDenomAllowlist(ctx, chainID, cs.RewardDenomAllowlist)
+		}
+		if len(cs.RelayerAllowlist) > 0 {
+			k.SetRelayerAllowlist(ctx, chainID, cs.RelayerAllowlist)
+		}
+		if cs.SpawnHeight != 0 {
+			k.SetConsumerSpawnHeight(ctx, chainID, cs.SpawnHeight)
+		}
+		if cs.ValidatorApprovalRequired {
+			k.SetValidatorApprovalRequired(ctx, chainID)
+		}
+		for _, addr := range cs.ApprovedValidators {
+			consAddr, err := sdk.ConsAddressFromBech32(addr)
+			if err != nil {
+				panic(fmt.Errorf("invalid approved validator address for consumer chain %s: %w", chainID, err))
+			}
+			k.SetApprovedValidator(ctx, chainID, types.NewProviderConsAddress(consAddr))
+		}
+		// if no phase is set, the phase is derived from the consumer chain state, see GetConsumerPhase
+		if cs.Phase != types.ConsumerPhaseUnspecified {
+			k.SetConsumerPhase(ctx, chainID, cs.Phase)
+		} else {
+			k.DeleteConsumerPhase(ctx, chainID)
+		}
 		if err := k.SetConsumerGenesis(ctx, chainID, cs.ConsumerGenesis); err != nil {
 			// An error here would indicate something is very wrong,
 			// the ConsumerGenesis validated in ConsumerState.Validate().
@@ -86,6 +152,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		}
 	}
 
+	for _, failedProp := range genState.FailedConsumerAdditionProposals {
+		k.setFailedConsumerAdditionProp(ctx, failedProp)
+		k.SetConsumerSpawnFailureCount(ctx, failedProp.Proposal.ChainId, failedProp.FailureCount)
+	}
+	for _, token := range genState.IdempotencyTokens {
+		k.SetIdempotencyTokenExpiry(ctx, token.ChainId, token.Token, token.Expiry)
+	}
+	// Note that the client histories replace the records appended when setting the
+	// client IDs of the consumer chains above, i.e., with the genesis block height.
+	for _, history := range genState.ConsumerClientHistories {
+		k.SetConsumerClientHistory(ctx, history.ChainId, history.Records)
+	}
+
 	k.SetParams(ctx, genState.Params)
 	k.InitializeSlashMeter(ctx)
 }
@@ -109,6 +188,39 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			ClientId:          chain.ClientId,
 			ConsumerGenesis:   gen,
 			UnbondingOpsIndex: k.GetAllUnbondingOpIndexes(ctx, chain.ChainId),
+			SlashEnabled:      k.IsSlashEnabled(ctx, chain.ChainId),
+			Phase:             k.GetConsumerPhase(ctx, chain.ChainId),
+		}
+
+		cs.CandidateClientId, _ = k.GetConsumerCandidateClientId(ctx, chain.ChainId)
+		cs.TopN, _ = k.GetConsumerTopN(ctx, chain.ChainId)
+		if _, found := k.GetTrackedValSetTopN(ctx, chain.ChainId); found {
+			cs.ValidatorSet = k.GetConsumerValSet(ctx, chain.ChainId)
+		}
+		if genesisHash, found := k.GetConsumerAcceptedGenesisHash(ctx, chain.ChainId); found {
+			cs.AcceptedGenesisHash = genesisHash
+		}
+		if powerReduction, found := k.GetConsumerPowerReduction(ctx, chain.ChainId); found {
+			cs.PowerReduction = powerReduction.String()
+		}
+		if powerMultiplier, found := k.GetConsumerPowerMultiplier(ctx, chain.ChainId); found {
+			cs.PowerMultiplier = powerMultiplier.String()
+		}
+		if jailDuration, found := k.GetConsumerDowntimeJailDuration(ctx, chain.ChainId); found {
+			cs.DowntimeJailDuration = jailDuration
+		}
+		if timeoutPeriod, found := k.GetConsumerVscPacketTimeoutPeriod(ctx, chain.ChainId); found {
+			cs.VscPacketTimeoutPeriod = timeoutPeriod
+		}
+		cs.RewardDenomAllowlist = k.GetRewardDenomAllowlist(ctx, chain.ChainId)
+		cs.RelayerAllowlist = k.GetRelayerAllowlist(ctx, chain.ChainId)
+		if initParams, found := k.GetConsumerInitParams(ctx, chain.ChainId); found {
+			cs.InitParams = &initParams
+		}
+		cs.SpawnHeight, _ = k.GetConsumerSpawnHeight(ctx, chain.ChainId)
+		cs.ValidatorApprovalRequired = k.IsValidatorApprovalRequired(ctx, chain.ChainId)
+		for _, providerAddr := range k.GetAllApprovedValidators(ctx, chain.ChainId) {
+			cs.ApprovedValidators = append(cs.ApprovedValidators, providerAddr.String())
 		}
 
 		// try to find channel id for the current consumer chain
@@ -135,7 +247,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -148,4 +260,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	genState.FailedConsumerAdditionProposals = k.GetAllFailedConsumerAdditionProps(ctx)
+	genState.IdempotencyTokens = k.GetAllIdempotencyTokens(ctx)
+	genState.ConsumerClientHistories = k.GetAllConsumerClientHistories(ctx)
+
+	return genState
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
@@ -15,6 +16,7 @@ import (
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // TestInitAndExportGenesis tests the export and the initialisation of a provider chain genesis
@@ -24,6 +26,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	expClientID := "client"
 	oneHourFromNow := time.Now().UTC().Add(time.Hour)
 	initHeight, vscID := uint64(5), uint64(1)
+	vscBlockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	ubdIndex := []uint64{0, 1, 2}
 	params := providertypes.DefaultParams()
 
@@ -37,7 +40,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 
 	// create genesis struct
 	provGenesis := providertypes.NewGenesisState(vscID,
-		[]providertypes.ValsetUpdateIdToHeight{{ValsetUpdateId: vscID, Height: initHeight}},
+		[]providertypes.ValsetUpdateIdToHeight{{ValsetUpdateId: vscID, Height: initHeight, BlockTime: vscBlockTime}},
 		[]providertypes.ConsumerState{
 			providertypes.NewConsumerStates(
 				cChainIDs[0],
@@ -98,6 +101,67 @@ func TestInitAndExportGenesis(t *testing.T) {
 			},
 		},
 	)
+	// enable slashing for downtime infractions on the first consumer chain
+	provGenesis.ConsumerStates[0].SlashEnabled = true
+	provGenesis.ConsumerStates[0].Phase = providertypes.ConsumerPhaseActive
+	provGenesis.ConsumerStates[1].Phase = providertypes.ConsumerPhaseClientCreated
+	// only the first consumer chain is validated by the top N validators
+	provGenesis.ConsumerStates[0].TopN = 10
+	provGenesis.ConsumerStates[0].ValidatorSet = []abci.ValidatorUpdate{
+		{PubKey: providerCryptoId.TMProtoCryptoPublicKey(), Power: 100},
+	}
+	// only the first consumer chain confirmed its genesis state
+	provGenesis.ConsumerStates[0].AcceptedGenesisHash = make([]byte, 32)
+	provGenesis.ConsumerStates[0].PowerReduction = "1000"
+	provGenesis.ConsumerStates[0].PowerMultiplier = "1.500000000000000000"
+	provGenesis.ConsumerStates[0].RewardDenomAllowlist = []string{"ubar", "ufoo"}
+	provGenesis.ConsumerStates[0].RelayerAllowlist = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+	provGenesis.ConsumerStates[0].DowntimeJailDuration = time.Hour
+	provGenesis.ConsumerStates[0].VscPacketTimeoutPeriod = 2 * time.Hour
+	provGenesis.ConsumerStates[0].SpawnHeight = 5
+	provGenesis.ConsumerStates[0].CandidateClientId = "candidateClientID"
+	provGenesis.ConsumerStates[0].ValidatorApprovalRequired = true
+	provGenesis.ConsumerStates[0].ApprovedValidators = []string{providerCryptoId.SDKValConsAddress().String()}
+	provGenesis.ConsumerStates[0].InitParams = &providertypes.ConsumerInitParams{
+		Title:                        "title",
+		InitialHeight:                clienttypes.NewHeight(0, 1),
+		SpawnTime:                    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		UnbondingPeriod:              time.Hour,
+		SlashEnabled:                 true,
+		TopN:                         10,
+		ConsumerPowerReduction:       "1000",
+		PowerMultiplier:              "1.500000000000000000",
+		RelayerAllowlist:             []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"},
+		ConsumerDowntimeJailDuration: time.Hour,
+		MaxClockDrift:                10 * time.Second,
+		TrustingPeriodFraction:       providertypes.DefaultTrustingPeriodFraction,
+		ValidatorApprovalRequired:    true,
+		VscPacketTimeoutPeriod:       2 * time.Hour,
+	}
+	// the consumer client of a third consumer chain could not be created twice
+	failedProp := testkeeper.GetTestConsumerAdditionProp()
+	failedProp.ChainId = "c2"
+	failedProp.SpawnTime = oneHourFromNow
+	provGenesis.FailedConsumerAdditionProposals = []providertypes.FailedConsumerAdditionProposal{
+		{Proposal: *failedProp, Error: "error", FailureCount: 2},
+	}
+	provGenesis.IdempotencyTokens = []providertypes.IdempotencyToken{
+		{ChainId: cChainIDs[0], Token: "token", Expiry: oneHourFromNow},
+	}
+	// the first consumer chain replaced its consumer client once, while the
+	// client history of a stopped consumer chain is preserved
+	provGenesis.ConsumerClientHistories = []providertypes.ConsumerClientHistory{
+		{ChainId: cChainIDs[0], Records: []providertypes.ConsumerClientRecord{
+			{ClientId: "oldClient", BlockHeight: 1, Timestamp: vscBlockTime},
+			{ClientId: expClientID, BlockHeight: 2, Timestamp: vscBlockTime.Add(time.Hour)},
+		}},
+		{ChainId: cChainIDs[1], Records: []providertypes.ConsumerClientRecord{
+			{ClientId: expClientID, BlockHeight: 3, Timestamp: vscBlockTime.Add(2 * time.Hour)},
+		}},
+		{ChainId: "stopped", Records: []providertypes.ConsumerClientRecord{
+			{ClientId: "stoppedClient", BlockHeight: 1, Timestamp: vscBlockTime},
+		}},
+	}
 
 	// Instantiate in-mem provider keeper with mocks
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -139,6 +203,9 @@ func TestInitAndExportGenesis(t *testing.T) {
 	height, found := pk.GetValsetUpdateBlockHeight(ctx, vscID)
 	require.True(t, found)
 	require.Equal(t, initHeight, height)
+	blockTime, found := pk.GetValsetUpdateBlockTime(ctx, vscID)
+	require.True(t, found)
+	require.Equal(t, vscBlockTime, blockTime)
 	addProp, found := pk.GetPendingConsumerAdditionProp(ctx, oneHourFromNow, cChainIDs[0])
 	require.True(t, found)
 	require.Equal(t, provGenesis.ConsumerAdditionProposals[0], addProp)
@@ -161,6 +228,17 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// check provider chain's consumer chain states
 	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
 
+	gotFailedProp, found := pk.GetFailedConsumerAdditionProp(ctx, "c2")
+	require.True(t, found)
+	require.Equal(t, provGenesis.FailedConsumerAdditionProposals[0], gotFailedProp)
+	require.Equal(t, uint64(2), pk.GetConsumerSpawnFailureCount(ctx, "c2"))
+	expiry, found := pk.GetIdempotencyTokenExpiry(ctx, cChainIDs[0], "token")
+	require.True(t, found)
+	require.Equal(t, oneHourFromNow, expiry)
+	for _, history := range provGenesis.ConsumerClientHistories {
+		require.Equal(t, history.Records, pk.GetConsumerClientHistory(ctx, history.ChainId))
+	}
+
 	// check the exported genesis
 	require.Equal(t, provGenesis, pk.ExportGenesis(ctx))
 }
@@ -200,5 +278,54 @@ func assertConsumerChainStates(t *testing.T, ctx sdk.Context, pk keeper.Keeper,
 		}
 
 		require.Equal(t, cs.SlashDowntimeAck, pk.GetSlashAcks(ctx, chainID))
+		require.Equal(t, cs.SlashEnabled, pk.IsSlashEnabled(ctx, chainID))
+		require.Equal(t, cs.Phase, pk.GetConsumerPhase(ctx, chainID))
+		topN, found := pk.GetConsumerTopN(ctx, chainID)
+		require.Equal(t, cs.TopN != 0, found)
+		require.Equal(t, cs.TopN, topN)
+		require.Equal(t, cs.ValidatorSet, pk.GetConsumerValSet(ctx, chainID))
+		genesisHash, found := pk.GetConsumerAcceptedGenesisHash(ctx, chainID)
+		require.Equal(t, len(cs.AcceptedGenesisHash) != 0, found)
+		require.Equal(t, cs.AcceptedGenesisHash, genesisHash)
+
+		powerReduction, found := pk.GetConsumerPowerReduction(ctx, chainID)
+		require.Equal(t, cs.PowerReduction != "", found)
+		if found {
+			require.Equal(t, cs.PowerReduction, powerReduction.String())
+		}
+
+		powerMultiplier, found := pk.GetConsumerPowerMultiplier(ctx, chainID)
+		require.Equal(t, cs.PowerMultiplier != "", found)
+		if found {
+			require.Equal(t, cs.PowerMultiplier, powerMultiplier.String())
+		}
+
+		jailDuration, found := pk.GetConsumerDowntimeJailDuration(ctx, chainID)
+		require.Equal(t, cs.DowntimeJailDuration != 0, found)
+		require.Equal(t, cs.DowntimeJailDuration, jailDuration)
+
+		timeoutPeriod, found := pk.GetConsumerVscPacketTimeoutPeriod(ctx, chainID)
+		require.Equal(t, cs.VscPacketTimeoutPeriod != 0, found)
+		require.Equal(t, cs.VscPacketTimeoutPeriod, timeoutPeriod)
+
+		candidateClientID, found := pk.GetConsumerCandidateClientId(ctx, chainID)
+		require.Equal(t, cs.CandidateClientId != "", found)
+		require.Equal(t, cs.CandidateClientId, candidateClientID)
+
+		spawnHeight, found := pk.GetConsumerSpawnHeight(ctx, chainID)
+		require.Equal(t, cs.SpawnHeight != 0, found)
+		require.Equal(t, cs.SpawnHeight, spawnHeight)
+
+		require.Equal(t, cs.ValidatorApprovalRequired, pk.IsValidatorApprovalRequired(ctx, chainID))
+		require.Len(t, pk.GetAllApprovedValidators(ctx, chainID), len(cs.ApprovedValidators))
+
+		initParams, found := pk.GetConsumerInitParams(ctx, chainID)
+		require.Equal(t, cs.InitParams != nil, found)
+		if found {
+			require.Equal(t, *cs.InitParams, initParams)
+		}
+
+		require.Equal(t, cs.RewardDenomAllowlist, pk.GetRewardDenomAllowlist(ctx, chainID))
+		require.Equal(t, cs.RelayerAllowlist, pk.GetRelayerAllowlist(ctx, chainID))
 	}
 }
@@ -2,12 +2,25 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,7 +43,104 @@ func (k Keeper) QueryConsumerGenesis(c context.Context, req *types.QueryConsumer
 		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
 	}
 
-	return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+	hash, err := gen.CanonicalHash()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute consumer genesis hash: %s", err)
+	}
+
+	// the genesis states of other modules are retained with the initialization parameters
+	initParams, _ := k.GetConsumerInitParams(ctx, req.ChainId)
+
+	// the provider client state is omitted from the genesis of consumer chains that are not new chains
+	providerChainID := ""
+	if gen.ProviderClientState != nil {
+		providerChainID = gen.ProviderClientState.ChainId
+	}
+
+	return &types.QueryConsumerGenesisResponse{
+		GenesisState:           gen,
+		CanonicalHash:          hex.EncodeToString(hash),
+		AdditionalGenesisState: initParams.AdditionalGenesisState,
+		ProviderChainId:        providerChainID,
+		InitialBalances:        initParams.InitialBalances,
+		DowntimeJailDuration:   k.GetEffectiveConsumerDowntimeJailDuration(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerGenesisDiff(goCtx context.Context, req *types.QueryConsumerGenesisDiffRequest) (*types.QueryConsumerGenesisDiffResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGen, found := k.GetConsumerGenesis(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+	freshGen, err := k.MakeFreshConsumerGenesis(ctx, req.ChainId, storedGen)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to make fresh consumer genesis: %s", err)
+	}
+
+	added, removed, changed := diffInitialValSets(storedGen.InitialValSet, freshGen.InitialValSet)
+
+	return &types.QueryConsumerGenesisDiffResponse{
+		Added:         added,
+		Removed:       removed,
+		Changed:       changed,
+		ParamsChanged: consumerGenesisParamsChanged(storedGen, freshGen),
+		FreshGenesis:  freshGen,
+	}, nil
+}
+
+// consumerGenesisParamsChanged returns whether the consumer params or the params of the provider client
+// differ between the given consumer genesis states, i.e., regardless of the latest height of the provider client.
+func consumerGenesisParamsChanged(stored, fresh consumertypes.GenesisState) bool {
+	if !proto.Equal(&stored.Params, &fresh.Params) {
+		return true
+	}
+	if stored.ProviderClientState == nil || fresh.ProviderClientState == nil {
+		return stored.ProviderClientState != fresh.ProviderClientState
+	}
+	storedClientState, freshClientState := *stored.ProviderClientState, *fresh.ProviderClientState
+	storedClientState.LatestHeight, freshClientState.LatestHeight = clienttypes.Height{}, clienttypes.Height{}
+	return !proto.Equal(&storedClientState, &freshClientState)
+}
+
+// diffInitialValSets returns the validators (with consumer keys) that were added to, removed from,
+// or whose power changed in the fresh initial validator set compared to the stored one.
+// Note that a validator whose consumer key was reassigned is both removed and added.
+func diffInitialValSets(stored, fresh []abci.ValidatorUpdate) (added, removed, changed []types.ConsumerGenesisValidatorChange) {
+	storedPowers := make(map[string]int64, len(stored))
+	for _, val := range stored {
+		storedPowers[val.PubKey.String()] = val.Power
+	}
+
+	for _, val := range fresh {
+		storedPower, found := storedPowers[val.PubKey.String()]
+		switch {
+		case !found:
+			added = append(added, types.ConsumerGenesisValidatorChange{PubKey: val.PubKey, FreshPower: val.Power})
+		case storedPower != val.Power:
+			changed = append(changed, types.ConsumerGenesisValidatorChange{
+				PubKey: val.PubKey, StoredPower: storedPower, FreshPower: val.Power,
+			})
+		}
+		delete(storedPowers, val.PubKey.String())
+	}
+	// iterate over the stored validator set to find the removed validators in a deterministic order
+	for _, val := range stored {
+		if _, found := storedPowers[val.PubKey.String()]; found {
+			removed = append(removed, types.ConsumerGenesisValidatorChange{PubKey: val.PubKey, StoredPower: val.Power})
+		}
+	}
+
+	return added, removed, changed
 }
 
 func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsumerChainsRequest) (*types.QueryConsumerChainsResponse, error) {
@@ -38,19 +148,96 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	// an empty status filter is equivalent to all
+	var statusFilter ibcexported.Status
+	switch strings.ToLower(req.Status) {
+	case "", "all":
+	case strings.ToLower(ibcexported.Active.String()):
+		statusFilter = ibcexported.Active
+	case strings.ToLower(ibcexported.Expired.String()):
+		statusFilter = ibcexported.Expired
+	case strings.ToLower(ibcexported.Frozen.String()):
+		statusFilter = ibcexported.Frozen
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid request: unknown client status %s, expected active, expired, frozen or all", req.Status)
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// convert to array of pointers
 	chains := []*types.Chain{}
 	for _, chain := range k.GetAllConsumerChains(ctx) {
+		clientStatus := k.GetConsumerClientStatus(ctx, chain.ClientId)
+		if statusFilter != "" && clientStatus != statusFilter {
+			continue
+		}
 		// prevent implicit memory aliasing
 		c := chain
+		c.Status = clientStatus.String()
 		chains = append(chains, &c)
 	}
 
 	return &types.QueryConsumerChainsResponse{Chains: chains}, nil
 }
 
+func (k Keeper) QueryConsumerRelationships(goCtx context.Context, req *types.QueryConsumerRelationshipsRequest) (*types.QueryConsumerRelationshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the consumer clients are stored under keys with the following format:
+	// ChainToClientBytePrefix | chainID
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.ChainToClientBytePrefix})
+
+	var relationships []types.ConsumerRelationship
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		chainID := string(key)
+		clientID := string(value)
+		initChainHeight, _ := k.GetInitChainHeight(ctx, chainID)
+
+		relationships = append(relationships, types.ConsumerRelationship{
+			ChainId:         chainID,
+			ClientId:        clientID,
+			Phase:           k.GetConsumerPhase(ctx, chainID),
+			InitChainHeight: initChainHeight,
+			Status:          k.GetConsumerClientStatus(ctx, clientID).String(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerRelationshipsResponse{
+		Relationships: relationships,
+		Pagination:    pageRes,
+		Height:        ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Keeper) QueryRecentSpawns(goCtx context.Context, req *types.QueryRecentSpawnsRequest) (*types.QueryRecentSpawnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRecentSpawnsResponse{Spawns: k.GetRecentSpawns(ctx)}, nil
+}
+
+func (k Keeper) QueryTemplateClient(goCtx context.Context, req *types.QueryTemplateClientRequest) (*types.QueryTemplateClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryTemplateClientResponse{TemplateClient: k.GetTemplateClient(ctx)}, nil
+}
+
 func (k Keeper) QueryConsumerChainStarts(goCtx context.Context, req *types.QueryConsumerChainStartProposalsRequest) (*types.QueryConsumerChainStartProposalsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -112,6 +299,31 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 	}, nil
 }
 
+func (k Keeper) QueryValidatorConsumerChains(goCtx context.Context, req *types.QueryValidatorConsumerChainsRequest) (*types.QueryValidatorConsumerChainsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err)
+	}
+
+	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no validator with provider address %s", req.ProviderAddress)
+	}
+
+	chainIDs, err := k.GetValidatorConsumerChains(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorConsumerChainsResponse{ChainIds: chainIDs}, nil
+}
+
 func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.QueryValidatorProviderAddrRequest) (*types.QueryValidatorProviderAddrResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -135,6 +347,37 @@ func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.Que
 	}, nil
 }
 
+func (k Keeper) QueryValidatorByConsumerAddr(goCtx context.Context, req *types.QueryValidatorByConsumerAddrRequest) (*types.QueryValidatorByConsumerAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerAddrTmp, err := sdk.ConsAddressFromBech32(req.ConsumerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid consumer address: %s", err)
+	}
+	consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+
+	// the same mapping is used to attribute the infractions in slash packets, see HandleSlashPacket
+	providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, req.ChainId, consumerAddr)
+	validator, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorProviderAddress,
+			"no provider validator for consumer address %s on chain %s", req.ConsumerAddress, req.ChainId)
+	}
+
+	return &types.QueryValidatorByConsumerAddrResponse{
+		ProviderAddress: providerAddr.String(),
+		OperatorAddress: validator.OperatorAddress,
+	}, nil
+}
+
 func (k Keeper) QueryThrottleState(goCtx context.Context, req *types.QueryThrottleStateRequest) (*types.QueryThrottleStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -214,25 +457,787 @@ func (k Keeper) QueryThrottledConsumerPacketData(goCtx context.Context, req *typ
 	}, nil
 }
 
-// getSlashPacketData fetches a slash packet data from the store using consumerChainId and ibcSeqNum (direct access)
-// If the returned bytes do not unmarshal to SlashPacketData, the data is considered not found.
-func (k Keeper) getSlashPacketData(ctx sdk.Context, consumerChainID string, ibcSeqNum uint64) (ccvtypes.SlashPacketData, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ThrottledPacketDataKey(consumerChainID, ibcSeqNum))
-	if len(bz) == 0 {
-		return ccvtypes.SlashPacketData{}, false
+func (k Keeper) QueryConsumerChainSlashEnabled(goCtx context.Context, req *types.QueryConsumerChainSlashEnabledRequest) (*types.QueryConsumerChainSlashEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if bz[0] != slashPacketData {
-		return ccvtypes.SlashPacketData{}, false
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid chain-id")
 	}
 
-	packet := ccvtypes.SlashPacketData{}
-	err := packet.Unmarshal(bz[1:])
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the flag remains readable for stopped consumer chains with preserved state
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found && !k.IsConsumerStatePreserved(ctx, req.ChainId) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerChainSlashEnabledResponse{
+		SlashEnabled: k.IsSlashEnabled(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerRewardsTotals(goCtx context.Context, req *types.QueryConsumerRewardsTotalsRequest) (*types.QueryConsumerRewardsTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryConsumerRewardsTotalsResponse{Totals: k.GetConsumerRewardsTotals(ctx, req.ChainId)}, nil
+}
+
+func (k Keeper) QueryProviderConsensusStateForConsumer(goCtx context.Context, req *types.QueryProviderConsensusStateForConsumerRequest) (*types.QueryProviderConsensusStateForConsumerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// consumer chains whose client was created have the consensus state stored in their genesis
+	if gen, found := k.GetConsumerGenesis(ctx, req.ChainId); found {
+		if gen.ProviderConsensusState == nil || gen.ProviderClientState == nil {
+			return nil, status.Errorf(codes.NotFound, "no provider consensus state in genesis of consumer chain: %s", req.ChainId)
+		}
+		return &types.QueryProviderConsensusStateForConsumerResponse{
+			ConsensusState: *gen.ProviderConsensusState,
+			Height:         gen.ProviderClientState.LatestHeight,
+		}, nil
+	}
+
+	// consumer chains with a pending addition proposal will use the self consensus state
+	// at the height when the proposal's spawn time is reached; return the current one
+	if _, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	height := clienttypes.GetSelfHeight(ctx)
+	consState, err := k.clientKeeper.GetSelfConsensusState(ctx, height)
 	if err != nil {
-		// If the data cannot be unmarshaled, it is considered not found
-		return ccvtypes.SlashPacketData{}, false
+		return nil, status.Errorf(codes.Internal, "failed to get self consensus state for height %s: %s", height, err)
+	}
+	tmConsState, ok := consState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected self consensus state type: %T", consState)
 	}
 
-	return packet, true
+	return &types.QueryProviderConsensusStateForConsumerResponse{
+		ConsensusState: *tmConsState,
+		Height:         height,
+		Pending:        true,
+	}, nil
+}
+
+func (k Keeper) QueryUnassignedValidators(goCtx context.Context, req *types.QueryUnassignedValidatorsRequest) (*types.QueryUnassignedValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the consumer chain must be either registered or have a pending addition proposal
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		if _, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, req.ChainId); !found {
+			return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+		}
+	}
+
+	validators := []types.UnassignedValidator{}
+	for _, val := range k.GetValidatorsWithoutConsumerKey(ctx, req.ChainId) {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot get consensus address of validator %s: %s", val.OperatorAddress, err)
+		}
+		validators = append(validators, types.UnassignedValidator{
+			OperatorAddress: val.OperatorAddress,
+			ProviderAddress: consAddr.String(),
+		})
+	}
+
+	return &types.QueryUnassignedValidatorsResponse{Validators: validators}, nil
+}
+
+func (k Keeper) QueryConsumerChainClients(goCtx context.Context, req *types.QueryConsumerChainClientsRequest) (*types.QueryConsumerChainClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientID, found := k.GetConsumerClientId(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+	candidateClientID, _ := k.GetConsumerCandidateClientId(ctx, req.ChainId)
+
+	return &types.QueryConsumerChainClientsResponse{
+		ClientId:          clientID,
+		CandidateClientId: candidateClientID,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerChainPhase(goCtx context.Context, req *types.QueryConsumerChainPhaseRequest) (*types.QueryConsumerChainPhaseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	phase := k.GetConsumerPhase(ctx, req.ChainId)
+	if phase == types.ConsumerPhaseUnspecified {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	// the remaining cooldown is zero if the consumer chain is not stopping
+	cooldownRemaining, _ := k.GetConsumerCooldownRemaining(ctx, req.ChainId)
+
+	return &types.QueryConsumerChainPhaseResponse{Phase: phase, CooldownRemaining: cooldownRemaining}, nil
+}
+
+func (k Keeper) QueryCcvVersion(goCtx context.Context, req *types.QueryCcvVersionRequest) (*types.QueryCcvVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryCcvVersionResponse{Version: ccvtypes.Version}, nil
+}
+
+func (k Keeper) QueryVscMaturityTime(goCtx context.Context, req *types.QueryVscMaturityTimeRequest) (*types.QueryVscMaturityTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	maturityTime, found := k.GetVscMaturityTime(ctx, req.ChainId, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no maturity time for vscID %d of consumer chain: %s", req.VscId, req.ChainId)
+	}
+
+	return &types.QueryVscMaturityTimeResponse{MaturityTime: maturityTime}, nil
+}
+
+func (k Keeper) QueryConsumerNextVscId(goCtx context.Context, req *types.QueryConsumerNextVscIdRequest) (*types.QueryConsumerNextVscIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	// the VSC ID is shared by all consumer chains, i.e., the next VSC packet
+	// queued for the consumer chain is assigned the current VSC ID
+	lastMaturedVscID, _ := k.GetLastMaturedVscId(ctx, req.ChainId)
+
+	return &types.QueryConsumerNextVscIdResponse{
+		NextVscId:        k.GetValidatorSetUpdateId(ctx),
+		LastMaturedVscId: lastMaturedVscID,
+	}, nil
+}
+
+func (k Keeper) QueryCcvStats(goCtx context.Context, req *types.QueryCcvStatsRequest) (*types.QueryCcvStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chains := k.GetAllConsumerChains(ctx)
+	outstandingVscPackets := 0
+	for _, chain := range chains {
+		outstandingVscPackets += len(k.GetPendingVSCPackets(ctx, chain.ChainId))
+	}
+
+	replicatedPower, err := k.GetReplicatedPower(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCcvStatsResponse{
+		ActiveConsumers:       uint64(len(chains)),
+		PendingConsumers:      uint64(len(k.GetAllPendingConsumerAdditionProps(ctx))),
+		StoppedConsumers:      uint64(len(k.GetConsumerChainsInPhase(ctx, types.ConsumerPhaseStopped))),
+		OutstandingVscPackets: uint64(outstandingVscPackets),
+		ReplicatedPower:       replicatedPower,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerJailedValidators(goCtx context.Context, req *types.QueryConsumerJailedValidatorsRequest) (*types.QueryConsumerJailedValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the slash history remains readable for stopped consumer chains with preserved state
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found && !k.IsConsumerStatePreserved(ctx, req.ChainId) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	providerAddrs := []string{}
+	for _, providerAddr := range k.GetAllJailedByConsumer(ctx, req.ChainId) {
+		// skip the validators that were unjailed in the meantime
+		val, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+		if !found || !val.IsJailed() {
+			continue
+		}
+		providerAddrs = append(providerAddrs, providerAddr.String())
+	}
+
+	return &types.QueryConsumerJailedValidatorsResponse{ProviderAddresses: providerAddrs}, nil
+}
+
+func (k Keeper) QueryConsumerClientInitialHeight(goCtx context.Context, req *types.QueryConsumerClientInitialHeightRequest) (*types.QueryConsumerClientInitialHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initialHeight, found := k.GetConsumerClientInitialHeight(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerClientInitialHeightResponse{InitialHeight: initialHeight}, nil
+}
+
+func (k Keeper) QueryConsumerCreationUnbondingTime(goCtx context.Context, req *types.QueryConsumerCreationUnbondingTimeRequest) (*types.QueryConsumerCreationUnbondingTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unbondingTime, found := k.GetConsumerCreationUnbondingTime(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerCreationUnbondingTimeResponse{UnbondingTime: unbondingTime}, nil
+}
+
+func (k Keeper) QueryConsumerClientLatestUpdate(goCtx context.Context, req *types.QueryConsumerClientLatestUpdateRequest) (*types.QueryConsumerClientLatestUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientID, found := k.GetConsumerClientId(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound,
+			"client %s of consumer chain %s", clientID, req.ChainId)
+	}
+	consState, found := k.clientKeeper.GetLatestClientConsensusState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound,
+			"latest consensus state of client %s of consumer chain %s", clientID, req.ChainId)
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+	// the elapsed time is relative to the block time, such that all nodes return the same result
+	timestamp := time.Unix(0, int64(consState.GetTimestamp())).UTC()
+
+	return &types.QueryConsumerClientLatestUpdateResponse{
+		ClientId:     clientID,
+		LatestHeight: clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()),
+		Timestamp:    timestamp,
+		Elapsed:      ctx.BlockTime().Sub(timestamp),
+	}, nil
+}
+
+func (k Keeper) QueryConsumerClientExpiry(goCtx context.Context, req *types.QueryConsumerClientExpiryRequest) (*types.QueryConsumerClientExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientID, found := k.GetConsumerClientId(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientNotFound,
+			"client %s of consumer chain %s", clientID, req.ChainId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid type of client %s of consumer chain %s: %T", clientID, req.ChainId, clientState)
+	}
+	consState, found := k.clientKeeper.GetLatestClientConsensusState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound,
+			"latest consensus state of client %s of consumer chain %s", clientID, req.ChainId)
+	}
+
+	// the client expires once the trusting period elapsed since its latest consensus state,
+	// i.e., the remaining time is not positive anymore (see ClientState.IsExpired)
+	expiryTime := time.Unix(0, int64(consState.GetTimestamp())).UTC().Add(tmClientState.TrustingPeriod)
+
+	return &types.QueryConsumerClientExpiryResponse{
+		ClientId:   clientID,
+		ExpiryTime: expiryTime,
+		Remaining:  expiryTime.Sub(ctx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) QueryRewardDenomAllowlist(goCtx context.Context, req *types.QueryRewardDenomAllowlistRequest) (*types.QueryRewardDenomAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRewardDenomAllowlistResponse{Denoms: k.GetRewardDenomAllowlist(ctx, req.ChainId)}, nil
+}
+
+func (k Keeper) QueryRelayerAllowlist(goCtx context.Context, req *types.QueryRelayerAllowlistRequest) (*types.QueryRelayerAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRelayerAllowlistResponse{Relayers: k.GetRelayerAllowlist(ctx, req.ChainId)}, nil
+}
+
+// getSlashPacketData fetches a slash packet data from the store using consumerChainId and ibcSeqNum (direct access)
+// If the returned bytes do not unmarshal to SlashPacketData, the data is considered not found.
+func (k Keeper) getSlashPacketData(ctx sdk.Context, consumerChainID string, ibcSeqNum uint64) (ccvtypes.SlashPacketData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ThrottledPacketDataKey(consumerChainID, ibcSeqNum))
+	if len(bz) == 0 {
+		return ccvtypes.SlashPacketData{}, false
+	}
+
+	if bz[0] != slashPacketData {
+		return ccvtypes.SlashPacketData{}, false
+	}
+
+	packet := ccvtypes.SlashPacketData{}
+	err := packet.Unmarshal(bz[1:])
+	if err != nil {
+		// If the data cannot be unmarshaled, it is considered not found
+		return ccvtypes.SlashPacketData{}, false
+	}
+
+	return packet, true
+}
+
+func (k Keeper) QueryConsumerKeyAssignments(goCtx context.Context, req *types.QueryConsumerKeyAssignmentsRequest) (*types.QueryConsumerKeyAssignmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the consumer keys assigned for a consumer chain are stored under keys with the following format:
+	// ConsumerValidatorsBytePrefix | len(chainID) | chainID | providerAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey),
+		types.ChainIdWithLenKey(types.ConsumerValidatorsBytePrefix, req.ChainId))
+
+	var assignments []types.ConsumerKeyAssignment
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		providerAddr := types.NewProviderConsAddress(key)
+		var consumerKey tmprotocrypto.PublicKey
+		if err := consumerKey.Unmarshal(value); err != nil {
+			return err
+		}
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+		if err != nil {
+			return err
+		}
+		// a key assignment replacement exists until the new consumer key is sent to the consumer chain
+		_, _, pendingRotation := k.GetKeyAssignmentReplacement(ctx, req.ChainId, providerAddr)
+
+		assignments = append(assignments, types.ConsumerKeyAssignment{
+			ProviderAddress: providerAddr.String(),
+			ConsumerKey:     &consumerKey,
+			ConsumerAddress: consumerAddr.String(),
+			PendingRotation: pendingRotation,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerKeyAssignmentsResponse{
+		Assignments: assignments,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerInitParams(goCtx context.Context, req *types.QueryConsumerInitParamsRequest) (*types.QueryConsumerInitParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initParams, found := k.GetConsumerInitParams(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no init params found for consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerInitParamsResponse{InitParams: initParams}, nil
+}
+
+func (k Keeper) QueryConsumerSpawnHeight(goCtx context.Context, req *types.QueryConsumerSpawnHeightRequest) (*types.QueryConsumerSpawnHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spawnHeight, found := k.GetConsumerSpawnHeight(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no spawn height found for consumer chain %s", req.ChainId)
+	}
+
+	return &types.QueryConsumerSpawnHeightResponse{SpawnHeight: spawnHeight}, nil
+}
+
+func (k Keeper) QueryPendingValidatorApprovals(goCtx context.Context, req *types.QueryPendingValidatorApprovalsRequest) (*types.QueryPendingValidatorApprovalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddrs := []string{}
+	for _, providerAddr := range k.GetAllPendingValidatorApprovals(ctx, req.ChainId) {
+		providerAddrs = append(providerAddrs, providerAddr.String())
+	}
+
+	return &types.QueryPendingValidatorApprovalsResponse{ProviderAddrs: providerAddrs}, nil
+}
+
+func (k Keeper) QueryFailedConsumerAdditionProposals(goCtx context.Context, req *types.QueryFailedConsumerAdditionProposalsRequest) (*types.QueryFailedConsumerAdditionProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the failed proposals are stored under keys with the following format:
+	// FailedCAPBytePrefix | chainID
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.FailedCAPBytePrefix})
+
+	var failedProps []types.FailedConsumerAdditionProposal
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var failedProp types.FailedConsumerAdditionProposal
+		if err := failedProp.Unmarshal(value); err != nil {
+			return err
+		}
+		failedProps = append(failedProps, failedProp)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedConsumerAdditionProposalsResponse{
+		FailedProposals: failedProps,
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) QueryHasAssignedConsumerKey(goCtx context.Context, req *types.QueryHasAssignedConsumerKeyRequest) (*types.QueryHasAssignedConsumerKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err)
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+
+	consumerKey, found := k.GetValidatorConsumerPubKey(ctx, req.ChainId, providerAddr)
+	if !found {
+		return &types.QueryHasAssignedConsumerKeyResponse{HasAssigned: false}, nil
+	}
+
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// a key assignment replacement exists until the new consumer key is sent to the consumer chain
+	_, _, pendingRotation := k.GetKeyAssignmentReplacement(ctx, req.ChainId, providerAddr)
+
+	return &types.QueryHasAssignedConsumerKeyResponse{
+		HasAssigned: true,
+		Assignment: &types.ConsumerKeyAssignment{
+			ProviderAddress: providerAddr.String(),
+			ConsumerKey:     &consumerKey,
+			ConsumerAddress: consumerAddr.String(),
+			PendingRotation: pendingRotation,
+		},
+	}, nil
+}
+
+func (k Keeper) QueryConsumerIntendedParams(goCtx context.Context, req *types.QueryConsumerIntendedParamsRequest) (*types.QueryConsumerIntendedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, found := k.GetConsumerIntendedParams(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerIntendedParamsResponse{Params: params}, nil
+}
+
+func (k Keeper) QueryConsumerStateDump(goCtx context.Context, req *types.QueryConsumerStateDumpRequest) (*types.QueryConsumerStateDumpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	phase := k.GetConsumerPhase(ctx, req.ChainId)
+	if phase == types.ConsumerPhaseUnspecified {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	// the state below is only partially set, depending on the phase of the consumer chain
+	clientID, _ := k.GetConsumerClientId(ctx, req.ChainId)
+	channelID, _ := k.GetChainToChannel(ctx, req.ChainId)
+	initParams, _ := k.GetConsumerInitParams(ctx, req.ChainId)
+	spawnHeight, _ := k.GetConsumerSpawnHeight(ctx, req.ChainId)
+	initChainHeight, _ := k.GetInitChainHeight(ctx, req.ChainId)
+	topN, _ := k.GetConsumerTopN(ctx, req.ChainId)
+
+	return &types.QueryConsumerStateDumpResponse{
+		ChainId:           req.ChainId,
+		ClientId:          clientID,
+		ChannelId:         channelID,
+		Phase:             phase,
+		InitParams:        initParams,
+		ValidatorSet:      k.GetConsumerValSet(ctx, req.ChainId),
+		OutstandingVscs:   k.GetAllVscSendTimestamps(ctx, req.ChainId),
+		SlashHistoryCount: uint64(len(k.GetAllJailedByConsumer(ctx, req.ChainId))),
+		SpawnHeight:       spawnHeight,
+		InitChainHeight:   initChainHeight,
+		TopN:              topN,
+		SlashEnabled:      k.IsSlashEnabled(ctx, req.ChainId),
+		StatePreserved:    k.IsConsumerStatePreserved(ctx, req.ChainId),
+	}, nil
+}
+
+func (k Keeper) QueryPendingStoppedChains(goCtx context.Context, req *types.QueryPendingStoppedChainsRequest) (*types.QueryPendingStoppedChainsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chains := []types.PendingStoppedChain{}
+	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
+		// a consumer chain with a cooldown is only stopped once the cooldown elapsed,
+		// see StartConsumerChainCooldown
+		chains = append(chains, types.PendingStoppedChain{
+			ChainId:  prop.ChainId,
+			StopTime: prop.StopTime.Add(prop.Cooldown),
+		})
+	}
+	// the pending consumer removal proposals are ordered by the stop time without the cooldown
+	sort.SliceStable(chains, func(i, j int) bool {
+		return chains[i].StopTime.Before(chains[j].StopTime)
+	})
+
+	return &types.QueryPendingStoppedChainsResponse{Chains: chains}, nil
+}
+
+func (k Keeper) QueryConsumerLatestSeenHeight(goCtx context.Context, req *types.QueryConsumerLatestSeenHeightRequest) (*types.QueryConsumerLatestSeenHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	latestSeenHeight, found := k.GetConsumerLatestSeenHeight(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerLatestSeenHeightResponse{LatestSeenHeight: latestSeenHeight}, nil
+}
+
+func (k Keeper) QueryPendingChainSpawnCountdown(goCtx context.Context, req *types.QueryPendingChainSpawnCountdownRequest) (*types.QueryPendingChainSpawnCountdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	spawnTime, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownPendingConsumerAdditionProp, req.ChainId)
+	}
+
+	// a consumer chain whose spawn time has passed is spawned in the next BeginBlock
+	remaining := spawnTime.Sub(ctx.BlockTime())
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return &types.QueryPendingChainSpawnCountdownResponse{
+		SpawnTime: spawnTime,
+		Remaining: remaining,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerOptedOutValidators(goCtx context.Context, req *types.QueryConsumerOptedOutValidatorsRequest) (*types.QueryConsumerOptedOutValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetConsumerClientId(ctx, req.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	threshold, validators, err := k.GetConsumerOptedOutValidators(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerOptedOutValidatorsResponse{
+		SoftOptOutThreshold: threshold.String(),
+		Validators:          validators,
+	}, nil
+}
+
+func (k Keeper) QueryConsumerClientHistory(goCtx context.Context, req *types.QueryConsumerClientHistoryRequest) (*types.QueryConsumerClientHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: chain id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the client history is retained for removed consumer chains
+	clients := k.GetConsumerClientHistory(ctx, req.ChainId)
+	if len(clients) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, req.ChainId)
+	}
+
+	return &types.QueryConsumerClientHistoryResponse{Clients: clients}, nil
 }
@@ -131,7 +131,13 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {
 }
 
-func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) {
+	// a bonded validator is no longer jailed, so any record
+	// of it being jailed due to a consumer chain is stale
+	providerAddr := providertypes.NewProviderConsAddress(valConsAddr)
+	for _, chain := range h.k.GetAllConsumerChains(ctx) {
+		h.k.DeleteJailedByConsumer(ctx, chain.ChainId, providerAddr)
+	}
 }
 
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {
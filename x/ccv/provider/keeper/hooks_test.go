@@ -8,6 +8,7 @@ import (
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidatorConsensusKeyInUse(t *testing.T) {
@@ -74,3 +75,26 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+// TestAfterValidatorBonded tests that the records of a validator being jailed
+// due to consumer chains are deleted once the validator is bonded again
+func TestAfterValidatorBonded(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	anotherValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+
+	for _, chainID := range []string{"chain-1", "chain-2"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "client-"+chainID)
+		providerKeeper.SetJailedByConsumer(ctx, chainID, validator.ProviderConsAddress())
+		providerKeeper.SetJailedByConsumer(ctx, chainID, anotherValidator.ProviderConsAddress())
+	}
+
+	providerKeeper.Hooks().AfterValidatorBonded(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress())
+
+	for _, chainID := range []string{"chain-1", "chain-2"} {
+		require.False(t, providerKeeper.JailedByConsumer(ctx, chainID, validator.ProviderConsAddress()))
+		require.True(t, providerKeeper.JailedByConsumer(ctx, chainID, anotherValidator.ProviderConsAddress()))
+	}
+}
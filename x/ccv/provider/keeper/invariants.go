@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// Inconsistencies found by the reconciliation of the consumer client and channel mappings
+const (
+	InconsistencyMissingClient          = "missing_client"
+	InconsistencyMissingChannel         = "missing_channel"
+	InconsistencyMissingChannelMapping  = "missing_channel_mapping"
+	InconsistencyDanglingChannelMapping = "dangling_channel_mapping"
+)
+
+// RegisterInvariants registers the provider module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "consumer-state", ConsumerStateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pending-active-disjoint", PendingActiveDisjointInvariant(k))
+}
+
+// ConsumerStateInvariant checks that the state of every consumer chain with a client is consistent, i.e.,
+// the consumer chain is in a phase in which it has a client, a channel mapping exists if the
+// CCV channel was established, and every channel mapping has a matching reverse mapping.
+// A broken invariant indicates state drift, e.g., due to a partial removal or a migration bug.
+func ConsumerStateInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, chain := range k.GetAllConsumerChains(ctx) {
+			phase := k.GetConsumerPhase(ctx, chain.ChainId)
+			switch phase {
+			case types.ConsumerPhaseClientCreated,
+				types.ConsumerPhaseChannelEstablished,
+				types.ConsumerPhaseActive,
+				types.ConsumerPhaseStopping:
+			default:
+				count++
+				msg += fmt.Sprintf("\tconsumer chain %s has client %s but is in phase %s\n",
+					chain.ChainId, chain.ClientId, phase)
+			}
+
+			channelID, found := k.GetChainToChannel(ctx, chain.ChainId)
+			if !found {
+				if phase == types.ConsumerPhaseChannelEstablished || phase == types.ConsumerPhaseActive {
+					count++
+					msg += fmt.Sprintf("\tconsumer chain %s is in phase %s but has no channel\n",
+						chain.ChainId, phase)
+				}
+				continue
+			}
+			if reverseChainID, found := k.GetChannelToChain(ctx, channelID); !found || reverseChainID != chain.ChainId {
+				count++
+				msg += fmt.Sprintf("\tconsumer chain %s has channel %s, but the channel maps to consumer chain %q\n",
+					chain.ChainId, channelID, reverseChainID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "consumer-state",
+			fmt.Sprintf("found %d inconsistencies in the consumer chain state\n%s", count, msg)), count != 0
+	}
+}
+
+// PendingActiveDisjointInvariant checks that no consumer chain has both a pending consumer addition
+// proposal and a client, i.e., that the pending proposal of every spawned consumer chain was deleted.
+func PendingActiveDisjointInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+			if clientID, found := k.GetConsumerClientId(ctx, prop.ChainId); found {
+				count++
+				msg += fmt.Sprintf("\tconsumer chain %s has a pending consumer addition proposal with spawn time %s but also client %s\n",
+					prop.ChainId, prop.SpawnTime.UTC(), clientID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "pending-active-disjoint",
+			fmt.Sprintf("found %d consumer chains that are both pending and active\n%s", count, msg)), count != 0
+	}
+}
+
+// EndBlockReconcile contains the EndBlock logic that reconciles, every ReconciliationInterval blocks,
+// the consumer client and channel mappings with the IBC state, see ReconcileConsumerMappings.
+func (k Keeper) EndBlockReconcile(ctx sdk.Context) {
+	interval := k.GetReconciliationInterval(ctx)
+	if interval == 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	k.ReconcileConsumerMappings(ctx)
+}
+
+// ReconcileConsumerMappings verifies that the client of every consumer chain still exists,
+// that every consumer chain with an established CCV channel has a channel mapping to an existing channel,
+// and that every channel mapping has a matching reverse mapping. Every inconsistency is logged and
+// a consumer_state_inconsistency event is emitted. If the ReconciliationRemoveDangling param is set,
+// the consumer chains whose client or channel no longer exists are stopped via StopConsumerChain,
+// such that all their state is removed consistently, and the channel mappings without a matching
+// reverse mapping are removed.
+//
+// It returns the number of inconsistencies found. Unlike ConsumerStateInvariant, it never halts the chain.
+func (k Keeper) ReconcileConsumerMappings(ctx sdk.Context) (count int) {
+	removeDangling := k.GetReconciliationRemoveDangling(ctx)
+
+	report := func(chainID, inconsistency string, removed bool, attrs ...sdk.Attribute) {
+		count++
+		k.Logger(ctx).Error("inconsistent consumer chain state",
+			"chainID", chainID,
+			"inconsistency", inconsistency,
+			"removed", removed,
+		)
+		attrs = append([]sdk.Attribute{
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeInconsistency, inconsistency),
+			sdk.NewAttribute(ccv.AttributeRemoved, strconv.FormatBool(removed)),
+		}, attrs...)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(ccv.EventTypeConsumerStateInconsistency, attrs...))
+	}
+
+	// stop removes all the state of the given consumer chain, returning false if it cannot be stopped
+	stop := func(chainID string) bool {
+		if !removeDangling {
+			return false
+		}
+		// the channel is not closed, as either the channel or its client no longer exists
+		cachedCtx, writeFn := ctx.CacheContext()
+		if err := k.StopConsumerChain(cachedCtx, chainID, false); err != nil {
+			k.Logger(ctx).Error("inconsistent consumer chain could not be stopped",
+				"chainID", chainID,
+				"error", err.Error(),
+			)
+			return false
+		}
+		writeFn()
+		return true
+	}
+
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		if _, found := k.clientKeeper.GetClientState(ctx, chain.ClientId); !found {
+			removed := stop(chain.ChainId)
+			report(chain.ChainId, InconsistencyMissingClient, removed,
+				sdk.NewAttribute(ccv.AttributeClientID, chain.ClientId))
+			if removed {
+				continue
+			}
+		}
+
+		channelID, found := k.GetChainToChannel(ctx, chain.ChainId)
+		if !found {
+			phase := k.GetConsumerPhase(ctx, chain.ChainId)
+			if phase == types.ConsumerPhaseChannelEstablished || phase == types.ConsumerPhaseActive {
+				// there is no mapping to remove
+				report(chain.ChainId, InconsistencyMissingChannelMapping, false)
+			}
+			continue
+		}
+		if _, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID); !found {
+			report(chain.ChainId, InconsistencyMissingChannel, stop(chain.ChainId),
+				sdk.NewAttribute(ccv.AttributeChannelID, channelID))
+		}
+	}
+
+	for _, channelToChain := range k.GetAllChannelToChains(ctx) {
+		if channelID, found := k.GetChainToChannel(ctx, channelToChain.ChainId); !found || channelID != channelToChain.ChannelId {
+			if removeDangling {
+				k.DeleteChannelToChain(ctx, channelToChain.ChannelId)
+			}
+			report(channelToChain.ChainId, InconsistencyDanglingChannelMapping, removeDangling,
+				sdk.NewAttribute(ccv.AttributeChannelID, channelToChain.ChannelId))
+		}
+	}
+
+	return count
+}
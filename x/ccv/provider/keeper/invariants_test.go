@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+)
+
+// TestConsumerStateInvariant tests that the consumer state invariant
+// is broken only by inconsistent consumer chain states
+func TestConsumerStateInvariant(t *testing.T) {
+	testCases := []struct {
+		name     string
+		setup    func(sdk.Context, providerkeeper.Keeper)
+		expPass  bool
+		expCount string
+	}{
+		{
+			"no consumer chains",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {},
+			true, "found 0 inconsistencies",
+		},
+		{
+			"consistent consumer chains",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				// client created, without a stored phase
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				// active, with a channel
+				k.SetConsumerClientId(ctx, "chain-2", "client-2")
+				k.SetConsumerPhase(ctx, "chain-2", providertypes.ConsumerPhaseActive)
+				k.SetChainToChannel(ctx, "chain-2", "channel-2")
+				k.SetChannelToChain(ctx, "channel-2", "chain-2")
+			},
+			true, "found 0 inconsistencies",
+		},
+		{
+			"consumer chain with a client in the stopped phase",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				k.SetConsumerPhase(ctx, "chain-1", providertypes.ConsumerPhaseStopped)
+			},
+			false, "found 1 inconsistencies",
+		},
+		{
+			"active consumer chain without a channel",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				k.SetConsumerPhase(ctx, "chain-1", providertypes.ConsumerPhaseActive)
+			},
+			false, "found 1 inconsistencies",
+		},
+		{
+			"consumer chain with a channel without a reverse mapping",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				k.SetChainToChannel(ctx, "chain-1", "channel-1")
+			},
+			false, "found 1 inconsistencies",
+		},
+		{
+			"consumer chain with a channel mapped to another consumer chain",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				k.SetChainToChannel(ctx, "chain-1", "channel-1")
+				k.SetChannelToChain(ctx, "channel-1", "chain-2")
+			},
+			false, "found 1 inconsistencies",
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		tc.setup(ctx, providerKeeper)
+		msg, broken := providerkeeper.ConsumerStateInvariant(&providerKeeper)(ctx)
+		require.Equal(t, !tc.expPass, broken, tc.name)
+		require.Contains(t, msg, tc.expCount, tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestPendingActiveDisjointInvariant tests that the pending-active-disjoint invariant
+// is broken only if a spawned consumer chain still has a pending consumer addition proposal
+func TestPendingActiveDisjointInvariant(t *testing.T) {
+	testCases := []struct {
+		name     string
+		setup    func(sdk.Context, providerkeeper.Keeper)
+		expPass  bool
+		expCount string
+	}{
+		{
+			"no consumer chains",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {},
+			true, "found 0 consumer chains",
+		},
+		{
+			"pending and active consumer chains are disjoint",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				prop := testkeeper.GetTestConsumerAdditionProp()
+				prop.ChainId = "chain-1"
+				k.SetPendingConsumerAdditionProp(ctx, prop)
+				k.SetConsumerClientId(ctx, "chain-2", "client-2")
+			},
+			true, "found 0 consumer chains",
+		},
+		{
+			"spawned consumer chain with its pending proposal deleted",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				prop := testkeeper.GetTestConsumerAdditionProp()
+				prop.ChainId = "chain-1"
+				k.SetPendingConsumerAdditionProp(ctx, prop)
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+				k.DeletePendingConsumerAdditionProps(ctx, *prop)
+			},
+			true, "found 0 consumer chains",
+		},
+		{
+			// simulates a spawn that does not clean up the pending proposal
+			"spawned consumer chain with its pending proposal not deleted",
+			func(ctx sdk.Context, k providerkeeper.Keeper) {
+				prop := testkeeper.GetTestConsumerAdditionProp()
+				prop.ChainId = "chain-1"
+				k.SetPendingConsumerAdditionProp(ctx, prop)
+				k.SetConsumerClientId(ctx, "chain-1", "client-1")
+			},
+			false, "found 1 consumer chains",
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		tc.setup(ctx, providerKeeper)
+		msg, broken := providerkeeper.PendingActiveDisjointInvariant(&providerKeeper)(ctx)
+		require.Equal(t, !tc.expPass, broken, tc.name)
+		require.Contains(t, msg, tc.expCount, tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestReconcileConsumerMappings tests that the reconciliation reports the consumer client and channel
+// mappings that are inconsistent with the IBC state, and stops the consumer chains with dangling
+// mappings only if enabled
+func TestReconcileConsumerMappings(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// consistent consumer chain
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetChainToChannel(ctx, "chain-1", "channel-1")
+	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
+	// consumer chain whose client no longer exists
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	providerKeeper.SetConsumerPhase(ctx, "chain-2", providertypes.ConsumerPhaseClientCreated)
+	providerKeeper.AppendPendingVSCPackets(ctx, "chain-2", ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	// active consumer chain whose channel no longer exists
+	providerKeeper.SetConsumerClientId(ctx, "chain-3", "client-3")
+	providerKeeper.SetConsumerPhase(ctx, "chain-3", providertypes.ConsumerPhaseActive)
+	providerKeeper.SetChainToChannel(ctx, "chain-3", "channel-3")
+	providerKeeper.SetChannelToChain(ctx, "channel-3", "chain-3")
+	// channel mapping without a matching reverse mapping
+	providerKeeper.SetChannelToChain(ctx, "channel-4", "chain-4")
+
+	// the reconciliation only runs every ReconciliationInterval blocks,
+	// i.e., no IBC state is read before the mocks are set up
+	ctx = ctx.WithBlockHeight(providertypes.DefaultReconciliationInterval - 1)
+	providerKeeper.EndBlockReconcile(ctx)
+
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "client-1").Return(&ibctmtypes.ClientState{}, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "client-2").Return(nil, false).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "client-3").Return(&ibctmtypes.ClientState{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccvtypes.ProviderPortID, "channel-1").Return(channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccvtypes.ProviderPortID, "channel-3").Return(channeltypes.Channel{}, false).AnyTimes()
+
+	// the inconsistencies are reported, but the mappings are kept by default
+	require.Equal(t, 3, providerKeeper.ReconcileConsumerMappings(ctx))
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chain-2")
+	require.True(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chain-3")
+	require.True(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channel-4")
+	require.True(t, found)
+
+	params := providerKeeper.GetParams(ctx)
+	params.ReconciliationRemoveDangling = true
+	providerKeeper.SetParams(ctx, params)
+
+	// the consumer chains with dangling mappings are stopped, i.e., all their state is removed
+	require.Equal(t, 3, providerKeeper.ReconcileConsumerMappings(ctx))
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain-1")
+	require.True(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain-2")
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain-2"))
+	require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, "chain-2"))
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain-3")
+	require.False(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chain-3")
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channel-3")
+	require.False(t, found)
+	require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, "chain-3"))
+	_, found = providerKeeper.GetChannelToChain(ctx, "channel-4")
+	require.False(t, found)
+
+	// the remaining state is consistent
+	require.Zero(t, providerKeeper.ReconcileConsumerMappings(ctx))
+	_, broken := providerkeeper.ConsumerStateInvariant(&providerKeeper)(ctx)
+	require.False(t, broken)
+}
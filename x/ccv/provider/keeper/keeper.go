@@ -28,19 +28,24 @@ import (
 
 // Keeper defines the Cross-Chain Validation Provider Keeper
 type Keeper struct {
-	storeKey         sdk.StoreKey
-	cdc              codec.BinaryCodec
-	paramSpace       paramtypes.Subspace
-	scopedKeeper     ccv.ScopedKeeper
-	channelKeeper    ccv.ChannelKeeper
-	portKeeper       ccv.PortKeeper
-	connectionKeeper ccv.ConnectionKeeper
-	accountKeeper    ccv.AccountKeeper
-	clientKeeper     ccv.ClientKeeper
-	stakingKeeper    ccv.StakingKeeper
-	slashingKeeper   ccv.SlashingKeeper
-	evidenceKeeper   ccv.EvidenceKeeper
-	feeCollectorName string
+	storeKey           sdk.StoreKey
+	cdc                codec.BinaryCodec
+	paramSpace         paramtypes.Subspace
+	scopedKeeper       ccv.ScopedKeeper
+	channelKeeper      ccv.ChannelKeeper
+	portKeeper         ccv.PortKeeper
+	connectionKeeper   ccv.ConnectionKeeper
+	accountKeeper      ccv.AccountKeeper
+	clientKeeper       ccv.ClientKeeper
+	stakingKeeper      ccv.StakingKeeper
+	slashingKeeper     ccv.SlashingKeeper
+	evidenceKeeper     ccv.EvidenceKeeper
+	bankKeeper         ccv.BankKeeper
+	distributionKeeper ccv.DistributionKeeper
+	feeCollectorName   string
+
+	// the address capable of executing privileged messages, e.g., the gov module account
+	authority string
 }
 
 // NewKeeper creates a new provider Keeper instance
@@ -50,7 +55,8 @@ func NewKeeper(
 	connectionKeeper ccv.ConnectionKeeper, clientKeeper ccv.ClientKeeper,
 	stakingKeeper ccv.StakingKeeper, slashingKeeper ccv.SlashingKeeper,
 	accountKeeper ccv.AccountKeeper, evidenceKeeper ccv.EvidenceKeeper,
-	feeCollectorName string,
+	bankKeeper ccv.BankKeeper, distributionKeeper ccv.DistributionKeeper,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -58,19 +64,22 @@ func NewKeeper(
 	}
 
 	k := Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		paramSpace:       paramSpace,
-		scopedKeeper:     scopedKeeper,
-		channelKeeper:    channelKeeper,
-		portKeeper:       portKeeper,
-		connectionKeeper: connectionKeeper,
-		accountKeeper:    accountKeeper,
-		clientKeeper:     clientKeeper,
-		stakingKeeper:    stakingKeeper,
-		slashingKeeper:   slashingKeeper,
-		evidenceKeeper:   evidenceKeeper,
-		feeCollectorName: feeCollectorName,
+		cdc:                cdc,
+		storeKey:           key,
+		paramSpace:         paramSpace,
+		scopedKeeper:       scopedKeeper,
+		channelKeeper:      channelKeeper,
+		portKeeper:         portKeeper,
+		connectionKeeper:   connectionKeeper,
+		accountKeeper:      accountKeeper,
+		clientKeeper:       clientKeeper,
+		stakingKeeper:      stakingKeeper,
+		slashingKeeper:     slashingKeeper,
+		evidenceKeeper:     evidenceKeeper,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		feeCollectorName:   feeCollectorName,
+		authority:          authority,
 	}
 
 	k.mustValidateFields()
@@ -81,23 +90,31 @@ func NewKeeper(
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 13 {
-		panic("number of fields in provider keeper is not 13")
+	if reflect.ValueOf(k).NumField() != 16 {
+		panic("number of fields in provider keeper is not 16")
 	}
 
-	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                           // 1
-	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                 // 2
-	ccv.PanicIfZeroOrNil(k.paramSpace, "paramSpace")             // 3
-	ccv.PanicIfZeroOrNil(k.scopedKeeper, "scopedKeeper")         // 4
-	ccv.PanicIfZeroOrNil(k.channelKeeper, "channelKeeper")       // 5
-	ccv.PanicIfZeroOrNil(k.portKeeper, "portKeeper")             // 6
-	ccv.PanicIfZeroOrNil(k.connectionKeeper, "connectionKeeper") // 7
-	ccv.PanicIfZeroOrNil(k.accountKeeper, "accountKeeper")       // 8
-	ccv.PanicIfZeroOrNil(k.clientKeeper, "clientKeeper")         // 9
-	ccv.PanicIfZeroOrNil(k.stakingKeeper, "stakingKeeper")       // 10
-	ccv.PanicIfZeroOrNil(k.slashingKeeper, "slashingKeeper")     // 11
-	ccv.PanicIfZeroOrNil(k.evidenceKeeper, "evidenceKeeper")     // 12
-	ccv.PanicIfZeroOrNil(k.feeCollectorName, "feeCollectorName") // 13
+	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                               // 1
+	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                     // 2
+	ccv.PanicIfZeroOrNil(k.paramSpace, "paramSpace")                 // 3
+	ccv.PanicIfZeroOrNil(k.scopedKeeper, "scopedKeeper")             // 4
+	ccv.PanicIfZeroOrNil(k.channelKeeper, "channelKeeper")           // 5
+	ccv.PanicIfZeroOrNil(k.portKeeper, "portKeeper")                 // 6
+	ccv.PanicIfZeroOrNil(k.connectionKeeper, "connectionKeeper")     // 7
+	ccv.PanicIfZeroOrNil(k.accountKeeper, "accountKeeper")           // 8
+	ccv.PanicIfZeroOrNil(k.clientKeeper, "clientKeeper")             // 9
+	ccv.PanicIfZeroOrNil(k.stakingKeeper, "stakingKeeper")           // 10
+	ccv.PanicIfZeroOrNil(k.slashingKeeper, "slashingKeeper")         // 11
+	ccv.PanicIfZeroOrNil(k.evidenceKeeper, "evidenceKeeper")         // 12
+	ccv.PanicIfZeroOrNil(k.bankKeeper, "bankKeeper")                 // 13
+	ccv.PanicIfZeroOrNil(k.distributionKeeper, "distributionKeeper") // 14
+	ccv.PanicIfZeroOrNil(k.feeCollectorName, "feeCollectorName")     // 15
+	ccv.PanicIfZeroOrNil(k.authority, "authority")                   // 16
+}
+
+// GetAuthority returns the address capable of executing privileged messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // Logger returns a module-specific logger.
@@ -142,12 +159,16 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability
 }
 
 // SetChainToChannel sets the mapping from a consumer chainID to the CCV channel ID for that consumer chain.
+// The mapping and its reverse (see SetChannelToChain) are set once the CCV channel is established,
+// i.e., in OnChanOpenConfirm via SetConsumerChain.
 func (k Keeper) SetChainToChannel(ctx sdk.Context, chainID, channelID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.ChainToChannelKey(chainID), []byte(channelID))
 }
 
-// GetChainToChannel gets the CCV channelID for the given consumer chainID
+// GetChainToChannel gets the CCV channelID for the given consumer chainID.
+// All features routing packets to a consumer chain, e.g., sending VSC packets,
+// should use this mapping instead of looking up the channel otherwise.
 func (k Keeper) GetChainToChannel(ctx sdk.Context, chainID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.ChainToChannelKey(chainID))
@@ -204,6 +225,20 @@ func (k Keeper) GetChannelToChain(ctx sdk.Context, channelID string) (string, bo
 	return string(bz), true
 }
 
+// GetConsumerChannelVersion returns the CCV version negotiated for the given CCV channel,
+// i.e., the version in the handshake metadata of the provider, which is stored as the channel version.
+func (k Keeper) GetConsumerChannelVersion(ctx sdk.Context, channelID string) (string, bool) {
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
+	if !found {
+		return "", false
+	}
+	var md types.HandshakeMetadata
+	if err := (&md).Unmarshal([]byte(channel.Version)); err != nil {
+		return "", false
+	}
+	return md.Version, true
+}
+
 // DeleteChannelToChain deletes the consumer chain ID for a given CCV channelID
 func (k Keeper) DeleteChannelToChain(ctx sdk.Context, channelID string) {
 	store := ctx.KVStore(k.storeKey)
@@ -236,13 +271,15 @@ func (k Keeper) GetAllChannelToChains(ctx sdk.Context) (channels []types.Channel
 	return channels
 }
 
+// SetConsumerGenesis stores the consumer genesis state of the given chain,
+// prefixed by the schema version it was serialized with
 func (k Keeper) SetConsumerGenesis(ctx sdk.Context, chainID string, gen consumertypes.GenesisState) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := gen.Marshal()
 	if err != nil {
 		return err
 	}
-	store.Set(types.ConsumerGenesisKey(chainID), bz)
+	store.Set(types.ConsumerGenesisKey(chainID), append([]byte{types.ConsumerGenesisVersion1}, bz...))
 
 	return nil
 }
@@ -254,8 +291,8 @@ func (k Keeper) GetConsumerGenesis(ctx sdk.Context, chainID string) (consumertyp
 		return consumertypes.GenesisState{}, false
 	}
 
-	var data consumertypes.GenesisState
-	if err := data.Unmarshal(bz); err != nil {
+	data, err := unmarshalConsumerGenesis(bz)
+	if err != nil {
 		// An error here would indicate something is very wrong,
 		// the ConsumerGenesis is assumed to be correctly serialized in SetConsumerGenesis.
 		panic(fmt.Errorf("consumer genesis could not be unmarshaled: %w", err))
@@ -263,11 +300,204 @@ func (k Keeper) GetConsumerGenesis(ctx sdk.Context, chainID string) (consumertyp
 	return data, true
 }
 
+// unmarshalConsumerGenesis unmarshals a consumer genesis state stored by SetConsumerGenesis,
+// according to the schema version in its first byte
+func unmarshalConsumerGenesis(bz []byte) (consumertypes.GenesisState, error) {
+	if len(bz) == 0 {
+		return consumertypes.GenesisState{}, fmt.Errorf("missing consumer genesis version")
+	}
+
+	var data consumertypes.GenesisState
+	switch bz[0] {
+	case types.ConsumerGenesisVersion1:
+		if err := data.Unmarshal(bz[1:]); err != nil {
+			return consumertypes.GenesisState{}, err
+		}
+	default:
+		return consumertypes.GenesisState{}, fmt.Errorf("unknown consumer genesis version: %d", bz[0])
+	}
+	return data, nil
+}
+
+// MigrateConsumerGenesesToVersioned prefixes all the consumer genesis states
+// stored before the introduction of schema versions with ConsumerGenesisVersion1
+func (k Keeper) MigrateConsumerGenesesToVersioned(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ConsumerGenesisBytePrefix})
+
+	var unversioned []types.ConsumerState
+	for ; iterator.Valid(); iterator.Next() {
+		// a serialized GenesisState never starts with a version byte,
+		// since a protobuf field tag cannot have field number 0
+		bz := iterator.Value()
+		if len(bz) > 0 && bz[0] == types.ConsumerGenesisVersion1 {
+			continue
+		}
+
+		chainID := string(iterator.Key()[1:])
+		var gen consumertypes.GenesisState
+		if err := gen.Unmarshal(bz); err != nil {
+			iterator.Close()
+			return fmt.Errorf("unversioned consumer genesis of chain %s could not be unmarshaled: %w", chainID, err)
+		}
+		unversioned = append(unversioned, types.ConsumerState{ChainId: chainID, ConsumerGenesis: gen})
+	}
+	iterator.Close()
+
+	for _, cs := range unversioned {
+		if err := k.SetConsumerGenesis(ctx, cs.ChainId, cs.ConsumerGenesis); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetConsumerIntendedParams returns the consumer CCV params the provider set in the consumer genesis
+// of the given consumer chain, i.e., the params the consumer chain is intended to run with
+func (k Keeper) GetConsumerIntendedParams(ctx sdk.Context, chainID string) (consumertypes.Params, bool) {
+	gen, found := k.GetConsumerGenesis(ctx, chainID)
+	if !found {
+		return consumertypes.Params{}, false
+	}
+	return gen.Params, true
+}
+
 func (k Keeper) DeleteConsumerGenesis(ctx sdk.Context, chainID string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerGenesisKey(chainID))
 }
 
+// SetConsumerAcceptedGenesisHash sets the hash of the genesis state
+// the given consumer chain confirmed it started with
+func (k Keeper) SetConsumerAcceptedGenesisHash(ctx sdk.Context, chainID string, genesisHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerAcceptedGenesisHashKey(chainID), genesisHash)
+}
+
+// GetConsumerAcceptedGenesisHash returns the hash of the genesis state
+// the given consumer chain confirmed it started with
+func (k Keeper) GetConsumerAcceptedGenesisHash(ctx sdk.Context, chainID string) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerAcceptedGenesisHashKey(chainID))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// DeleteConsumerAcceptedGenesisHash deletes the hash of the genesis state accepted by the given consumer chain
+func (k Keeper) DeleteConsumerAcceptedGenesisHash(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerAcceptedGenesisHashKey(chainID))
+}
+
+// SetCommittedGenesisHash sets the hash of the genesis state of the given consumer chain
+// committed to when its consumer addition proposal was handled
+func (k Keeper) SetCommittedGenesisHash(ctx sdk.Context, chainID string, genesisHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CommittedGenesisHashKey(chainID), genesisHash)
+}
+
+// GetCommittedGenesisHash returns the hash of the genesis state of the given consumer chain
+// committed to when its consumer addition proposal was handled
+func (k Keeper) GetCommittedGenesisHash(ctx sdk.Context, chainID string) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommittedGenesisHashKey(chainID))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// DeleteCommittedGenesisHash deletes the committed genesis hash of the given consumer chain
+func (k Keeper) DeleteCommittedGenesisHash(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CommittedGenesisHashKey(chainID))
+}
+
+// SetRelayerAllowlist sets the addresses of the relayers the given consumer chain accepts
+// CCV packets from, replacing the previous allowlist
+func (k Keeper) SetRelayerAllowlist(ctx sdk.Context, chainID string, relayers []string) {
+	if len(relayers) == 0 {
+		k.DeleteRelayerAllowlist(ctx, chainID)
+		return
+	}
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.RelayerAllowlist = relayers
+	})
+}
+
+// GetRelayerAllowlist returns the addresses of the relayers the given consumer chain accepts
+// CCV packets from. If empty, CCV packets relayed by any address are accepted.
+func (k Keeper) GetRelayerAllowlist(ctx sdk.Context, chainID string) []string {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	return initParams.RelayerAllowlist
+}
+
+// DeleteRelayerAllowlist deletes the relayer allowlist of the given consumer chain
+func (k Keeper) DeleteRelayerAllowlist(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.RelayerAllowlist = nil
+	})
+}
+
+// SetConsumerInitParams sets the initialization parameters the given consumer chain was spawned with
+func (k Keeper) SetConsumerInitParams(ctx sdk.Context, chainID string, initParams types.ConsumerInitParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := initParams.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// initParams is either instantiated in CreateConsumerClient or set during InitGenesis.
+		panic(fmt.Errorf("consumer init params could not be marshaled: %w", err))
+	}
+	store.Set(types.ConsumerInitParamsKey(chainID), bz)
+}
+
+// GetConsumerInitParams returns the initialization parameters the given consumer chain was spawned with,
+// i.e., a single view of all the per-chain settings of the consumer chain at spawn time
+func (k Keeper) GetConsumerInitParams(ctx sdk.Context, chainID string) (types.ConsumerInitParams, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerInitParamsKey(chainID))
+	if bz == nil {
+		return types.ConsumerInitParams{}, false
+	}
+
+	var initParams types.ConsumerInitParams
+	if err := initParams.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the init params are assumed to be correctly serialized in SetConsumerInitParams.
+		panic(fmt.Errorf("failed to unmarshal consumer init params: %w", err))
+	}
+	return initParams, true
+}
+
+// DeleteConsumerInitParams deletes the initialization parameters of the given consumer chain
+func (k Keeper) DeleteConsumerInitParams(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerInitParamsKey(chainID))
+}
+
+// setConsumerInitParam applies the given update to the initialization parameters of the given
+// consumer chain, starting from empty initialization parameters if none are stored.
+// The initialization parameters are the only store of the per-chain settings they contain.
+func (k Keeper) setConsumerInitParam(ctx sdk.Context, chainID string, update func(*types.ConsumerInitParams)) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	update(&initParams)
+	k.SetConsumerInitParams(ctx, chainID, initParams)
+}
+
+// clearConsumerInitParam applies the given update to the initialization parameters of the given
+// consumer chain, if any are stored
+func (k Keeper) clearConsumerInitParam(ctx sdk.Context, chainID string, update func(*types.ConsumerInitParams)) {
+	initParams, found := k.GetConsumerInitParams(ctx, chainID)
+	if !found {
+		return
+	}
+	update(&initParams)
+	k.SetConsumerInitParams(ctx, chainID, initParams)
+}
+
 // VerifyConsumerChain verifies that the chain trying to connect on the channel handshake
 // is the expected consumer chain.
 func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) error {
@@ -320,6 +550,10 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 		return sdkerrors.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannelID, chainID)
 	}
 
+	if err := k.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseChannelEstablished); err != nil {
+		return err
+	}
+
 	// the CCV channel is established:
 	// - set channel mappings
 	k.SetChainToChannel(ctx, chainID, channelID)
@@ -691,9 +925,13 @@ func (k Keeper) GetAllValsetUpdateBlockHeights(ctx sdk.Context) (valsetUpdateBlo
 		valsetUpdateId := binary.BigEndian.Uint64(iterator.Key()[1:])
 		height := binary.BigEndian.Uint64(iterator.Value())
 
+		// the block time is zero if it is not known
+		blockTime, _ := k.GetValsetUpdateBlockTime(ctx, valsetUpdateId)
+
 		valsetUpdateBlockHeights = append(valsetUpdateBlockHeights, types.ValsetUpdateIdToHeight{
 			ValsetUpdateId: valsetUpdateId,
 			Height:         height,
+			BlockTime:      blockTime,
 		})
 	}
 
@@ -706,6 +944,80 @@ func (k Keeper) DeleteValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId ui
 	store.Delete(types.ValsetUpdateBlockHeightKey(valsetUpdateId))
 }
 
+// SetValsetUpdateBlockTime sets the block time for a given valset update id
+func (k Keeper) SetValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValsetUpdateBlockTimeKey(valsetUpdateId), sdk.FormatTimeBytes(blockTime))
+}
+
+// GetValsetUpdateBlockTime gets the block time for a given valset update id
+func (k Keeper) GetValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValsetUpdateBlockTimeKey(valsetUpdateId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	blockTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the block time is assumed to be correctly serialized in SetValsetUpdateBlockTime.
+		panic(fmt.Errorf("failed to parse block time for vscID %d: %w", valsetUpdateId, err))
+	}
+	return blockTime, true
+}
+
+// DeleteValsetUpdateBlockTime deletes the block time for a given valset update id
+func (k Keeper) DeleteValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValsetUpdateBlockTimeKey(valsetUpdateId))
+}
+
+// getEarliestValsetUpdateBlockTime returns the valset update id with the earliest block time
+//
+// Note that the mapping from vscIDs to block times is stored under keys with the following format:
+// ValsetUpdateBlockTimeBytePrefix | vscID
+// Thus, the first entry is the one of the lowest vscID, i.e., of the earliest block time.
+func (k Keeper) getEarliestValsetUpdateBlockTime(ctx sdk.Context) (valsetUpdateId uint64, blockTime time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ValsetUpdateBlockTimeBytePrefix})
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, time.Time{}, false
+	}
+	valsetUpdateId = binary.BigEndian.Uint64(iterator.Key()[1:])
+	blockTime, found = k.GetValsetUpdateBlockTime(ctx, valsetUpdateId)
+	return valsetUpdateId, blockTime, found
+}
+
+// PruneValsetUpdateBlockTimes deletes the block times of the valset update ids that are
+// outside the unbonding window, except for the latest one of them, which is kept as an
+// upper bound on the block times of the deleted ones, see WithinUnbondingWindow.
+func (k Keeper) PruneValsetUpdateBlockTimes(ctx sdk.Context) {
+	unbondingTime := k.stakingKeeper.UnbondingTime(ctx)
+
+	var expiredIds []uint64
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ValsetUpdateBlockTimeBytePrefix})
+	for ; iterator.Valid(); iterator.Next() {
+		blockTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the block time is assumed to be correctly serialized in SetValsetUpdateBlockTime.
+			panic(fmt.Errorf("failed to parse block time: %w", err))
+		}
+		if blockTime.Add(unbondingTime).After(ctx.BlockTime()) {
+			break
+		}
+		expiredIds = append(expiredIds, binary.BigEndian.Uint64(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	for i := 0; i < len(expiredIds)-1; i++ {
+		k.DeleteValsetUpdateBlockTime(ctx, expiredIds[i])
+	}
+}
+
 // SetSlashAcks sets the slash acks under the given chain ID
 //
 // TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
@@ -797,6 +1109,202 @@ func (k Keeper) DeleteInitChainHeight(ctx sdk.Context, chainID string) {
 	store.Delete(types.InitChainHeightKey(chainID))
 }
 
+// SetConsumerSpawnHeight sets the provider block height at which the client
+// of the given consumer chain was created
+func (k Keeper) SetConsumerSpawnHeight(ctx sdk.Context, chainID string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerSpawnHeightKey(chainID), sdk.Uint64ToBigEndian(height))
+}
+
+// GetConsumerSpawnHeight returns the provider block height at which the client of the given
+// consumer chain was created. Note that this is distinct from the initial height of the client,
+// which is a height of the consumer chain.
+func (k Keeper) GetConsumerSpawnHeight(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerSpawnHeightKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteConsumerSpawnHeight deletes the spawn height of the given consumer chain
+func (k Keeper) DeleteConsumerSpawnHeight(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerSpawnHeightKey(chainID))
+}
+
+// SetConsumerClientInitialHeight sets the initial height the client
+// of the given consumer chain was created with
+func (k Keeper) SetConsumerClientInitialHeight(ctx sdk.Context, chainID string, height clienttypes.Height) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.InitialHeight = height
+	})
+}
+
+// GetConsumerClientInitialHeight returns the initial height the client
+// of the given consumer chain was created with
+func (k Keeper) GetConsumerClientInitialHeight(ctx sdk.Context, chainID string) (clienttypes.Height, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.InitialHeight.IsZero() {
+		return clienttypes.Height{}, false
+	}
+	return initParams.InitialHeight, true
+}
+
+// DeleteConsumerClientInitialHeight deletes the initial height the client
+// of the given consumer chain was created with
+func (k Keeper) DeleteConsumerClientInitialHeight(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.InitialHeight = clienttypes.Height{}
+	})
+}
+
+// SetConsumerLatestSeenHeight sets the latest height of the given consumer chain
+// seen by the provider via the updates of the consumer client
+func (k Keeper) SetConsumerLatestSeenHeight(ctx sdk.Context, chainID string, height clienttypes.Height) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := height.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the height is assumed to be a valid client height.
+		panic(fmt.Errorf("failed to marshal latest seen height: %w", err))
+	}
+	store.Set(types.ConsumerLatestSeenHeightKey(chainID), bz)
+}
+
+// GetConsumerLatestSeenHeight returns the latest height of the given consumer chain
+// seen by the provider via the updates of the consumer client
+func (k Keeper) GetConsumerLatestSeenHeight(ctx sdk.Context, chainID string) (clienttypes.Height, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerLatestSeenHeightKey(chainID))
+	if bz == nil {
+		return clienttypes.Height{}, false
+	}
+
+	var height clienttypes.Height
+	if err := height.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the latest seen height is assumed to be correctly serialized in SetConsumerLatestSeenHeight.
+		panic(fmt.Errorf("failed to unmarshal latest seen height: %w", err))
+	}
+	return height, true
+}
+
+// DeleteConsumerLatestSeenHeight deletes the latest height of the given consumer chain
+// seen by the provider
+func (k Keeper) DeleteConsumerLatestSeenHeight(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerLatestSeenHeightKey(chainID))
+}
+
+// SetConsumerCreationUnbondingTime sets the provider unbonding time the client
+// of the given consumer chain was created with
+func (k Keeper) SetConsumerCreationUnbondingTime(ctx sdk.Context, chainID string, unbondingTime time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerCreationUnbondingTimeKey(chainID), sdk.Uint64ToBigEndian(uint64(unbondingTime)))
+}
+
+// GetConsumerCreationUnbondingTime returns the provider unbonding time the client
+// of the given consumer chain was created with
+func (k Keeper) GetConsumerCreationUnbondingTime(ctx sdk.Context, chainID string) (time.Duration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerCreationUnbondingTimeKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteConsumerCreationUnbondingTime deletes the provider unbonding time the client
+// of the given consumer chain was created with
+func (k Keeper) DeleteConsumerCreationUnbondingTime(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerCreationUnbondingTimeKey(chainID))
+}
+
+// SetLastProviderUnbondingTime sets the provider unbonding time last observed by the provider module
+func (k Keeper) SetLastProviderUnbondingTime(ctx sdk.Context, unbondingTime time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastProviderUnbondingTimeKey(), sdk.Uint64ToBigEndian(uint64(unbondingTime)))
+}
+
+// GetLastProviderUnbondingTime returns the provider unbonding time last observed by the provider module
+func (k Keeper) GetLastProviderUnbondingTime(ctx sdk.Context) (time.Duration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastProviderUnbondingTimeKey())
+	if bz == nil {
+		return 0, false
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz)), true
+}
+
+// AppendRecentSpawn appends the given spawn record to the log of recent consumer chain spawns
+// and prunes the oldest records, such that the log holds at most MaxRecentSpawns records
+func (k Keeper) AppendRecentSpawn(ctx sdk.Context, record types.SpawnRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the sequence number of a record follows the one of the latest record
+	seq := uint64(0)
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{types.RecentSpawnBytePrefix})
+	if iterator.Valid() {
+		seq = sdk.BigEndianToUint64(iterator.Key()[1:]) + 1
+	}
+	iterator.Close()
+
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal spawn record: %w", err))
+	}
+	store.Set(types.RecentSpawnKey(seq), bz)
+
+	k.pruneRecentSpawns(ctx)
+}
+
+// pruneRecentSpawns deletes the oldest records from the log of recent consumer chain spawns,
+// such that the log holds at most MaxRecentSpawns records
+func (k Keeper) pruneRecentSpawns(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	maxRecentSpawns := k.GetMaxRecentSpawns(ctx)
+
+	var keysToDel [][]byte
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{types.RecentSpawnBytePrefix})
+	for n := int64(0); iterator.Valid(); iterator.Next() {
+		if n >= maxRecentSpawns {
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		n++
+	}
+	// Close iterator before deleting from state
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetRecentSpawns returns the records of the log of recent consumer chain spawns,
+// ordered from the most recent spawn
+func (k Keeper) GetRecentSpawns(ctx sdk.Context) (records []types.SpawnRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{types.RecentSpawnBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SpawnRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the records are assumed to be correctly serialized in AppendRecentSpawn.
+			panic(fmt.Errorf("failed to unmarshal spawn record: %w", err))
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
 // GetPendingVSCPackets returns the list of pending ValidatorSetChange packets stored under chain ID
 func (k Keeper) GetPendingVSCPackets(ctx sdk.Context, chainID string) []ccv.ValidatorSetChangePacketData {
 	var packets ccv.ValidatorSetChangePackets
@@ -836,69 +1344,486 @@ func (k Keeper) DeletePendingVSCPackets(ctx sdk.Context, chainID string) {
 	store.Delete(types.PendingVSCsKey(chainID))
 }
 
-// SetConsumerClientId sets the client ID for the given chain ID
-func (k Keeper) SetConsumerClientId(ctx sdk.Context, chainID, clientID string) {
+// SetVscSendFailures sets the number of consecutive failures
+// to send the pending VSC packets to the given consumer chain
+func (k Keeper) SetVscSendFailures(ctx sdk.Context, chainID string, failures uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ChainToClientKey(chainID), []byte(clientID))
+	store.Set(types.VscSendFailuresKey(chainID), sdk.Uint64ToBigEndian(failures))
 }
 
-// GetConsumerClientId returns the client ID for the given chain ID.
-func (k Keeper) GetConsumerClientId(ctx sdk.Context, chainID string) (string, bool) {
+// GetVscSendFailures returns the number of consecutive failures
+// to send the pending VSC packets to the given consumer chain
+func (k Keeper) GetVscSendFailures(ctx sdk.Context, chainID string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	clientIdBytes := store.Get(types.ChainToClientKey(chainID))
-	if clientIdBytes == nil {
-		return "", false
+	bz := store.Get(types.VscSendFailuresKey(chainID))
+	if bz == nil {
+		return 0
 	}
-	return string(clientIdBytes), true
+	return sdk.BigEndianToUint64(bz)
 }
 
-// DeleteConsumerClientId removes from the store the clientID for the given chainID.
-func (k Keeper) DeleteConsumerClientId(ctx sdk.Context, chainID string) {
+// DeleteVscSendFailures deletes the number of consecutive failures
+// to send the pending VSC packets to the given consumer chain
+func (k Keeper) DeleteVscSendFailures(ctx sdk.Context, chainID string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ChainToClientKey(chainID))
+	store.Delete(types.VscSendFailuresKey(chainID))
 }
 
-// SetInitTimeoutTimestamp sets the init timeout timestamp for the given chain ID
-func (k Keeper) SetInitTimeoutTimestamp(ctx sdk.Context, chainID string, ts uint64) {
+// SetLastMaturedVscId sets the highest ID of the VSC packets matured on the given consumer chain
+func (k Keeper) SetLastMaturedVscId(ctx sdk.Context, chainID string, vscID uint64) {
 	store := ctx.KVStore(k.storeKey)
-	tsBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(tsBytes, ts)
-	store.Set(types.InitTimeoutTimestampKey(chainID), tsBytes)
+	store.Set(types.LastMaturedVscIdKey(chainID), sdk.Uint64ToBigEndian(vscID))
 }
 
-// GetInitTimeoutTimestamp returns the init timeout timestamp for the given chain ID.
-// This method is used only in testing.
-func (k Keeper) GetInitTimeoutTimestamp(ctx sdk.Context, chainID string) (uint64, bool) {
+// GetLastMaturedVscId returns the highest ID of the VSC packets matured on the given consumer chain
+func (k Keeper) GetLastMaturedVscId(ctx sdk.Context, chainID string) (uint64, bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.InitTimeoutTimestampKey(chainID))
+	bz := store.Get(types.LastMaturedVscIdKey(chainID))
 	if bz == nil {
 		return 0, false
 	}
-	return binary.BigEndian.Uint64(bz), true
+	return sdk.BigEndianToUint64(bz), true
 }
 
-// DeleteInitTimeoutTimestamp removes from the store the init timeout timestamp for the given chainID.
-func (k Keeper) DeleteInitTimeoutTimestamp(ctx sdk.Context, chainID string) {
+// DeleteLastMaturedVscId deletes the highest ID of the VSC packets matured on the given consumer chain
+func (k Keeper) DeleteLastMaturedVscId(ctx sdk.Context, chainID string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.InitTimeoutTimestampKey(chainID))
+	store.Delete(types.LastMaturedVscIdKey(chainID))
 }
 
-// GetAllInitTimeoutTimestamps gets all init timeout timestamps in the store.
-//
-// Note that the init timeout timestamps are stored under keys with the following format:
-// InitTimeoutTimestampBytePrefix | chainID
-// Thus, the returned array is in ascending order of chainIDs (NOT in timestamp order).
-func (k Keeper) GetAllInitTimeoutTimestamps(ctx sdk.Context) (initTimeoutTimestamps []types.InitTimeoutTimestamp) {
+// SetVscSendRetryHeight sets the block height from which
+// sending the pending VSC packets to the given consumer chain is retried
+func (k Keeper) SetVscSendRetryHeight(ctx sdk.Context, chainID string, height uint64) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{types.InitTimeoutTimestampBytePrefix})
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		chainID := string(iterator.Key()[1:])
-		ts := binary.BigEndian.Uint64(iterator.Value())
+	store.Set(types.VscSendRetryHeightKey(chainID), sdk.Uint64ToBigEndian(height))
+}
 
-		initTimeoutTimestamps = append(initTimeoutTimestamps, types.InitTimeoutTimestamp{
-			ChainId:   chainID,
+// GetVscSendRetryHeight returns the block height from which
+// sending the pending VSC packets to the given consumer chain is retried
+func (k Keeper) GetVscSendRetryHeight(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VscSendRetryHeightKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteVscSendRetryHeight deletes the block height from which
+// sending the pending VSC packets to the given consumer chain is retried
+func (k Keeper) DeleteVscSendRetryHeight(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VscSendRetryHeightKey(chainID))
+}
+
+// SetConsumerClientId sets the client ID for the given chain ID.
+// The consumer chain count is incremented if the chain ID had no client ID, see GetConsumerChainCount.
+// A client ID different from the current one is appended to the client history, see GetConsumerClientHistory.
+func (k Keeper) SetConsumerClientId(ctx sdk.Context, chainID, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	prevClientID := store.Get(types.ChainToClientKey(chainID))
+	if prevClientID == nil {
+		k.setConsumerChainCount(ctx, k.GetConsumerChainCount(ctx)+1)
+	} else {
+		store.Delete(types.ClientToChainKey(string(prevClientID)))
+	}
+	store.Set(types.ChainToClientKey(chainID), []byte(clientID))
+	store.Set(types.ClientToChainKey(clientID), []byte(chainID))
+	if string(prevClientID) != clientID {
+		k.appendConsumerClientHistory(ctx, chainID, clientID)
+	}
+}
+
+// appendConsumerClientHistory appends the given client to the client history of the given consumer chain
+// and prunes the oldest records, such that the history holds at most MaxConsumerClientHistory records
+func (k Keeper) appendConsumerClientHistory(ctx sdk.Context, chainID, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChainIdWithLenKey(types.ConsumerClientHistoryBytePrefix, chainID)
+
+	// the sequence number of a record follows the one of the latest record
+	seq := uint64(0)
+	iterator := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iterator.Valid() {
+		seq = sdk.BigEndianToUint64(iterator.Key()[len(prefix):]) + 1
+	}
+	iterator.Close()
+
+	record := types.ConsumerClientRecord{
+		ClientId:    clientID,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().UTC(),
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is instantiated above and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal consumer client record: %w", err))
+	}
+	store.Set(types.ConsumerClientHistoryKey(chainID, seq), bz)
+
+	var keysToDel [][]byte
+	iterator = sdk.KVStoreReversePrefixIterator(store, prefix)
+	for n := 0; iterator.Valid(); iterator.Next() {
+		if n >= types.MaxConsumerClientHistory {
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		n++
+	}
+	// Close iterator before deleting from state
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetConsumerClientHistory returns the client history of the given consumer chain, ordered from the oldest client.
+//
+// Note that the client history is retained when the consumer chain is removed while preserving its state,
+// so that it can still be audited, see StopConsumerChainPreservingState.
+func (k Keeper) GetConsumerClientHistory(ctx sdk.Context, chainID string) (records []types.ConsumerClientRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerClientHistoryBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ConsumerClientRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in appendConsumerClientHistory.
+			panic(fmt.Errorf("failed to unmarshal consumer client record: %w", err))
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetConsumerClientHistory replaces the client history of the given consumer chain
+// with the given records, ordered from the oldest client, e.g., on genesis import
+func (k Keeper) SetConsumerClientHistory(ctx sdk.Context, chainID string, records []types.ConsumerClientRecord) {
+	k.DeleteConsumerClientHistory(ctx, chainID)
+
+	store := ctx.KVStore(k.storeKey)
+	for seq, record := range records {
+		bz, err := record.Marshal()
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the records are validated in GenesisState.Validate().
+			panic(fmt.Errorf("failed to marshal consumer client record: %w", err))
+		}
+		store.Set(types.ConsumerClientHistoryKey(chainID, uint64(seq)), bz)
+	}
+}
+
+// DeleteConsumerClientHistory deletes the client history of the given consumer chain
+func (k Keeper) DeleteConsumerClientHistory(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerClientHistoryBytePrefix, chainID))
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	// Close iterator before deleting from state
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetAllConsumerClientHistories returns the client histories of all the consumer chains,
+// including the removed ones whose state is preserved, ordered by chain ID.
+//
+// Note that the client records are stored under keys with the following format:
+// ConsumerClientHistoryBytePrefix | len(chainID) | chainID | seq
+// Thus, the records of a consumer chain are contiguous and ordered from the oldest client.
+func (k Keeper) GetAllConsumerClientHistories(ctx sdk.Context) (histories []types.ConsumerClientHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ConsumerClientHistoryBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, _, err := types.ParseChainIdAndUintIdKey(types.ConsumerClientHistoryBytePrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in appendConsumerClientHistory.
+			panic(fmt.Errorf("failed to parse consumer client history key: %w", err))
+		}
+		var record types.ConsumerClientRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in appendConsumerClientHistory.
+			panic(fmt.Errorf("failed to unmarshal consumer client record: %w", err))
+		}
+		if len(histories) == 0 || histories[len(histories)-1].ChainId != chainID {
+			histories = append(histories, types.ConsumerClientHistory{ChainId: chainID})
+		}
+		histories[len(histories)-1].Records = append(histories[len(histories)-1].Records, record)
+	}
+
+	return histories
+}
+
+// GetConsumerClientId returns the client ID for the given chain ID.
+func (k Keeper) GetConsumerClientId(ctx sdk.Context, chainID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientIdBytes := store.Get(types.ChainToClientKey(chainID))
+	if clientIdBytes == nil {
+		return "", false
+	}
+	return string(clientIdBytes), true
+}
+
+// GetChainIDByClientID returns the chain ID of the consumer chain with the given client ID.
+func (k Keeper) GetChainIDByClientID(ctx sdk.Context, clientID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	chainIDBytes := store.Get(types.ClientToChainKey(clientID))
+	if chainIDBytes == nil {
+		return "", false
+	}
+	return string(chainIDBytes), true
+}
+
+// BackfillClientToChainIndex writes the mapping from the client ID to the chain ID
+// of every consumer chain with a client ID, i.e., the reverse index of the client IDs,
+// which is not stored for the consumer chains added before consensus version 2.
+// Re-running it is a no-op.
+func (k Keeper) BackfillClientToChainIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		store.Set(types.ClientToChainKey(chain.ClientId), []byte(chain.ChainId))
+	}
+}
+
+// BackfillConsumerChainCount writes the number of consumer chains with a client ID,
+// which is not stored for the consumer chains added before consensus version 2.
+// Without it, removing such a consumer chain would underflow the count.
+// Re-running it is a no-op.
+func (k Keeper) BackfillConsumerChainCount(ctx sdk.Context) {
+	k.setConsumerChainCount(ctx, uint64(len(k.GetAllConsumerChains(ctx))))
+}
+
+// GetConsumerClientStatus returns the status of the given consumer client, i.e.,
+// Active, Expired or Frozen, as resolved by the IBC client keeper.
+// Unknown is returned if there is no client state for the given client ID.
+func (k Keeper) GetConsumerClientStatus(ctx sdk.Context, clientID string) ibcexported.Status {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return ibcexported.Unknown
+	}
+	return clientState.Status(ctx, k.clientKeeper.ClientStore(ctx, clientID), k.cdc)
+}
+
+// DeleteConsumerClientId removes from the store the clientID for the given chainID.
+// The consumer chain count is decremented if the chain ID had a client ID, see GetConsumerChainCount.
+func (k Keeper) DeleteConsumerClientId(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	if clientID := store.Get(types.ChainToClientKey(chainID)); clientID != nil {
+		k.setConsumerChainCount(ctx, k.GetConsumerChainCount(ctx)-1)
+		store.Delete(types.ClientToChainKey(string(clientID)))
+	}
+	store.Delete(types.ChainToClientKey(chainID))
+}
+
+// GetConsumerChainCount returns the number of registered consumer chains,
+// i.e., the number of consumer chains with a client ID, without iterating over them.
+func (k Keeper) GetConsumerChainCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerChainCountKey())
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setConsumerChainCount sets the number of registered consumer chains.
+// Note that the count is only updated through SetConsumerClientId and DeleteConsumerClientId.
+func (k Keeper) setConsumerChainCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerChainCountKey(), sdk.Uint64ToBigEndian(count))
+}
+
+// SetConsumerCandidateClientId sets the candidate client ID for the given chain ID,
+// i.e., a client that replaces the consumer client once promoted via PromoteConsumerClient.
+// The candidate client must be a client of the consumer chain different from the consumer client.
+func (k Keeper) SetConsumerCandidateClientId(ctx sdk.Context, chainID, clientID string) error {
+	consumerClientID, found := k.GetConsumerClientId(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", chainID)
+	}
+	if consumerClientID == clientID {
+		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerClient,
+			"candidate client %s is already the client of consumer chain %s", clientID, chainID)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotFound, "candidate client %s not found", clientID)
+	}
+	tmClient, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid client type. expected %s, got %s", ibcexported.Tendermint, clientState.ClientType())
+	}
+	if tmClient.ChainId != chainID {
+		return sdkerrors.Wrapf(ccv.ErrInvalidConsumerClient,
+			"candidate client %s is a client of chain %s, expected %s", clientID, tmClient.ChainId, chainID)
+	}
+
+	k.setConsumerCandidateClientId(ctx, chainID, clientID)
+	return nil
+}
+
+// setConsumerCandidateClientId sets the candidate clientID for the given chainID without validating it
+func (k Keeper) setConsumerCandidateClientId(ctx sdk.Context, chainID, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChainToCandidateClientKey(chainID), []byte(clientID))
+}
+
+// GetConsumerCandidateClientId returns the candidate client ID for the given chain ID.
+func (k Keeper) GetConsumerCandidateClientId(ctx sdk.Context, chainID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientIdBytes := store.Get(types.ChainToCandidateClientKey(chainID))
+	if clientIdBytes == nil {
+		return "", false
+	}
+	return string(clientIdBytes), true
+}
+
+// DeleteConsumerCandidateClientId removes from the store the candidate clientID for the given chainID.
+func (k Keeper) DeleteConsumerCandidateClientId(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChainToCandidateClientKey(chainID))
+}
+
+// PromoteConsumerClient replaces the client of the given consumer chain with its candidate client.
+// Both the consumer client and the candidate client are updated in a single step, so that
+// all the logic relying on the consumer client switches to the candidate client at once.
+func (k Keeper) PromoteConsumerClient(ctx sdk.Context, chainID string) error {
+	candidateClientID, found := k.GetConsumerCandidateClientId(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrClientNotFound, "cannot find candidate client for consumer chain %s", chainID)
+	}
+	prevClientID, _ := k.GetConsumerClientId(ctx, chainID)
+
+	k.SetConsumerClientId(ctx, chainID, candidateClientID)
+	k.DeleteConsumerCandidateClientId(ctx, chainID)
+
+	k.Logger(ctx).Info("consumer client promoted",
+		"chainID", chainID,
+		"previous clientID", prevClientID,
+		"clientID", candidateClientID,
+	)
+	return nil
+}
+
+// SetConsumerPhase sets the lifecycle phase of the given consumer chain.
+//
+// Note: the phase transitions are enforced by TransitionConsumerPhase.
+func (k Keeper) SetConsumerPhase(ctx sdk.Context, chainID string, phase types.ConsumerPhase) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerPhaseKey(chainID), []byte{byte(phase)})
+}
+
+// GetConsumerPhase returns the lifecycle phase of the given consumer chain.
+// If no phase is stored, e.g., for consumer chains added before the phases were tracked,
+// the phase is derived from the state of the consumer chain.
+func (k Keeper) GetConsumerPhase(ctx sdk.Context, chainID string) types.ConsumerPhase {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerPhaseKey(chainID))
+	if len(bz) == 1 {
+		return types.ConsumerPhase(bz[0])
+	}
+
+	if _, found := k.GetChainToChannel(ctx, chainID); found {
+		return types.ConsumerPhaseChannelEstablished
+	}
+	if _, found := k.GetConsumerClientId(ctx, chainID); found {
+		return types.ConsumerPhaseClientCreated
+	}
+	if _, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, chainID); found {
+		return types.ConsumerPhasePending
+	}
+	return types.ConsumerPhaseUnspecified
+}
+
+// GetConsumerChainsInPhase returns the chain IDs of the consumer chains with the given stored lifecycle phase.
+//
+// Note that the consumer chains without a stored phase are not returned, see GetConsumerPhase.
+func (k Keeper) GetConsumerChainsInPhase(ctx sdk.Context, phase types.ConsumerPhase) (chainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.ConsumerPhaseBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if bz := iterator.Value(); len(bz) == 1 && types.ConsumerPhase(bz[0]) == phase {
+			chainIDs = append(chainIDs, string(iterator.Key()[1:]))
+		}
+	}
+	return chainIDs
+}
+
+// DeleteConsumerPhase removes from the store the lifecycle phase of the given consumer chain
+func (k Keeper) DeleteConsumerPhase(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerPhaseKey(chainID))
+}
+
+// TransitionConsumerPhase moves the given consumer chain to the next lifecycle phase.
+// An error is returned if the transition from the current phase is not legal,
+// which would indicate a bug in the consumer chain lifecycle logic.
+func (k Keeper) TransitionConsumerPhase(ctx sdk.Context, chainID string, next types.ConsumerPhase) error {
+	current := k.GetConsumerPhase(ctx, chainID)
+	if !current.CanTransitionTo(next) {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerPhaseTransition,
+			"consumer chain %s cannot transition from %s to %s", chainID, current, next)
+	}
+	k.SetConsumerPhase(ctx, chainID, next)
+	return nil
+}
+
+// SetInitTimeoutTimestamp sets the init timeout timestamp for the given chain ID
+func (k Keeper) SetInitTimeoutTimestamp(ctx sdk.Context, chainID string, ts uint64) {
+	store := ctx.KVStore(k.storeKey)
+	tsBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tsBytes, ts)
+	store.Set(types.InitTimeoutTimestampKey(chainID), tsBytes)
+}
+
+// GetInitTimeoutTimestamp returns the init timeout timestamp for the given chain ID.
+// This method is used only in testing.
+func (k Keeper) GetInitTimeoutTimestamp(ctx sdk.Context, chainID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InitTimeoutTimestampKey(chainID))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// DeleteInitTimeoutTimestamp removes from the store the init timeout timestamp for the given chainID.
+func (k Keeper) DeleteInitTimeoutTimestamp(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.InitTimeoutTimestampKey(chainID))
+}
+
+// GetAllInitTimeoutTimestamps gets all init timeout timestamps in the store.
+//
+// Note that the init timeout timestamps are stored under keys with the following format:
+// InitTimeoutTimestampBytePrefix | chainID
+// Thus, the returned array is in ascending order of chainIDs (NOT in timestamp order).
+func (k Keeper) GetAllInitTimeoutTimestamps(ctx sdk.Context) (initTimeoutTimestamps []types.InitTimeoutTimestamp) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.InitTimeoutTimestampBytePrefix})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		chainID := string(iterator.Key()[1:])
+		ts := binary.BigEndian.Uint64(iterator.Value())
+
+		initTimeoutTimestamps = append(initTimeoutTimestamps, types.InitTimeoutTimestamp{
+			ChainId:   chainID,
 			Timestamp: ts,
 		})
 	}
@@ -1026,6 +1951,170 @@ func (k Keeper) GetFirstVscSendTimestamp(ctx sdk.Context, chainID string) (vscSe
 	return types.VscSendTimestamp{}, false
 }
 
+// SetVscMaturityTime sets the maturity time of the VSCPacket
+// with ID vscID sent to a chain with ID chainID
+func (k Keeper) SetVscMaturityTime(ctx sdk.Context, chainID string, vscID uint64, maturityTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VscMaturityTimeKey(chainID, vscID), sdk.FormatTimeBytes(maturityTime))
+}
+
+// GetVscMaturityTime returns the maturity time of the VSCPacket
+// with ID vscID sent to a chain with ID chainID
+func (k Keeper) GetVscMaturityTime(ctx sdk.Context, chainID string, vscID uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VscMaturityTimeKey(chainID, vscID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	maturityTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the maturity time is assumed to be correctly serialized in SetVscMaturityTime.
+		panic(fmt.Errorf("failed to parse maturity time: %w", err))
+	}
+	return maturityTime, true
+}
+
+// DeleteVscMaturityTime removes the maturity time of the VSCPacket
+// with ID vscID sent to a chain with ID chainID
+func (k Keeper) DeleteVscMaturityTime(ctx sdk.Context, chainID string, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VscMaturityTimeKey(chainID, vscID))
+}
+
+// DeleteVscMaturityTimesForConsumer deletes all VSC maturity times for a given consumer chain
+func (k Keeper) DeleteVscMaturityTimesForConsumer(ctx sdk.Context, consumerChainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.VscMaturityTimeBytePrefix, consumerChainID))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// SetVscPendingChunkAcks sets the number of chunks of the VSCPacket with ID vscID
+// sent to a chain with ID chainID that were not yet acknowledged
+func (k Keeper) SetVscPendingChunkAcks(ctx sdk.Context, chainID string, vscID uint64, chunks uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VscPendingChunkAcksKey(chainID, vscID), sdk.Uint64ToBigEndian(chunks))
+}
+
+// GetVscPendingChunkAcks returns the number of chunks of the VSCPacket with ID vscID
+// sent to a chain with ID chainID that were not yet acknowledged
+func (k Keeper) GetVscPendingChunkAcks(ctx sdk.Context, chainID string, vscID uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VscPendingChunkAcksKey(chainID, vscID))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteVscPendingChunkAcks deletes the number of unacknowledged chunks of the VSCPacket
+// with ID vscID sent to a chain with ID chainID
+func (k Keeper) DeleteVscPendingChunkAcks(ctx sdk.Context, chainID string, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VscPendingChunkAcksKey(chainID, vscID))
+}
+
+// HasVscPendingChunkAcks returns true if any VSCPacket sent to the given consumer chain
+// has chunks that were not yet acknowledged
+func (k Keeper) HasVscPendingChunkAcks(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.VscPendingChunkAcksBytePrefix, chainID))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// SetVscMaturityDeferred records that the VSCPacket with ID vscID matured on a chain
+// with ID chainID before all its chunks were acknowledged
+func (k Keeper) SetVscMaturityDeferred(ctx sdk.Context, chainID string, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VscMaturityDeferredKey(chainID, vscID), []byte{})
+}
+
+// IsVscMaturityDeferred returns true if the VSCPacket with ID vscID matured on a chain
+// with ID chainID before all its chunks were acknowledged
+func (k Keeper) IsVscMaturityDeferred(ctx sdk.Context, chainID string, vscID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.VscMaturityDeferredKey(chainID, vscID))
+}
+
+// DeleteVscMaturityDeferred deletes the deferred maturity of the VSCPacket
+// with ID vscID sent to a chain with ID chainID
+func (k Keeper) DeleteVscMaturityDeferred(ctx sdk.Context, chainID string, vscID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VscMaturityDeferredKey(chainID, vscID))
+}
+
+// DeleteVscChunksForConsumer deletes the unacknowledged chunks and the deferred maturities
+// of all the VSCPackets sent to a given consumer chain
+func (k Keeper) DeleteVscChunksForConsumer(ctx sdk.Context, consumerChainID string) {
+	store := ctx.KVStore(k.storeKey)
+
+	keysToDel := [][]byte{}
+	for _, prefix := range []byte{types.VscPendingChunkAcksBytePrefix, types.VscMaturityDeferredBytePrefix} {
+		iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(prefix, consumerChainID))
+		for ; iterator.Valid(); iterator.Next() {
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetVscUnbondingPeriod returns the unbonding period used to compute the maturity time
+// of the VSCPackets sent to the given consumer chain, i.e., the consumer unbonding period.
+// If the consumer genesis is not found, the provider unbonding period is returned.
+func (k Keeper) GetVscUnbondingPeriod(ctx sdk.Context, chainID string) time.Duration {
+	if gen, found := k.GetConsumerGenesis(ctx, chainID); found {
+		return gen.Params.UnbondingPeriod
+	}
+	return k.stakingKeeper.UnbondingTime(ctx)
+}
+
+// SetConsumerVscPacketTimeoutPeriod sets the timeout period of the VSC packets sent to the given consumer chain
+func (k Keeper) SetConsumerVscPacketTimeoutPeriod(ctx sdk.Context, chainID string, period time.Duration) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.VscPacketTimeoutPeriod = period
+	})
+}
+
+// GetConsumerVscPacketTimeoutPeriod returns the timeout period of the VSC packets sent to the given consumer chain.
+// If not found, the ccv timeout period of the provider params is used.
+func (k Keeper) GetConsumerVscPacketTimeoutPeriod(ctx sdk.Context, chainID string) (time.Duration, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.VscPacketTimeoutPeriod == 0 {
+		return 0, false
+	}
+	return initParams.VscPacketTimeoutPeriod, true
+}
+
+// DeleteConsumerVscPacketTimeoutPeriod deletes the VSC packet timeout period of the given consumer chain
+func (k Keeper) DeleteConsumerVscPacketTimeoutPeriod(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.VscPacketTimeoutPeriod = 0
+	})
+}
+
+// GetVscPacketTimeoutPeriod returns the timeout period of the VSC packets sent to the given consumer chain,
+// i.e., the VSC packet timeout period of the consumer chain if set, and the provider ccv timeout period otherwise.
+func (k Keeper) GetVscPacketTimeoutPeriod(ctx sdk.Context, chainID string) time.Duration {
+	if period, found := k.GetConsumerVscPacketTimeoutPeriod(ctx, chainID); found {
+		return period
+	}
+	return k.GetCCVTimeoutPeriod(ctx)
+}
+
 // SetSlashLog updates validator's slash log for a consumer chain
 // If an entry exists for a given validator address, at least one
 // double signing slash packet was received by the provider from at least one consumer chain
@@ -1047,3 +2136,100 @@ func (k Keeper) GetSlashLog(
 	bz := store.Get(types.SlashLogKey(providerAddr))
 	return bz != nil
 }
+
+// SetSlashEnabled sets whether downtime infractions committed on the given consumer chain
+// result in slashing
+func (k Keeper) SetSlashEnabled(ctx sdk.Context, chainID string, enabled bool) {
+	if !enabled {
+		k.DeleteSlashEnabled(ctx, chainID)
+		return
+	}
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.SlashEnabled = true
+	})
+}
+
+// IsSlashEnabled returns true if downtime infractions committed on the given
+// consumer chain result in slashing
+func (k Keeper) IsSlashEnabled(ctx sdk.Context, chainID string) bool {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	return initParams.SlashEnabled
+}
+
+// DeleteSlashEnabled deletes the slash enabled flag for the given consumer chain
+func (k Keeper) DeleteSlashEnabled(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.SlashEnabled = false
+	})
+}
+
+// SetConsumerStatePreserved flags that the state of the given consumer chain
+// was preserved when it was stopped
+func (k Keeper) SetConsumerStatePreserved(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerStatePreservedKey(chainID), []byte{})
+}
+
+// IsConsumerStatePreserved returns true if the state of the given consumer chain
+// was preserved when it was stopped and is not yet purged
+func (k Keeper) IsConsumerStatePreserved(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerStatePreservedKey(chainID))
+}
+
+// DeleteConsumerStatePreserved deletes the preserved state flag for the given consumer chain
+func (k Keeper) DeleteConsumerStatePreserved(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerStatePreservedKey(chainID))
+}
+
+// SetJailedByConsumer records that the given provider validator was jailed
+// due to an infraction committed on the given consumer chain
+func (k Keeper) SetJailedByConsumer(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerJailedValidatorKey(chainID, providerAddr), []byte{})
+}
+
+// JailedByConsumer returns whether the given provider validator was jailed
+// due to an infraction committed on the given consumer chain
+func (k Keeper) JailedByConsumer(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerJailedValidatorKey(chainID, providerAddr))
+}
+
+// DeleteJailedByConsumer deletes the record that the given provider validator
+// was jailed due to an infraction committed on the given consumer chain
+func (k Keeper) DeleteJailedByConsumer(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerJailedValidatorKey(chainID, providerAddr))
+}
+
+// GetAllJailedByConsumer returns the provider addresses of all the validators
+// that were jailed due to an infraction committed on the given consumer chain
+//
+// Note that the validators are ordered by their provider consensus address.
+func (k Keeper) GetAllJailedByConsumer(ctx sdk.Context, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerJailedValidatorsBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, providerAddr, err := types.ParseChainIdAndConsAddrKey(types.ConsumerJailedValidatorsBytePrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetJailedByConsumer.
+			panic(fmt.Errorf("failed to parse ConsumerJailedValidatorKey: %w", err))
+		}
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(providerAddr))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllJailedByConsumer deletes the records of all the validators
+// that were jailed due to an infraction committed on the given consumer chain
+func (k Keeper) DeleteAllJailedByConsumer(ctx sdk.Context, chainID string) {
+	for _, providerAddr := range k.GetAllJailedByConsumer(ctx, chainID) {
+		k.DeleteJailedByConsumer(ctx, chainID, providerAddr)
+	}
+}
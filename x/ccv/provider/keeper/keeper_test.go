@@ -2,21 +2,33 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/rand"
 	"sort"
 	"testing"
 	"time"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 
 	ibcsimapp "github.com/cosmos/interchain-security/legacy_ibc_testing/simapp"
 
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,6 +60,61 @@ func TestValsetUpdateBlockHeight(t *testing.T) {
 	require.Equal(t, blockHeight, uint64(4))
 }
 
+// TestValsetUpdateBlockTime tests the getter and setter methods for valset updates mapped to block time
+func TestValsetUpdateBlockTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetValsetUpdateBlockTime(ctx, uint64(1))
+	require.False(t, found)
+
+	expectedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	providerKeeper.SetValsetUpdateBlockTime(ctx, uint64(1), expectedTime)
+	blockTime, found := providerKeeper.GetValsetUpdateBlockTime(ctx, uint64(1))
+	require.True(t, found)
+	require.Equal(t, expectedTime, blockTime)
+
+	providerKeeper.DeleteValsetUpdateBlockTime(ctx, uint64(1))
+	_, found = providerKeeper.GetValsetUpdateBlockTime(ctx, uint64(1))
+	require.False(t, found)
+}
+
+// TestPruneValsetUpdateBlockTimes tests that the block times outside the unbonding window are deleted,
+// except for the latest one of them
+func TestPruneValsetUpdateBlockTimes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := 21 * 24 * time.Hour
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingTime).Times(3)
+
+	// no block times
+	providerKeeper.PruneValsetUpdateBlockTimes(ctx)
+
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 1, now.Add(-unbondingTime).Add(-2*time.Hour))
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 2, now.Add(-unbondingTime).Add(-time.Hour))
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 3, now.Add(-unbondingTime))
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 4, now.Add(-unbondingTime).Add(time.Nanosecond))
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 5, now)
+
+	expectMapped := func(expMapped []bool) {
+		for i, exp := range expMapped {
+			_, found := providerKeeper.GetValsetUpdateBlockTime(ctx, uint64(i+1))
+			require.Equal(t, exp, found, "vscID %d", i+1)
+		}
+	}
+
+	// vscIDs 1 and 2 are deleted, vscID 3 is the latest one outside the unbonding window
+	providerKeeper.PruneValsetUpdateBlockTimes(ctx)
+	expectMapped([]bool{false, false, true, true, true})
+
+	// pruning is idempotent
+	providerKeeper.PruneValsetUpdateBlockTimes(ctx)
+	expectMapped([]bool{false, false, true, true, true})
+}
+
 // TestGetAllValsetUpdateBlockHeights tests GetAllValsetUpdateBlockHeights behaviour correctness
 func TestGetAllValsetUpdateBlockHeights(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -222,6 +289,134 @@ func TestInitHeight(t *testing.T) {
 	}
 }
 
+// TestConsumerClientInitialHeight tests the getter, setter, and deletion methods
+// for the initial heights the consumer clients were created with
+func TestConsumerClientInitialHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerClientInitialHeight(ctx, "chainID")
+	require.False(t, found)
+
+	providerKeeper.SetConsumerClientInitialHeight(ctx, "chainID", clienttypes.NewHeight(2, 3))
+	providerKeeper.SetConsumerClientInitialHeight(ctx, "chainID1", clienttypes.NewHeight(0, 1))
+
+	height, found := providerKeeper.GetConsumerClientInitialHeight(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(2, 3), height)
+
+	providerKeeper.DeleteConsumerClientInitialHeight(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerClientInitialHeight(ctx, "chainID")
+	require.False(t, found)
+	height, found = providerKeeper.GetConsumerClientInitialHeight(ctx, "chainID1")
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(0, 1), height)
+}
+
+// TestConsumerCreationUnbondingTime tests the getter, setter, and deletion methods
+// for the provider unbonding times the consumer clients were created with
+func TestConsumerCreationUnbondingTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerCreationUnbondingTime(ctx, "chainID")
+	require.False(t, found)
+
+	providerKeeper.SetConsumerCreationUnbondingTime(ctx, "chainID", 21*24*time.Hour)
+	providerKeeper.SetConsumerCreationUnbondingTime(ctx, "chainID1", time.Hour)
+
+	unbondingTime, found := providerKeeper.GetConsumerCreationUnbondingTime(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, 21*24*time.Hour, unbondingTime)
+
+	providerKeeper.DeleteConsumerCreationUnbondingTime(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerCreationUnbondingTime(ctx, "chainID")
+	require.False(t, found)
+	unbondingTime, found = providerKeeper.GetConsumerCreationUnbondingTime(ctx, "chainID1")
+	require.True(t, found)
+	require.Equal(t, time.Hour, unbondingTime)
+}
+
+// TestRecentSpawns tests that the log of recent consumer chain spawns
+// holds the most recent spawns, bounded by the MaxRecentSpawns param
+func TestRecentSpawns(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.MaxRecentSpawns = 3
+	providerKeeper.SetParams(ctx, params)
+
+	require.Empty(t, providerKeeper.GetRecentSpawns(ctx))
+
+	spawnTime := time.Now().UTC()
+	records := []types.SpawnRecord{}
+	for i := 0; i < 5; i++ {
+		record := types.SpawnRecord{
+			ChainId:     fmt.Sprintf("chain-%d", i),
+			ClientId:    fmt.Sprintf("client-%d", i),
+			SpawnTime:   spawnTime.Add(time.Duration(i) * time.Hour),
+			BlockHeight: int64(10 + i),
+		}
+		records = append(records, record)
+		providerKeeper.AppendRecentSpawn(ctx, record)
+	}
+
+	// only the three most recent spawns are logged, ordered from the most recent one
+	require.Equal(t, []types.SpawnRecord{records[4], records[3], records[2]}, providerKeeper.GetRecentSpawns(ctx))
+
+	// lowering the bound prunes the log on the next spawn
+	params.MaxRecentSpawns = 1
+	providerKeeper.SetParams(ctx, params)
+	record := types.SpawnRecord{ChainId: "chain-5", ClientId: "client-5", SpawnTime: spawnTime, BlockHeight: 15}
+	providerKeeper.AppendRecentSpawn(ctx, record)
+	require.Equal(t, []types.SpawnRecord{record}, providerKeeper.GetRecentSpawns(ctx))
+}
+
+// TestGetConsumerClientStatus tests that the status of a consumer client is resolved correctly
+func TestGetConsumerClientStatus(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	// the client store holds tendermint consensus states
+	clienttypes.RegisterInterfaces(keeperParams.Cdc.InterfaceRegistry())
+	ibctmtypes.RegisterInterfaces(keeperParams.Cdc.InterfaceRegistry())
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	height := clienttypes.NewHeight(0, 5)
+	clientStore := prefix.NewStore(ctx.KVStore(keeperParams.StoreKey), []byte("clients/clientID/"))
+
+	// unknown client
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1)
+	require.Equal(t, ibcexported.Unknown, providerKeeper.GetConsumerClientStatus(ctx, "clientID"))
+
+	// frozen client
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+		&ibctmtypes.ClientState{LatestHeight: height, FrozenHeight: clienttypes.NewHeight(0, 1)}, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().ClientStore(ctx, "clientID").Return(clientStore).Times(1)
+	require.Equal(t, ibcexported.Frozen, providerKeeper.GetConsumerClientStatus(ctx, "clientID"))
+
+	// expired client, i.e., without a consensus state at the latest height
+	clientState := &ibctmtypes.ClientState{LatestHeight: height, TrustingPeriod: time.Hour}
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(clientState, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().ClientStore(ctx, "clientID").Return(clientStore).Times(1)
+	require.Equal(t, ibcexported.Expired, providerKeeper.GetConsumerClientStatus(ctx, "clientID"))
+
+	// active client
+	clientStore.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(
+		keeperParams.Cdc, &ibctmtypes.ConsensusState{Timestamp: now.Add(-time.Minute)}))
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(clientState, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().ClientStore(ctx, "clientID").Return(clientStore).Times(1)
+	require.Equal(t, ibcexported.Active, providerKeeper.GetConsumerClientStatus(ctx, "clientID"))
+
+	// expired client, i.e., the trusting period elapsed since the latest consensus state
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(clientState, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().ClientStore(ctx, "clientID").Return(clientStore).Times(1)
+	require.Equal(t, ibcexported.Expired, providerKeeper.GetConsumerClientStatus(ctx, "clientID"))
+}
+
 // TestGetAllUnbondingOpIndexes tests GetAllUnbondingOpIndexes behavior correctness
 func TestGetAllUnbondingOpIndexes(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -391,6 +586,72 @@ func TestVscSendTimestamp(t *testing.T) {
 	require.Empty(t, providerKeeper.GetAllVscSendTimestamps(ctx, chainID))
 }
 
+// TestVscMaturityTime tests the set, get, and deletion methods for VSC maturity times
+func TestVscMaturityTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	maturityTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, found := providerKeeper.GetVscMaturityTime(ctx, "chain", 1)
+	require.False(t, found)
+
+	providerKeeper.SetVscMaturityTime(ctx, "chain", 1, maturityTime)
+	providerKeeper.SetVscMaturityTime(ctx, "chain", 2, maturityTime.Add(time.Hour))
+	providerKeeper.SetVscMaturityTime(ctx, "chain1", 1, maturityTime)
+
+	mt, found := providerKeeper.GetVscMaturityTime(ctx, "chain", 2)
+	require.True(t, found)
+	require.Equal(t, maturityTime.Add(time.Hour), mt)
+
+	providerKeeper.DeleteVscMaturityTime(ctx, "chain", 2)
+	_, found = providerKeeper.GetVscMaturityTime(ctx, "chain", 2)
+	require.False(t, found)
+
+	// delete all VSC maturity times of a consumer chain
+	providerKeeper.DeleteVscMaturityTimesForConsumer(ctx, "chain")
+	_, found = providerKeeper.GetVscMaturityTime(ctx, "chain", 1)
+	require.False(t, found)
+	_, found = providerKeeper.GetVscMaturityTime(ctx, "chain1", 1)
+	require.True(t, found)
+}
+
+// TestVscChunks tests the set, get, and deletion methods for the unacknowledged chunks
+// and the deferred maturities of VSC packets
+func TestVscChunks(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetVscPendingChunkAcks(ctx, "chain", 1)
+	require.False(t, found)
+	require.False(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain"))
+	require.False(t, providerKeeper.IsVscMaturityDeferred(ctx, "chain", 1))
+
+	providerKeeper.SetVscPendingChunkAcks(ctx, "chain", 1, 3)
+	providerKeeper.SetVscPendingChunkAcks(ctx, "chain1", 1, 2)
+	providerKeeper.SetVscMaturityDeferred(ctx, "chain", 1)
+	providerKeeper.SetVscMaturityDeferred(ctx, "chain1", 1)
+
+	pending, found := providerKeeper.GetVscPendingChunkAcks(ctx, "chain", 1)
+	require.True(t, found)
+	require.Equal(t, uint64(3), pending)
+	require.True(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain"))
+	require.False(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain2"))
+	require.True(t, providerKeeper.IsVscMaturityDeferred(ctx, "chain", 1))
+
+	providerKeeper.DeleteVscPendingChunkAcks(ctx, "chain1", 1)
+	require.False(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain1"))
+	providerKeeper.DeleteVscMaturityDeferred(ctx, "chain1", 1)
+	require.False(t, providerKeeper.IsVscMaturityDeferred(ctx, "chain1", 1))
+
+	// delete all the VSC chunks of a consumer chain
+	providerKeeper.SetVscPendingChunkAcks(ctx, "chain1", 1, 2)
+	providerKeeper.DeleteVscChunksForConsumer(ctx, "chain")
+	require.False(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain"))
+	require.False(t, providerKeeper.IsVscMaturityDeferred(ctx, "chain", 1))
+	require.True(t, providerKeeper.HasVscPendingChunkAcks(ctx, "chain1"))
+}
+
 // TestGetAllConsumerChains tests GetAllConsumerChains behaviour correctness
 func TestGetAllConsumerChains(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -413,6 +674,432 @@ func TestGetAllConsumerChains(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestQueryConsumerRelationships tests that the relationships with all consumer chains
+// with a client are returned in pages, in ascending order of chain IDs
+func TestQueryConsumerRelationships(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(42)
+
+	// the client states are not found, i.e., the client status is unknown
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	pk.SetConsumerClientId(ctx, "chain-2", "client-2")
+	pk.SetConsumerPhase(ctx, "chain-2", types.ConsumerPhaseClientCreated)
+	pk.SetConsumerClientId(ctx, "chain-1", "client-1")
+	pk.SetConsumerPhase(ctx, "chain-1", types.ConsumerPhaseActive)
+	pk.SetInitChainHeight(ctx, "chain-1", 7)
+	pk.SetConsumerClientId(ctx, "chain-3", "client-3")
+	pk.SetConsumerPhase(ctx, "chain-3", types.ConsumerPhaseChannelEstablished)
+	pk.SetInitChainHeight(ctx, "chain-3", 9)
+	// consumer chains without a client are not returned
+	pk.SetConsumerPhase(ctx, "chain-4", types.ConsumerPhasePending)
+
+	expRelationships := []types.ConsumerRelationship{
+		{ChainId: "chain-1", ClientId: "client-1", Phase: types.ConsumerPhaseActive, InitChainHeight: 7, Status: ibcexported.Unknown.String()},
+		{ChainId: "chain-2", ClientId: "client-2", Phase: types.ConsumerPhaseClientCreated, Status: ibcexported.Unknown.String()},
+		{ChainId: "chain-3", ClientId: "client-3", Phase: types.ConsumerPhaseChannelEstablished, InitChainHeight: 9, Status: ibcexported.Unknown.String()},
+	}
+
+	// query the relationships in pages of two
+	relationships := []types.ConsumerRelationship{}
+	var nextKey []byte
+	for {
+		res, err := pk.QueryConsumerRelationships(sdk.WrapSDKContext(ctx),
+			&types.QueryConsumerRelationshipsRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+			})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Relationships), 2)
+		require.Equal(t, int64(42), res.Height)
+		relationships = append(relationships, res.Relationships...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, expRelationships, relationships)
+}
+
+// TestQueryTemplateClient tests that the template client query returns the template client in the params
+func TestQueryTemplateClient(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.TemplateClient.MaxClockDrift = 42 * time.Second
+	pk.SetParams(ctx, params)
+
+	_, err := pk.QueryTemplateClient(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	res, err := pk.QueryTemplateClient(sdk.WrapSDKContext(ctx), &types.QueryTemplateClientRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.TemplateClient, res.TemplateClient)
+	require.Equal(t, 42*time.Second, res.TemplateClient.MaxClockDrift)
+}
+
+// TestQueryCcvVersion tests that the CCV version query returns the version
+// used in the channel handshake metadata
+func TestQueryCcvVersion(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryCcvVersion(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	res, err := pk.QueryCcvVersion(sdk.WrapSDKContext(ctx), &types.QueryCcvVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, ccv.Version, res.Version)
+}
+
+// TestQueryConsumerClientLatestUpdate tests that the timestamp of the latest consensus state
+// of a consumer client and the time elapsed since are returned
+func TestQueryConsumerClientLatestUpdate(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := pk.QueryConsumerClientLatestUpdate(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerClientLatestUpdate(sdk.WrapSDKContext(ctx), &types.QueryConsumerClientLatestUpdateRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerClientLatestUpdateRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerClientLatestUpdate(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	pk.SetConsumerClientId(ctx, "chainID", "clientID")
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1)
+	_, err = pk.QueryConsumerClientLatestUpdate(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	latestHeight := clienttypes.NewHeight(1, 42)
+	timestamp := now.Add(-time.Hour)
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+			&ibctmtypes.ClientState{LatestHeight: latestHeight}, true).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(ctx, "clientID").Return(
+			&ibctmtypes.ConsensusState{Timestamp: timestamp}, true).Times(1),
+	)
+	res, err := pk.QueryConsumerClientLatestUpdate(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, "clientID", res.ClientId)
+	require.Equal(t, latestHeight, res.LatestHeight)
+	require.True(t, timestamp.Equal(res.Timestamp))
+	require.Equal(t, time.Hour, res.Elapsed)
+}
+
+// TestQueryConsumerGenesisDiff tests that the differences between the stored consumer genesis
+// and a freshly computed one are returned without modifying the state
+func TestQueryConsumerGenesisDiff(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	pk.SetParams(ctx, types.DefaultParams())
+
+	_, err := pk.QueryConsumerGenesisDiff(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerGenesisDiff(sdk.WrapSDKContext(ctx), &types.QueryConsumerGenesisDiffRequest{})
+	require.Error(t, err)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	req := &types.QueryConsumerGenesisDiffRequest{ChainId: prop.ChainId}
+	_, err = pk.QueryConsumerGenesisDiff(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	// the mocked initial validator set consists of a single validator with power 1
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	cachedCtx, _ := ctx.CacheContext()
+	storedGen, _, err := pk.MakeConsumerGenesis(cachedCtx, prop)
+	require.NoError(t, err)
+	require.Len(t, storedGen.InitialValSet, 1)
+
+	// the power of the mocked validator changed and another validator left
+	otherVal := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 3}
+	pubKey := storedGen.InitialValSet[0].PubKey
+	storedGen.InitialValSet = []abci.ValidatorUpdate{{PubKey: pubKey, Power: 5}, otherVal}
+	require.NoError(t, pk.SetConsumerGenesis(ctx, prop.ChainId, storedGen))
+
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	res, err := pk.QueryConsumerGenesisDiff(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Empty(t, res.Added)
+	require.Equal(t, []types.ConsumerGenesisValidatorChange{{PubKey: otherVal.PubKey, StoredPower: 3}}, res.Removed)
+	require.Equal(t, []types.ConsumerGenesisValidatorChange{{PubKey: pubKey, StoredPower: 5, FreshPower: 1}}, res.Changed)
+	require.False(t, res.ParamsChanged)
+	require.Len(t, res.FreshGenesis.InitialValSet, 1)
+	// the initial validator set of the fresh genesis is not stored
+	require.Empty(t, pk.GetConsumerValSet(ctx, prop.ChainId))
+
+	// the provider client in the stored genesis has another trusting period and there are no validators
+	storedGen.ProviderClientState.TrustingPeriod++
+	storedGen.InitialValSet = nil
+	require.NoError(t, pk.SetConsumerGenesis(ctx, prop.ChainId, storedGen))
+
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	res, err = pk.QueryConsumerGenesisDiff(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerGenesisValidatorChange{{PubKey: pubKey, FreshPower: 1}}, res.Added)
+	require.Empty(t, res.Removed)
+	require.Empty(t, res.Changed)
+	require.True(t, res.ParamsChanged)
+}
+
+// TestQueryConsumerIntendedParams tests that the consumer CCV params in the stored
+// consumer genesis of a consumer chain are returned
+func TestQueryConsumerIntendedParams(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryConsumerIntendedParams(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerIntendedParams(sdk.WrapSDKContext(ctx), &types.QueryConsumerIntendedParamsRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerIntendedParamsRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerIntendedParams(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	gen := *consumertypes.DefaultGenesisState()
+	gen.Params.Enabled = true
+	gen.Params.ConsumerRedistributionFraction = "0.5"
+	gen.Params.UnbondingPeriod = time.Hour
+	require.NoError(t, pk.SetConsumerGenesis(ctx, "chainID", gen))
+
+	res, err := pk.QueryConsumerIntendedParams(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, gen.Params, res.Params)
+}
+
+// TestQueryConsumerStateDump tests that the state the provider keeps for a consumer chain
+// is returned in a single snapshot
+func TestQueryConsumerStateDump(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryConsumerStateDump(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerStateDump(sdk.WrapSDKContext(ctx), &types.QueryConsumerStateDumpRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerStateDumpRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerStateDump(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	// a consumer chain with a client only
+	pk.SetConsumerClientId(ctx, "chainID", "clientID")
+	res, err := pk.QueryConsumerStateDump(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerStateDumpResponse{
+		ChainId:  "chainID",
+		ClientId: "clientID",
+		Phase:    types.ConsumerPhaseClientCreated,
+	}, res)
+
+	// an active consumer chain
+	valSet := []abci.ValidatorUpdate{{
+		PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(),
+		Power:  10,
+	}}
+	initParams := types.ConsumerInitParams{SpawnTime: time.Unix(100, 0).UTC(), TopN: 50, SlashEnabled: true}
+	vscSendTime := time.Unix(200, 0).UTC()
+
+	pk.SetChainToChannel(ctx, "chainID", "channelID")
+	pk.SetConsumerPhase(ctx, "chainID", types.ConsumerPhaseActive)
+	pk.SetConsumerInitParams(ctx, "chainID", initParams)
+	pk.SetConsumerValSet(ctx, "chainID", valSet)
+	pk.SetVscSendTimestamp(ctx, "chainID", 3, vscSendTime)
+	pk.SetJailedByConsumer(ctx, "chainID", types.NewProviderConsAddress([]byte("providerAddr1")))
+	pk.SetJailedByConsumer(ctx, "chainID", types.NewProviderConsAddress([]byte("providerAddr2")))
+	pk.SetConsumerSpawnHeight(ctx, "chainID", 5)
+	pk.SetInitChainHeight(ctx, "chainID", 7)
+	pk.SetConsumerTopN(ctx, "chainID", 50)
+	pk.SetSlashEnabled(ctx, "chainID", true)
+
+	res, err = pk.QueryConsumerStateDump(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerStateDumpResponse{
+		ChainId:           "chainID",
+		ClientId:          "clientID",
+		ChannelId:         "channelID",
+		Phase:             types.ConsumerPhaseActive,
+		InitParams:        initParams,
+		ValidatorSet:      valSet,
+		OutstandingVscs:   []types.VscSendTimestamp{{VscId: 3, Timestamp: vscSendTime}},
+		SlashHistoryCount: 2,
+		SpawnHeight:       5,
+		InitChainHeight:   7,
+		TopN:              50,
+		SlashEnabled:      true,
+	}, res)
+}
+
+// TestQueryPendingStoppedChains tests that the consumer chains scheduled to stop
+// are returned ordered by the time at which they are stopped, including the cooldown
+func TestQueryPendingStoppedChains(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryPendingStoppedChains(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	// no consumer chains scheduled to stop
+	res, err := pk.QueryPendingStoppedChains(sdk.WrapSDKContext(ctx), &types.QueryPendingStoppedChainsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Chains)
+
+	now := time.Now().UTC()
+	pk.SetPendingConsumerRemovalProp(ctx, &types.ConsumerRemovalProposal{ChainId: "chain-1", StopTime: now.Add(time.Hour)})
+	pk.SetPendingConsumerRemovalProp(ctx, &types.ConsumerRemovalProposal{ChainId: "chain-2", StopTime: now, Cooldown: 2 * time.Hour})
+	pk.SetPendingConsumerRemovalProp(ctx, &types.ConsumerRemovalProposal{ChainId: "chain-3", StopTime: now.Add(30 * time.Minute)})
+
+	res, err = pk.QueryPendingStoppedChains(sdk.WrapSDKContext(ctx), &types.QueryPendingStoppedChainsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingStoppedChain{
+		{ChainId: "chain-3", StopTime: now.Add(30 * time.Minute)},
+		{ChainId: "chain-1", StopTime: now.Add(time.Hour)},
+		{ChainId: "chain-2", StopTime: now.Add(2 * time.Hour)},
+	}, res.Chains)
+}
+
+// TestQueryPendingChainSpawnCountdown tests that the time remaining until a pending consumer chain
+// is spawned is computed from the spawn time of its consumer addition proposal
+func TestQueryPendingChainSpawnCountdown(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := pk.QueryPendingChainSpawnCountdown(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryPendingChainSpawnCountdown(sdk.WrapSDKContext(ctx), &types.QueryPendingChainSpawnCountdownRequest{})
+	require.Error(t, err)
+
+	// the consumer chain is not pending
+	req := &types.QueryPendingChainSpawnCountdownRequest{ChainId: "chain-1"}
+	_, err = pk.QueryPendingChainSpawnCountdown(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownPendingConsumerAdditionProp)
+
+	pk.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{ChainId: "chain-1", SpawnTime: now.Add(time.Hour)})
+	pk.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{ChainId: "chain-2", SpawnTime: now.Add(-time.Minute)})
+
+	res, err := pk.QueryPendingChainSpawnCountdown(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), res.SpawnTime)
+	require.Equal(t, time.Hour, res.Remaining)
+
+	// the spawn time has passed
+	res, err = pk.QueryPendingChainSpawnCountdown(sdk.WrapSDKContext(ctx), &types.QueryPendingChainSpawnCountdownRequest{ChainId: "chain-2"})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-time.Minute), res.SpawnTime)
+	require.Zero(t, res.Remaining)
+}
+
+// TestQueryConsumerClientExpiry tests that the time remaining until a consumer client
+// expires is computed from its trusting period and its latest consensus state
+func TestQueryConsumerClientExpiry(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := pk.QueryConsumerClientExpiry(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerClientExpiry(sdk.WrapSDKContext(ctx), &types.QueryConsumerClientExpiryRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerClientExpiryRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerClientExpiry(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	pk.SetConsumerClientId(ctx, "chainID", "clientID")
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1)
+	_, err = pk.QueryConsumerClientExpiry(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	testCases := []struct {
+		name         string
+		timestamp    time.Time
+		expRemaining time.Duration
+	}{
+		{"not expired", now.Add(-time.Hour), 23 * time.Hour},
+		{"expiring now", now.Add(-24 * time.Hour), 0},
+		{"expired", now.Add(-25 * time.Hour), -time.Hour},
+	}
+	for _, tc := range testCases {
+		gomock.InOrder(
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+				&ibctmtypes.ClientState{TrustingPeriod: 24 * time.Hour}, true).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(ctx, "clientID").Return(
+				&ibctmtypes.ConsensusState{Timestamp: tc.timestamp}, true).Times(1),
+		)
+		res, err := pk.QueryConsumerClientExpiry(sdk.WrapSDKContext(ctx), req)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, "clientID", res.ClientId, tc.name)
+		require.True(t, tc.timestamp.Add(24*time.Hour).Equal(res.ExpiryTime), tc.name)
+		require.Equal(t, tc.expRemaining, res.Remaining, tc.name)
+	}
+}
+
+// TestConsumerChainCount tests that the consumer chain count is consistent
+// with the registered consumer chains across add and remove cycles
+func TestConsumerChainCount(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Zero(t, pk.GetConsumerChainCount(ctx))
+
+	rng := rand.New(rand.NewSource(1))
+	chainIDs := []string{"chain-1", "chain-2", "chain-3", "chain-4", "chain-5"}
+	for i := 0; i < 1000; i++ {
+		chainID := chainIDs[rng.Intn(len(chainIDs))]
+		if rng.Intn(2) == 0 {
+			// setting the client ID of a registered chain again does not change the count
+			pk.SetConsumerClientId(ctx, chainID, fmt.Sprintf("client-%d", i))
+		} else {
+			// deleting the client ID of an unregistered chain does not change the count
+			pk.DeleteConsumerClientId(ctx, chainID)
+		}
+		require.Equal(t, uint64(len(pk.GetAllConsumerChains(ctx))), pk.GetConsumerChainCount(ctx))
+	}
+
+	for _, chainID := range chainIDs {
+		pk.DeleteConsumerClientId(ctx, chainID)
+	}
+	require.Zero(t, pk.GetConsumerChainCount(ctx))
+}
+
+// TestGetChainIDByClientID tests that the chain ID of a consumer chain can be retrieved
+// by its client ID as long as the client is the consumer client of the chain
+func TestGetChainIDByClientID(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := pk.GetChainIDByClientID(ctx, "client-1")
+	require.False(t, found)
+
+	pk.SetConsumerClientId(ctx, "chain-1", "client-1")
+	chainID, found := pk.GetChainIDByClientID(ctx, "client-1")
+	require.True(t, found)
+	require.Equal(t, "chain-1", chainID)
+
+	// replacing the client of a chain removes the mapping of the previous client
+	pk.SetConsumerClientId(ctx, "chain-1", "client-2")
+	_, found = pk.GetChainIDByClientID(ctx, "client-1")
+	require.False(t, found)
+	chainID, found = pk.GetChainIDByClientID(ctx, "client-2")
+	require.True(t, found)
+	require.Equal(t, "chain-1", chainID)
+
+	pk.DeleteConsumerClientId(ctx, "chain-1")
+	_, found = pk.GetChainIDByClientID(ctx, "client-2")
+	require.False(t, found)
+}
+
 // TestGetAllChannelToChains tests GetAllChannelToChains behaviour correctness
 func TestGetAllChannelToChains(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -533,3 +1220,456 @@ func TestSetSlashLog(t *testing.T) {
 	require.True(t, providerKeeper.GetSlashLog(ctx, addrWithDoubleSigns))
 	require.False(t, providerKeeper.GetSlashLog(ctx, addrWithoutDoubleSigns))
 }
+
+// TestSlashEnabled tests the slash enabled getter, setter and deletion methods
+func TestSlashEnabled(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// slashing is disabled by default
+	require.False(t, providerKeeper.IsSlashEnabled(ctx, "chainID"))
+
+	providerKeeper.SetSlashEnabled(ctx, "chainID", true)
+	require.True(t, providerKeeper.IsSlashEnabled(ctx, "chainID"))
+	require.False(t, providerKeeper.IsSlashEnabled(ctx, "chainID2"))
+
+	providerKeeper.SetSlashEnabled(ctx, "chainID", false)
+	require.False(t, providerKeeper.IsSlashEnabled(ctx, "chainID"))
+
+	providerKeeper.SetSlashEnabled(ctx, "chainID", true)
+	providerKeeper.DeleteSlashEnabled(ctx, "chainID")
+	require.False(t, providerKeeper.IsSlashEnabled(ctx, "chainID"))
+}
+
+// TestConsumerCandidateClient tests setting and promoting the candidate client of a consumer chain
+func TestConsumerCandidateClient(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "unknown").Return(nil, false).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "otherChainClient").Return(
+			&ibctmtypes.ClientState{ChainId: "otherChain"}, true).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "candidateClient").Return(
+			&ibctmtypes.ClientState{ChainId: "chainID"}, true).Times(1),
+	)
+
+	// no consumer client
+	require.Error(t, providerKeeper.SetConsumerCandidateClientId(ctx, "chainID", "candidateClient"))
+	// no candidate client
+	require.Error(t, providerKeeper.PromoteConsumerClient(ctx, "chainID"))
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	// candidate client is already the consumer client
+	require.Error(t, providerKeeper.SetConsumerCandidateClientId(ctx, "chainID", "clientID"))
+	// candidate client does not exist
+	require.Error(t, providerKeeper.SetConsumerCandidateClientId(ctx, "chainID", "unknown"))
+	// candidate client is a client of another chain
+	require.Error(t, providerKeeper.SetConsumerCandidateClientId(ctx, "chainID", "otherChainClient"))
+	_, found := providerKeeper.GetConsumerCandidateClientId(ctx, "chainID")
+	require.False(t, found)
+
+	require.NoError(t, providerKeeper.SetConsumerCandidateClientId(ctx, "chainID", "candidateClient"))
+	candidateClientID, found := providerKeeper.GetConsumerCandidateClientId(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "candidateClient", candidateClientID)
+	// the consumer client is unchanged until the candidate is promoted
+	clientID, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "clientID", clientID)
+
+	require.NoError(t, providerKeeper.PromoteConsumerClient(ctx, "chainID"))
+	clientID, found = providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "candidateClient", clientID)
+	_, found = providerKeeper.GetConsumerCandidateClientId(ctx, "chainID")
+	require.False(t, found)
+}
+
+// TestConsumerPhase tests the getter, setter and transitions of the consumer chain lifecycle phase
+func TestConsumerPhase(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	require.Equal(t, types.ConsumerPhaseUnspecified, providerKeeper.GetConsumerPhase(ctx, chainID))
+
+	// the phase is derived from the consumer chain state if not stored
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	require.Equal(t, types.ConsumerPhaseClientCreated, providerKeeper.GetConsumerPhase(ctx, chainID))
+	providerKeeper.SetChainToChannel(ctx, chainID, "channelID")
+	require.Equal(t, types.ConsumerPhaseChannelEstablished, providerKeeper.GetConsumerPhase(ctx, chainID))
+
+	// the stored phase takes precedence
+	providerKeeper.SetConsumerPhase(ctx, chainID, types.ConsumerPhaseActive)
+	require.Equal(t, types.ConsumerPhaseActive, providerKeeper.GetConsumerPhase(ctx, chainID))
+
+	// illegal transitions are rejected
+	err := providerKeeper.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseChannelEstablished)
+	require.ErrorIs(t, err, types.ErrInvalidConsumerPhaseTransition)
+	require.Equal(t, types.ConsumerPhaseActive, providerKeeper.GetConsumerPhase(ctx, chainID))
+
+	require.NoError(t, providerKeeper.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseStopped))
+	require.Equal(t, types.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, chainID))
+	// a stopped consumer chain cannot become active again
+	err = providerKeeper.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseActive)
+	require.ErrorIs(t, err, types.ErrInvalidConsumerPhaseTransition)
+
+	providerKeeper.DeleteConsumerPhase(ctx, chainID)
+	require.Equal(t, types.ConsumerPhaseChannelEstablished, providerKeeper.GetConsumerPhase(ctx, chainID))
+}
+
+// TestConsumerPhaseTransitions tests the legal transitions between consumer chain lifecycle phases
+func TestConsumerPhaseTransitions(t *testing.T) {
+	phases := []types.ConsumerPhase{
+		types.ConsumerPhaseUnspecified,
+		types.ConsumerPhasePending,
+		types.ConsumerPhaseClientCreated,
+		types.ConsumerPhaseChannelEstablished,
+		types.ConsumerPhaseActive,
+		types.ConsumerPhaseStopped,
+		types.ConsumerPhaseStopping,
+	}
+	legal := map[types.ConsumerPhase][]types.ConsumerPhase{
+		types.ConsumerPhaseUnspecified:        {types.ConsumerPhasePending, types.ConsumerPhaseClientCreated},
+		types.ConsumerPhasePending:            {types.ConsumerPhasePending, types.ConsumerPhaseClientCreated, types.ConsumerPhaseStopped},
+		types.ConsumerPhaseClientCreated:      {types.ConsumerPhaseChannelEstablished, types.ConsumerPhaseStopping, types.ConsumerPhaseStopped},
+		types.ConsumerPhaseChannelEstablished: {types.ConsumerPhaseActive, types.ConsumerPhaseStopping, types.ConsumerPhaseStopped},
+		types.ConsumerPhaseActive:             {types.ConsumerPhaseStopping, types.ConsumerPhaseStopped},
+		types.ConsumerPhaseStopped:            {types.ConsumerPhasePending, types.ConsumerPhaseClientCreated},
+		types.ConsumerPhaseStopping:           {types.ConsumerPhaseStopped},
+	}
+
+	for _, from := range phases {
+		for _, to := range phases {
+			expLegal := false
+			for _, phase := range legal[from] {
+				if phase == to {
+					expLegal = true
+				}
+			}
+			require.Equal(t, expLegal, from.CanTransitionTo(to), "%s -> %s", from, to)
+		}
+	}
+}
+
+// TestJailedByConsumer tests the set, get, iteration, and deletion methods
+// for the validators jailed due to a consumer chain
+func TestJailedByConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr0 := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	providerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+
+	require.False(t, providerKeeper.JailedByConsumer(ctx, "chainID", providerAddr0))
+	require.Empty(t, providerKeeper.GetAllJailedByConsumer(ctx, "chainID"))
+
+	providerKeeper.SetJailedByConsumer(ctx, "chainID", providerAddr0)
+	providerKeeper.SetJailedByConsumer(ctx, "chainID", providerAddr1)
+	providerKeeper.SetJailedByConsumer(ctx, "chainID1", providerAddr0)
+	require.True(t, providerKeeper.JailedByConsumer(ctx, "chainID", providerAddr0))
+	require.ElementsMatch(t, []types.ProviderConsAddress{providerAddr0, providerAddr1},
+		providerKeeper.GetAllJailedByConsumer(ctx, "chainID"))
+
+	providerKeeper.DeleteJailedByConsumer(ctx, "chainID", providerAddr0)
+	require.False(t, providerKeeper.JailedByConsumer(ctx, "chainID", providerAddr0))
+	require.Equal(t, []types.ProviderConsAddress{providerAddr1}, providerKeeper.GetAllJailedByConsumer(ctx, "chainID"))
+
+	providerKeeper.DeleteAllJailedByConsumer(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetAllJailedByConsumer(ctx, "chainID"))
+	// the records of other consumer chains are not affected
+	require.True(t, providerKeeper.JailedByConsumer(ctx, "chainID1", providerAddr0))
+}
+
+// TestConsumerGenesisVersioning tests that consumer genesis states are stored
+// prefixed by their schema version and that unknown versions are rejected
+func TestConsumerGenesisVersioning(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	gen := *consumertypes.DefaultGenesisState()
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "chainID", gen))
+
+	store := ctx.KVStore(keeperParams.StoreKey)
+	bz := store.Get(types.ConsumerGenesisKey("chainID"))
+	require.Equal(t, types.ConsumerGenesisVersion1, bz[0])
+
+	actualGen, found := providerKeeper.GetConsumerGenesis(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, gen, actualGen)
+
+	// a consumer genesis with an unknown version cannot be read
+	store.Set(types.ConsumerGenesisKey("chainID"), append([]byte{0xff}, bz[1:]...))
+	require.Panics(t, func() { providerKeeper.GetConsumerGenesis(ctx, "chainID") })
+}
+
+// TestMigrate1to2 tests that the migration to consensus version 2 upgrades the store of
+// consensus version 1, i.e., the consumer genesis states are prefixed with their schema version,
+// the mapping from the client IDs to the chain IDs and the consumer chain count are backfilled
+// and the params added since consensus version 1 are set to their default values,
+// while the params stored before the migration are kept. Re-running the migration is safe.
+func TestMigrate1to2(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	// pre-migration state, i.e., only the params of consensus version 1 are stored
+	params := types.DefaultParams()
+	params.TrustingPeriodFraction = "0.5"
+	params.MaxThrottledPackets = 10
+	subspace := keeperParams.ParamsSubspace
+	subspace.Set(ctx, types.KeyTemplateClient, params.TemplateClient)
+	subspace.Set(ctx, types.KeyTrustingPeriodFraction, params.TrustingPeriodFraction)
+	subspace.Set(ctx, ccv.KeyCCVTimeoutPeriod, params.CcvTimeoutPeriod)
+	subspace.Set(ctx, types.KeyInitTimeoutPeriod, params.InitTimeoutPeriod)
+	subspace.Set(ctx, types.KeyVscTimeoutPeriod, params.VscTimeoutPeriod)
+	subspace.Set(ctx, types.KeySlashMeterReplenishPeriod, params.SlashMeterReplenishPeriod)
+	subspace.Set(ctx, types.KeySlashMeterReplenishFraction, params.SlashMeterReplenishFraction)
+	subspace.Set(ctx, types.KeyMaxThrottledPackets, params.MaxThrottledPackets)
+	require.Panics(t, func() { providerKeeper.GetParams(ctx) })
+
+	// pre-migration state, i.e., client IDs stored without the reverse mapping nor the count
+	// and consumer genesis states stored without a schema version
+	gen := *consumertypes.DefaultGenesisState()
+	genBz, err := gen.Marshal()
+	require.NoError(t, err)
+	chains := []types.Chain{
+		{ChainId: "chain-1", ClientId: "07-tendermint-1"},
+		{ChainId: "chain-2", ClientId: "07-tendermint-5"},
+	}
+	store := ctx.KVStore(keeperParams.StoreKey)
+	for _, chain := range chains {
+		store.Set(types.ChainToClientKey(chain.ChainId), []byte(chain.ClientId))
+		store.Set(types.ConsumerGenesisKey(chain.ChainId), genBz)
+		_, found := providerKeeper.GetChainIDByClientID(ctx, chain.ClientId)
+		require.False(t, found)
+		require.Panics(t, func() { providerKeeper.GetConsumerGenesis(ctx, chain.ChainId) })
+	}
+	require.Zero(t, providerKeeper.GetConsumerChainCount(ctx))
+
+	// pre-migration state, i.e., pending consumer addition proposals stored without indexes
+	spawnTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	pendingProps := []types.ConsumerAdditionProposal{
+		{ChainId: "chain-3", SpawnTime: spawnTime.Add(time.Hour)},
+		{ChainId: "chain-3", SpawnTime: spawnTime},
+		{ChainId: "chain-4", SpawnTime: spawnTime},
+	}
+	for _, prop := range pendingProps {
+		propBz, err := prop.Marshal()
+		require.NoError(t, err)
+		store.Set(types.PendingCAPKey(prop.SpawnTime, prop.ChainId), propBz)
+	}
+	_, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chain-3")
+	require.False(t, found)
+
+	migrator := providerkeeper.NewMigrator(providerKeeper)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, migrator.Migrate1to2(ctx))
+
+		require.Equal(t, params, providerKeeper.GetParams(ctx))
+		for _, chain := range chains {
+			chainID, found := providerKeeper.GetChainIDByClientID(ctx, chain.ClientId)
+			require.True(t, found)
+			require.Equal(t, chain.ChainId, chainID)
+			actualGen, found := providerKeeper.GetConsumerGenesis(ctx, chain.ChainId)
+			require.True(t, found)
+			require.Equal(t, gen, actualGen)
+		}
+		require.Equal(t, chains, providerKeeper.GetAllConsumerChains(ctx))
+		require.Equal(t, uint64(len(chains)), providerKeeper.GetConsumerChainCount(ctx))
+		for _, chainID := range []string{"chain-3", "chain-4"} {
+			actualSpawnTime, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, chainID)
+			require.True(t, found)
+			require.Equal(t, spawnTime, actualSpawnTime)
+		}
+	}
+
+	// the indexes of the pending proposals are kept up to date once they are deleted
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, pendingProps[1])
+	actualSpawnTime, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chain-3")
+	require.True(t, found)
+	require.Equal(t, pendingProps[0].SpawnTime, actualSpawnTime)
+
+	// removing a consumer chain stored before the migration decrements the count
+	providerKeeper.DeleteConsumerClientId(ctx, "chain-1")
+	require.Equal(t, uint64(1), providerKeeper.GetConsumerChainCount(ctx))
+
+	// an unversioned genesis that cannot be unmarshaled fails the migration
+	store.Set(types.ConsumerGenesisKey("corrupted"), []byte{0xff, 0xff})
+	require.Error(t, migrator.Migrate1to2(ctx))
+}
+
+// TestRelayerAllowlist tests the setter, getter and deleter of the relayer allowlist
+// and the query returning it
+func TestRelayerAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	relayers := []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1wfjkcctev4eryh6lta047h6lta047h6lxlw5rj"}
+
+	require.Empty(t, providerKeeper.GetRelayerAllowlist(ctx, "chainID"))
+
+	providerKeeper.SetRelayerAllowlist(ctx, "chainID", relayers)
+	providerKeeper.SetRelayerAllowlist(ctx, "chainID2", relayers[1:])
+	require.Equal(t, relayers, providerKeeper.GetRelayerAllowlist(ctx, "chainID"))
+
+	res, err := providerKeeper.QueryRelayerAllowlist(sdk.WrapSDKContext(ctx),
+		&types.QueryRelayerAllowlistRequest{ChainId: "chainID"})
+	require.NoError(t, err)
+	require.Equal(t, relayers, res.Relayers)
+	_, err = providerKeeper.QueryRelayerAllowlist(sdk.WrapSDKContext(ctx), &types.QueryRelayerAllowlistRequest{})
+	require.Error(t, err)
+
+	// setting an allowlist replaces the previous one
+	providerKeeper.SetRelayerAllowlist(ctx, "chainID", relayers[1:])
+	require.Equal(t, relayers[1:], providerKeeper.GetRelayerAllowlist(ctx, "chainID"))
+
+	providerKeeper.DeleteRelayerAllowlist(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetRelayerAllowlist(ctx, "chainID"))
+	require.Equal(t, relayers[1:], providerKeeper.GetRelayerAllowlist(ctx, "chainID2"))
+}
+
+// TestQueryCcvStats tests that the CCV stats query aggregates the state of all consumer chains
+func TestQueryCcvStats(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	validators := []stakingtypes.Validator{ids[0].SDKStakingValidator(), ids[1].SDKStakingValidator()}
+
+	// two active consumer chains, only the first one validated by a bonded validator
+	providerKeeper.SetConsumerClientId(ctx, "chain-a", "client-a")
+	providerKeeper.SetConsumerTopN(ctx, "chain-a", 1)
+	providerKeeper.SetConsumerValSet(ctx, "chain-a", []abci.ValidatorUpdate{{PubKey: ids[0].TMProtoCryptoPublicKey(), Power: 1}})
+	providerKeeper.AppendPendingVSCPackets(ctx, "chain-a",
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1},
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2},
+	)
+	providerKeeper.SetConsumerClientId(ctx, "chain-b", "client-b")
+	providerKeeper.SetConsumerTopN(ctx, "chain-b", 1)
+	providerKeeper.AppendPendingVSCPackets(ctx, "chain-b", ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2})
+	// a pending consumer chain
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chain-c"
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	// a stopped consumer chain
+	providerKeeper.SetConsumerPhase(ctx, "chain-d", types.ConsumerPhaseStopped)
+
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetLastValidators(ctx).Return(validators).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, validators[0].GetOperator()).Return(int64(100)).Times(1),
+	)
+
+	res, err := providerKeeper.QueryCcvStats(sdk.WrapSDKContext(ctx), &types.QueryCcvStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCcvStatsResponse{
+		ActiveConsumers:       2,
+		PendingConsumers:      1,
+		StoppedConsumers:      1,
+		OutstandingVscPackets: 3,
+		ReplicatedPower:       100,
+	}, res)
+}
+
+// TestQueryConsumerInitParams tests the query of the initialization parameters of a consumer chain
+func TestQueryConsumerInitParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerInitParams(sdk.WrapSDKContext(ctx), &types.QueryConsumerInitParamsRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerInitParamsRequest{ChainId: "chainID"}
+	_, err = providerKeeper.QueryConsumerInitParams(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	initParams := types.ConsumerInitParams{
+		Title:                  "title",
+		SpawnTime:              time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		TopN:                   10,
+		RewardDenomAllowlist:   []string{"ufoo"},
+		MaxClockDrift:          30 * time.Second,
+		TrustingPeriodFraction: types.DefaultTrustingPeriodFraction,
+	}
+	providerKeeper.SetConsumerInitParams(ctx, "chainID", initParams)
+
+	res, err := providerKeeper.QueryConsumerInitParams(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, initParams, res.InitParams)
+
+	providerKeeper.DeleteConsumerInitParams(ctx, "chainID")
+	_, err = providerKeeper.QueryConsumerInitParams(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+}
+
+// TestQueryConsumerSpawnHeight tests the query of the provider block height at which a consumer chain was spawned
+func TestQueryConsumerSpawnHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerSpawnHeight(sdk.WrapSDKContext(ctx), &types.QueryConsumerSpawnHeightRequest{})
+	require.Error(t, err)
+
+	req := &types.QueryConsumerSpawnHeightRequest{ChainId: "chainID"}
+	_, err = providerKeeper.QueryConsumerSpawnHeight(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerSpawnHeight(ctx, "chainID", 42)
+	res, err := providerKeeper.QueryConsumerSpawnHeight(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), res.SpawnHeight)
+
+	providerKeeper.DeleteConsumerSpawnHeight(ctx, "chainID")
+	_, err = providerKeeper.QueryConsumerSpawnHeight(sdk.WrapSDKContext(ctx), req)
+	require.Error(t, err)
+}
+
+// TestConsumerClientHistory tests that every new client of a consumer chain is appended
+// to its client history, and that the history is bounded by MaxConsumerClientHistory
+func TestConsumerClientHistory(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	_, err := pk.QueryConsumerClientHistory(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerClientHistory(sdk.WrapSDKContext(ctx), &types.QueryConsumerClientHistoryRequest{})
+	require.Error(t, err)
+	req := &types.QueryConsumerClientHistoryRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerClientHistory(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	pk.SetConsumerClientId(ctx, "chainID", "clientID-0")
+	pk.SetConsumerClientId(ctx, "otherChainID", "clientID-1")
+	// setting the current client again does not change the history
+	pk.SetConsumerClientId(ctx.WithBlockHeight(11), "chainID", "clientID-0")
+	// the client is reset
+	pk.SetConsumerClientId(ctx.WithBlockHeight(12).WithBlockTime(now.Add(time.Hour)), "chainID", "clientID-2")
+
+	res, err := pk.QueryConsumerClientHistory(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerClientRecord{
+		{ClientId: "clientID-0", BlockHeight: 10, Timestamp: now},
+		{ClientId: "clientID-2", BlockHeight: 12, Timestamp: now.Add(time.Hour)},
+	}, res.Clients)
+	require.Equal(t, []types.ConsumerClientRecord{
+		{ClientId: "clientID-1", BlockHeight: 10, Timestamp: now},
+	}, pk.GetConsumerClientHistory(ctx, "otherChainID"))
+
+	// only the most recent clients are retained
+	for i := 3; i < 3+types.MaxConsumerClientHistory; i++ {
+		pk.SetConsumerClientId(ctx.WithBlockHeight(int64(10+i)), "chainID", fmt.Sprintf("clientID-%d", i))
+	}
+	history := pk.GetConsumerClientHistory(ctx, "chainID")
+	require.Len(t, history, types.MaxConsumerClientHistory)
+	require.Equal(t, "clientID-3", history[0].ClientId)
+	require.Equal(t, fmt.Sprintf("clientID-%d", 2+types.MaxConsumerClientHistory), history[len(history)-1].ClientId)
+
+	// the client history is retained when the consumer client is deleted
+	pk.DeleteConsumerClientId(ctx, "chainID")
+	require.Len(t, pk.GetConsumerClientHistory(ctx, "chainID"), types.MaxConsumerClientHistory)
+}
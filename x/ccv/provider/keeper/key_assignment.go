@@ -34,6 +34,16 @@ func (k Keeper) GetValidatorConsumerPubKey(
 	return consumerKey, true
 }
 
+// HasAssignedConsumerKey returns true if the validator has assigned a public key for a consumer chain
+func (k Keeper) HasAssignedConsumerKey(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerValidatorsKey(chainID, providerAddr))
+}
+
 // SetValidatorConsumerPubKey sets a validator's public key assigned for a consumer chain
 func (k Keeper) SetValidatorConsumerPubKey(
 	ctx sdk.Context,
@@ -459,8 +469,11 @@ func (k Keeper) AssignConsumerKey(
 			oldConsumerKey = providerKey
 		}
 
-		// check whether the validator is valid, i.e., its power is positive
-		power := k.stakingKeeper.GetLastValidatorPower(ctx, validator.GetOperator())
+		// check whether the validator is valid, i.e., its power on the consumer chain is positive
+		power, err := k.GetConsumerValidatorPower(ctx, chainID, validator)
+		if err != nil {
+			return err
+		}
 		if 0 < power {
 			// to enable multiple calls of AssignConsumerKey in the same block by the same validator
 
@@ -638,3 +651,26 @@ func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, chainID string) {
 		k.DeleteConsumerAddrsToPrune(ctx, chainID, consumerAddrsToPrune.VscId)
 	}
 }
+
+// GetValidatorsWithoutConsumerKey returns the validators in the provider chain's
+// last validator set, i.e., the validators that would be part of the consumer
+// validator set, that have not assigned a key for the given consumer chain
+func (k Keeper) GetValidatorsWithoutConsumerKey(ctx sdk.Context, chainID string) (validators []stakingtypes.Validator) {
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, power int64) (stop bool) {
+		val, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			// This should never happen as every last validator power has a corresponding validator
+			panic(fmt.Errorf("validator not found for operator address %s", valAddr))
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			// This should never happen as the validators' consensus keys are validated by the staking module
+			panic(fmt.Errorf("cannot get consensus address of validator %s: %s", valAddr, err))
+		}
+		if _, found := k.GetValidatorConsumerPubKey(ctx, chainID, types.NewProviderConsAddress(consAddr)); !found {
+			validators = append(validators, val)
+		}
+		return false
+	})
+	return validators
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
@@ -98,6 +99,38 @@ func TestGetAllValidatorConsumerPubKey(t *testing.T) {
 	require.Len(t, result, len(testAssignments))
 }
 
+// TestGetValidatorsWithoutConsumerKey tests that only the validators in the
+// last validator set without an assigned consumer key are returned
+func TestGetValidatorsWithoutConsumerKey(t *testing.T) {
+	chainID := consumer
+	keeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	// the first validator has assigned a key for the consumer chain
+	keeper.SetValidatorConsumerPubKey(ctx, chainID, ids[0].ProviderConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(10).TMProtoCryptoPublicKey())
+	// the second validator has assigned a key for another consumer chain
+	keeper.SetValidatorConsumerPubKey(ctx, "otherchain", ids[1].ProviderConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(11).TMProtoCryptoPublicKey())
+
+	mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+			for _, id := range ids {
+				if cb(id.SDKValOpAddress(), 1) {
+					return
+				}
+			}
+		}).Times(1)
+	for _, id := range ids {
+		mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, id.SDKValOpAddress()).Return(
+			id.SDKStakingValidator(), true).Times(1)
+	}
+
+	validators := keeper.GetValidatorsWithoutConsumerKey(ctx, chainID)
+	require.Equal(t, []stakingtypes.Validator{ids[1].SDKStakingValidator(), ids[2].SDKStakingValidator()}, validators)
+}
+
 func TestValidatorByConsumerAddrCRUD(t *testing.T) {
 	chainID := consumer
 	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
@@ -232,6 +265,163 @@ func TestGetAllKeyAssignmentReplacements(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestQueryConsumerKeyAssignments tests that the consumer keys assigned for a consumer chain
+// are returned in pages, together with whether a key rotation is pending
+func TestQueryConsumerKeyAssignments(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	numAssignments := 5
+	expAssignments := []types.ConsumerKeyAssignment{}
+	for i := 0; i < numAssignments; i++ {
+		providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(i).ProviderConsAddress()
+		consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(numAssignments + i)
+		consumerKey := consumerIdentity.TMProtoCryptoPublicKey()
+		pk.SetValidatorConsumerPubKey(ctx, "chainID", providerAddr, consumerKey)
+		// the first validator has a pending key rotation
+		if i == 0 {
+			pk.SetKeyAssignmentReplacement(ctx, "chainID", providerAddr, consumerKey, 100)
+		}
+		expAssignments = append(expAssignments, types.ConsumerKeyAssignment{
+			ProviderAddress: providerAddr.String(),
+			ConsumerKey:     &consumerKey,
+			ConsumerAddress: consumerIdentity.SDKValConsAddress().String(),
+			PendingRotation: i == 0,
+		})
+	}
+	// the key assignments for other chains are not returned
+	pk.SetValidatorConsumerPubKey(ctx, "chainID1",
+		cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(2*numAssignments).TMProtoCryptoPublicKey())
+
+	// the assignments are returned in ascending order of provider addresses
+	sort.Slice(expAssignments, func(i, j int) bool {
+		addrI, _ := sdk.ConsAddressFromBech32(expAssignments[i].ProviderAddress)
+		addrJ, _ := sdk.ConsAddressFromBech32(expAssignments[j].ProviderAddress)
+		return bytes.Compare(addrI, addrJ) < 0
+	})
+
+	_, err := pk.QueryConsumerKeyAssignments(sdk.WrapSDKContext(ctx),
+		&types.QueryConsumerKeyAssignmentsRequest{})
+	require.Error(t, err)
+
+	// query the assignments in pages of two
+	assignments := []types.ConsumerKeyAssignment{}
+	var nextKey []byte
+	for {
+		res, err := pk.QueryConsumerKeyAssignments(sdk.WrapSDKContext(ctx),
+			&types.QueryConsumerKeyAssignmentsRequest{
+				ChainId:    "chainID",
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+			})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Assignments), 2)
+		assignments = append(assignments, res.Assignments...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, expAssignments, assignments)
+}
+
+// TestQueryHasAssignedConsumerKey tests that the query returns whether a validator
+// has assigned a consumer key for a consumer chain, together with the assigned key
+func TestQueryHasAssignedConsumerKey(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerKey := consumerIdentity.TMProtoCryptoPublicKey()
+
+	req := &types.QueryHasAssignedConsumerKeyRequest{
+		ChainId:         "chainID",
+		ProviderAddress: providerIdentity.SDKValConsAddress().String(),
+	}
+	res, err := pk.QueryHasAssignedConsumerKey(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.False(t, res.HasAssigned)
+	require.Nil(t, res.Assignment)
+	require.False(t, pk.HasAssignedConsumerKey(ctx, "chainID", providerIdentity.ProviderConsAddress()))
+
+	pk.SetValidatorConsumerPubKey(ctx, "chainID", providerIdentity.ProviderConsAddress(), consumerKey)
+	require.True(t, pk.HasAssignedConsumerKey(ctx, "chainID", providerIdentity.ProviderConsAddress()))
+	require.False(t, pk.HasAssignedConsumerKey(ctx, "chainID1", providerIdentity.ProviderConsAddress()))
+
+	res, err = pk.QueryHasAssignedConsumerKey(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.True(t, res.HasAssigned)
+	require.Equal(t, &types.ConsumerKeyAssignment{
+		ProviderAddress: providerIdentity.SDKValConsAddress().String(),
+		ConsumerKey:     &consumerKey,
+		ConsumerAddress: consumerIdentity.SDKValConsAddress().String(),
+		PendingRotation: false,
+	}, res.Assignment)
+
+	_, err = pk.QueryHasAssignedConsumerKey(sdk.WrapSDKContext(ctx), &types.QueryHasAssignedConsumerKeyRequest{
+		ProviderAddress: providerIdentity.SDKValConsAddress().String(),
+	})
+	require.Error(t, err)
+
+	_, err = pk.QueryHasAssignedConsumerKey(sdk.WrapSDKContext(ctx), &types.QueryHasAssignedConsumerKeyRequest{
+		ChainId:         "chainID",
+		ProviderAddress: "invalid",
+	})
+	require.Error(t, err)
+}
+
+func TestQueryValidatorByConsumerAddr(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the first validator assigned a consumer key, the second did not
+	assigned := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	unassigned := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	unknown := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	pk.SetValidatorByConsumerAddr(ctx, "chainID", consumerIdentity.ConsumerConsAddress(), assigned.ProviderConsAddress())
+
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, assigned.SDKValConsAddress()).
+			Return(assigned.SDKStakingValidator(), true),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, unassigned.SDKValConsAddress()).
+			Return(unassigned.SDKStakingValidator(), true),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, unknown.SDKValConsAddress()).
+			Return(stakingtypes.Validator{}, false),
+	)
+
+	res, err := pk.QueryValidatorByConsumerAddr(sdk.WrapSDKContext(ctx), &types.QueryValidatorByConsumerAddrRequest{
+		ChainId:         "chainID",
+		ConsumerAddress: consumerIdentity.SDKValConsAddress().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, assigned.SDKValConsAddress().String(), res.ProviderAddress)
+	require.Equal(t, assigned.SDKValOpAddress().String(), res.OperatorAddress)
+
+	// without an assigned key, the consumer address is the provider address
+	res, err = pk.QueryValidatorByConsumerAddr(sdk.WrapSDKContext(ctx), &types.QueryValidatorByConsumerAddrRequest{
+		ChainId:         "chainID",
+		ConsumerAddress: unassigned.SDKValConsAddress().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, unassigned.SDKValConsAddress().String(), res.ProviderAddress)
+	require.Equal(t, unassigned.SDKValOpAddress().String(), res.OperatorAddress)
+
+	// unmapped addresses are not found
+	_, err = pk.QueryValidatorByConsumerAddr(sdk.WrapSDKContext(ctx), &types.QueryValidatorByConsumerAddrRequest{
+		ChainId:         "chainID",
+		ConsumerAddress: unknown.SDKValConsAddress().String(),
+	})
+	require.True(t, types.ErrNoValidatorProviderAddress.Is(err))
+
+	_, err = pk.QueryValidatorByConsumerAddr(sdk.WrapSDKContext(ctx), &types.QueryValidatorByConsumerAddrRequest{
+		ChainId:         "chainID",
+		ConsumerAddress: "invalid",
+	})
+	require.Error(t, err)
+}
+
 func TestConsumerAddrsToPruneCRUD(t *testing.T) {
 	chainID := consumer
 	consumerAddr := types.NewConsumerConsAddress([]byte("consumerAddr1"))
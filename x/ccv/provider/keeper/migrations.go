@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the provider module state from consensus version 1 to 2,
+// i.e., it prefixes the stored consumer genesis states with their schema version,
+// backfills the mapping from the client IDs to the chain IDs of the consumer chains,
+// the number of consumer chains and the indexes of the pending consumer addition proposals,
+// and sets the params added since consensus version 1 to their default values.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.MigrateConsumerGenesesToVersioned(ctx); err != nil {
+		return err
+	}
+	m.keeper.BackfillClientToChainIndex(ctx)
+	m.keeper.BackfillConsumerChainCount(ctx)
+	m.keeper.BackfillPendingConsumerAdditionPropIndexes(ctx)
+	m.keeper.SetMissingParamsToDefault(ctx)
+	return nil
+}
@@ -3,10 +3,13 @@ package keeper
 import (
 	"context"
 	"encoding/base64"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
 	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -105,3 +108,261 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 
 	return &types.MsgAssignConsumerKeyResponse{}, nil
 }
+
+// RequeueConsumerAdditionProposal defines a method for requeueing a failed consumer addition proposal
+func (k msgServer) RequeueConsumerAdditionProposal(goCtx context.Context,
+	msg *types.MsgRequeueConsumerAdditionProposal,
+) (*types.MsgRequeueConsumerAdditionProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.RequeueFailedConsumerAdditionProp(ctx, msg.ChainId, msg.SpawnTime, msg.InitialHeight); err != nil {
+		return nil, err
+	}
+	k.Logger(ctx).Info("requeued consumer addition proposal",
+		"consumer chainID", msg.ChainId,
+		"spawn time", msg.SpawnTime.UTC(),
+		"initial height", msg.InitialHeight.String(),
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeRequeueConsumerAdditionProposal,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+			sdk.NewAttribute(ccvtypes.AttributeTimestamp, msg.SpawnTime.UTC().String()),
+			sdk.NewAttribute(ccvtypes.AttributeInitialHeight, msg.InitialHeight.String()),
+		),
+	})
+
+	return &types.MsgRequeueConsumerAdditionProposalResponse{}, nil
+}
+
+// PurgeConsumerState defines a method for purging the preserved state of a stopped consumer chain
+func (k msgServer) PurgeConsumerState(goCtx context.Context,
+	msg *types.MsgPurgeConsumerState,
+) (*types.MsgPurgeConsumerStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.PurgeConsumerState(ctx, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypePurgeConsumerState,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+		),
+	})
+
+	return &types.MsgPurgeConsumerStateResponse{}, nil
+}
+
+// PurgeAllPendingClients defines a method for purging all the pending consumer addition proposals
+func (k msgServer) PurgeAllPendingClients(goCtx context.Context,
+	msg *types.MsgPurgeAllPendingClients,
+) (*types.MsgPurgeAllPendingClientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	numPurged := k.Keeper.PurgeAllPendingClients(ctx)
+	k.Logger(ctx).Info("purged all pending consumer addition proposals", "purged", numPurged)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypePurgeAllPendingClients,
+			sdk.NewAttribute(ccvtypes.AttributePurged, strconv.FormatUint(numPurged, 10)),
+		),
+	})
+
+	return &types.MsgPurgeAllPendingClientsResponse{NumPurged: numPurged}, nil
+}
+
+// UpdateParams defines a method for updating all the provider module params at once
+func (k msgServer) UpdateParams(goCtx context.Context,
+	msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	// the params are validated as a whole before any of them is set,
+	// since SetParams panics on the first invalid param
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	k.SetParams(ctx, msg.Params)
+	k.Logger(ctx).Info("updated the provider params")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(ccvtypes.EventTypeUpdateParams),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateRewardDenomAllowlist defines a method for replacing the reward denom allowlist of a consumer chain
+func (k msgServer) UpdateRewardDenomAllowlist(goCtx context.Context,
+	msg *types.MsgUpdateRewardDenomAllowlist,
+) (*types.MsgUpdateRewardDenomAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	// the allowlist can only be amended for existing consumer chains,
+	// the allowlist of a pending consumer chain is set by its proposal
+	if _, found := k.GetConsumerClientId(ctx, msg.ChainId); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, msg.ChainId)
+	}
+
+	k.SetRewardDenomAllowlist(ctx, msg.ChainId, msg.Denoms)
+	k.Logger(ctx).Info("updated the reward denom allowlist", "chainID", msg.ChainId, "denoms", msg.Denoms)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeUpdateRewardDenomAllowlist,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+			sdk.NewAttribute(ccvtypes.AttributeRewardDenoms, strings.Join(msg.Denoms, ",")),
+		),
+	})
+
+	return &types.MsgUpdateRewardDenomAllowlistResponse{}, nil
+}
+
+// ForceSpawnPendingClient defines a method for creating the consumer client of a pending consumer chain
+// before the spawn time of its consumer addition proposal
+func (k msgServer) ForceSpawnPendingClient(goCtx context.Context,
+	msg *types.MsgForceSpawnPendingClient,
+) (*types.MsgForceSpawnPendingClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	clientID, err := k.Keeper.ForceSpawnPendingClient(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeForceSpawnPendingClient,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+		),
+	})
+
+	return &types.MsgForceSpawnPendingClientResponse{ClientId: clientID}, nil
+}
+
+// ForceMatureVscPackets defines a method for marking all the outstanding VSC packets
+// of a consumer chain as matured, releasing the unbonding operations waiting for it
+func (k msgServer) ForceMatureVscPackets(goCtx context.Context,
+	msg *types.MsgForceMatureVscPackets,
+) (*types.MsgForceMatureVscPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	releasedIds, err := k.Keeper.ForceMatureVscPackets(ctx, msg.ChainId)
+	if err != nil {
+		return nil, err
+	}
+
+	releasedIdStrs := make([]string, len(releasedIds))
+	for i, id := range releasedIds {
+		releasedIdStrs[i] = strconv.FormatUint(id, 10)
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeForceMatureVscPackets,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+			sdk.NewAttribute(ccvtypes.AttributeReleasedUnbondingOps, strings.Join(releasedIdStrs, ",")),
+		),
+	})
+
+	return &types.MsgForceMatureVscPacketsResponse{NumReleased: uint64(len(releasedIds))}, nil
+}
+
+// ApproveConsumerValidator defines a method for approving a validator to join the validator set
+// of a consumer chain that requires the approval of its validators
+func (k msgServer) ApproveConsumerValidator(goCtx context.Context,
+	msg *types.MsgApproveConsumerValidator,
+) (*types.MsgApproveConsumerValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProviderAddress, "%s", err)
+	}
+
+	if err := k.Keeper.ApproveConsumerValidator(ctx, msg.ChainId, types.NewProviderConsAddress(consAddr)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeApproveConsumerValidator,
+			sdk.NewAttribute(ccvtypes.AttributeChainID, msg.ChainId),
+			sdk.NewAttribute(ccvtypes.AttributeProviderValidatorAddress, msg.ProviderAddr),
+		),
+	})
+
+	return &types.MsgApproveConsumerValidatorResponse{}, nil
+}
+
+// RenameConsumerChain defines a method for moving all the state of a consumer chain
+// from its chain ID to a new chain ID
+func (k msgServer) RenameConsumerChain(goCtx context.Context,
+	msg *types.MsgRenameConsumerChain,
+) (*types.MsgRenameConsumerChainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s",
+			k.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.RenameConsumerChain(ctx, msg.OldChainId, msg.NewChainId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			ccvtypes.EventTypeRenameConsumerChain,
+			sdk.NewAttribute(ccvtypes.AttributeOldChainID, msg.OldChainId),
+			sdk.NewAttribute(ccvtypes.AttributeNewChainID, msg.NewChainId),
+		),
+	})
+
+	return &types.MsgRenameConsumerChainResponse{}, nil
+}
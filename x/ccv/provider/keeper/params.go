@@ -77,6 +77,62 @@ func (k Keeper) GetMaxThrottledPackets(ctx sdk.Context) int64 {
 	return p
 }
 
+// GetConsumerRewardsToCommunityPoolFraction returns the fraction of the rewards
+// received from consumer chains that is sent to the community pool
+func (k Keeper) GetConsumerRewardsToCommunityPoolFraction(ctx sdk.Context) string {
+	var f string
+	k.paramSpace.Get(ctx, types.KeyConsumerRewardsToCommunityPoolFraction, &f)
+	return f
+}
+
+// GetDefaultTopN returns the default number of validators, selected by power,
+// that validate a consumer chain
+func (k Keeper) GetDefaultTopN(ctx sdk.Context) uint32 {
+	var n uint32
+	k.paramSpace.Get(ctx, types.KeyDefaultTopN, &n)
+	return n
+}
+
+// GetMaxSpawnTimeLag returns the maximum lag of the spawn time of a consumer addition
+// proposal behind the block time at which the proposal is handled
+func (k Keeper) GetMaxSpawnTimeLag(ctx sdk.Context) time.Duration {
+	var d time.Duration
+	k.paramSpace.Get(ctx, types.KeyMaxSpawnTimeLag, &d)
+	return d
+}
+
+// GetMaxVscSendBackoffBlocks returns the maximum number of blocks to wait before
+// retrying to send the pending VSC packets to a consumer chain after send failures
+func (k Keeper) GetMaxVscSendBackoffBlocks(ctx sdk.Context) int64 {
+	var n int64
+	k.paramSpace.Get(ctx, types.KeyMaxVscSendBackoffBlocks, &n)
+	return n
+}
+
+// GetMaxRecentSpawns returns the maximum number of entries
+// in the log of recent consumer chain spawns
+func (k Keeper) GetMaxRecentSpawns(ctx sdk.Context) int64 {
+	var n int64
+	k.paramSpace.Get(ctx, types.KeyMaxRecentSpawns, &n)
+	return n
+}
+
+// GetReconciliationInterval returns the interval, in blocks, at which the provider
+// reconciles the consumer client and channel mappings with the IBC state
+func (k Keeper) GetReconciliationInterval(ctx sdk.Context) int64 {
+	var n int64
+	k.paramSpace.Get(ctx, types.KeyReconciliationInterval, &n)
+	return n
+}
+
+// GetReconciliationRemoveDangling returns whether the reconciliation stops the consumer chains
+// with dangling client or channel mappings and removes the dangling channel mappings
+func (k Keeper) GetReconciliationRemoveDangling(ctx sdk.Context) bool {
+	var b bool
+	k.paramSpace.Get(ctx, types.KeyReconciliationRemoveDangling, &b)
+	return b
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -88,6 +144,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.GetSlashMeterReplenishPeriod(ctx),
 		k.GetSlashMeterReplenishFraction(ctx),
 		k.GetMaxThrottledPackets(ctx),
+		k.GetConsumerRewardsToCommunityPoolFraction(ctx),
+		k.GetDefaultTopN(ctx),
+		k.GetMaxSpawnTimeLag(ctx),
+		k.GetMaxVscSendBackoffBlocks(ctx),
+		k.GetMaxRecentSpawns(ctx),
+		k.GetReconciliationInterval(ctx),
+		k.GetReconciliationRemoveDangling(ctx),
 	)
 }
 
@@ -95,3 +158,15 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// SetMissingParamsToDefault sets the params that are not in the param store,
+// e.g., the params added after the chain started, to their default values.
+// Re-running it is a no-op.
+func (k Keeper) SetMissingParamsToDefault(ctx sdk.Context) {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	"github.com/stretchr/testify/require"
 )
@@ -44,8 +46,50 @@ func TestParams(t *testing.T) {
 		time.Hour,
 		"0.4",
 		100,
+		"0.5",
+		50,
+		24*time.Hour,
+		50,
+		20,
+		100,
+		true,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
 	require.Equal(t, newParams, params)
 }
+
+// TestUpdateParams tests that the provider params are updated at once by the authority,
+// while invalid params or other signers are rejected without modifying any param.
+func TestUpdateParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	defaultParams := providertypes.DefaultParams()
+	providerKeeper.SetParams(ctx, defaultParams)
+
+	newParams := providertypes.DefaultParams()
+	newParams.TrustingPeriodFraction = "0.5"
+	newParams.DefaultTopN = 50
+	newParams.MaxRecentSpawns = 10
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateParams(sdk.AccAddress([]byte("other")).String(), newParams))
+	require.Error(t, err)
+	require.Equal(t, defaultParams, providerKeeper.GetParams(ctx))
+
+	// invalid params are rejected as a whole
+	invalidParams := newParams
+	invalidParams.MaxRecentSpawns = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateParams(providerKeeper.GetAuthority(), invalidParams))
+	require.Error(t, err)
+	require.Equal(t, defaultParams, providerKeeper.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgUpdateParams(providerKeeper.GetAuthority(), newParams))
+	require.NoError(t, err)
+	require.Equal(t, newParams, providerKeeper.GetParams(ctx))
+}
@@ -1,17 +1,23 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
@@ -24,22 +30,94 @@ import (
 // HandleConsumerAdditionProposal will receive the consumer chain's client state from the proposal.
 // If the client can be successfully created in a cached context, it stores the proposal as a pending proposal.
 //
+// Note that the proposal is stored as pending even if the client cannot be created only because
+// the provider chain has no bonded validators yet, e.g., on a new provider chain, or because the
+// initial validator set has less power than the MinProviderPower of the proposal. In this case,
+// the consumer chain is spawned once the initial validator set is sufficient, see BeginBlockInit.
+//
+// Proposals with a spawn time older than the block time minus the MaxSpawnTimeLag param are rejected,
+// e.g., due to a clock error when authoring the proposal, instead of spawning the consumer chain immediately.
+//
 // Note: This method implements SpawnConsumerChainProposalHandler in spec.
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-hcaprop1
 // Spec tag: [CCV-PCF-HCAPROP.1]
 func (k Keeper) HandleConsumerAdditionProposal(ctx sdk.Context, p *types.ConsumerAdditionProposal) error {
+	maxSpawnTimeLag := k.GetMaxSpawnTimeLag(ctx)
+	if p.SpawnTime.Before(ctx.BlockTime().Add(-maxSpawnTimeLag)) {
+		k.Logger(ctx).Info("consumer addition proposal rejected: spawn time too far in the past",
+			"chainID", p.ChainId,
+			"spawn time", p.SpawnTime.UTC(),
+			"block time", ctx.BlockTime().UTC(),
+			"max spawn time lag", maxSpawnTimeLag,
+		)
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerAdditionProposal,
+			"spawn time %s is more than %s before the block time %s",
+			p.SpawnTime.UTC(), maxSpawnTimeLag, ctx.BlockTime().UTC())
+	}
+
+	// reject duplicates of a proposal handled within the retention period
+	if p.IdempotencyToken != "" {
+		if expiry, found := k.GetIdempotencyTokenExpiry(ctx, p.ChainId, p.IdempotencyToken); found && ctx.BlockTime().Before(expiry) {
+			k.Logger(ctx).Info("consumer addition proposal rejected: duplicate idempotency token",
+				"chainID", p.ChainId,
+				"idempotency token", p.IdempotencyToken,
+				"token expiry", expiry.UTC(),
+				"block time", ctx.BlockTime().UTC(),
+			)
+			return sdkerrors.Wrapf(types.ErrDuplicateIdempotencyToken,
+				"a proposal for chain %s with idempotency token %s was already handled", p.ChainId, p.IdempotencyToken)
+		}
+	}
+
 	// verify the consumer addition proposal execution
 	// in cached context and discard the cached writes
-	if _, _, err := k.CreateConsumerClientInCachedCtx(ctx, *p); err != nil {
+	cachedCtx, _, err := k.CreateConsumerClientInCachedCtx(ctx, *p)
+	if err != nil && !isSpawnDeferredErr(err) {
+		k.Logger(ctx).Info("consumer addition proposal rejected: consumer client cannot be created",
+			"chainID", p.ChainId,
+			"spawn time", p.SpawnTime.UTC(),
+			"block time", ctx.BlockTime().UTC(),
+			"error", err,
+		)
 		return err
 	}
 
 	k.SetPendingConsumerAdditionProp(ctx, p)
+	if p.IdempotencyToken != "" {
+		k.SetIdempotencyTokenExpiry(ctx, p.ChainId, p.IdempotencyToken,
+			ctx.BlockTime().Add(types.IdempotencyTokenRetentionPeriod))
+	}
+
+	// commit to the genesis the consumer chain would be spawned with, if it could be spawned already
+	if err == nil {
+		consumerGen, found := k.GetConsumerGenesis(cachedCtx, p.ChainId)
+		if !found {
+			return sdkerrors.Wrapf(ccv.ErrInvalidConsumerState, "missing consumer genesis for chain %s", p.ChainId)
+		}
+		genesisHash, err := committedGenesisHash(consumerGen)
+		if err != nil {
+			return err
+		}
+		k.SetCommittedGenesisHash(ctx, p.ChainId, genesisHash)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeConsumerGenesisCommitted,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+				sdk.NewAttribute(ccv.AttributeCommittedGenesisHash, fmt.Sprintf("%X", genesisHash)),
+			),
+		)
+	}
 
+	// a proposal whose spawn time is reached is executed in the next BeginBlockInit,
+	// unless its spawn is deferred due to an insufficient initial validator set
 	k.Logger(ctx).Info("consumer addition proposal enqueued",
 		"chainID", p.ChainId,
 		"title", p.Title,
 		"spawn time", p.SpawnTime.UTC(),
+		"block time", ctx.BlockTime().UTC(),
+		"immediate", !ctx.BlockTime().Before(p.SpawnTime),
+		"spawn deferred", err != nil,
 	)
 
 	return nil
@@ -57,21 +135,41 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 		return sdkerrors.Wrap(ccv.ErrDuplicateConsumerChain,
 			fmt.Sprintf("cannot create client for existent consumer chain: %s", chainID))
 	}
+	// the preserved state of a stopped consumer chain must be purged before it is added again
+	if k.IsConsumerStatePreserved(ctx, chainID) {
+		return sdkerrors.Wrap(ccv.ErrDuplicateConsumerChain,
+			fmt.Sprintf("cannot create client for consumer chain with preserved state: %s", chainID))
+	}
 
-	// Consumers start out with the unbonding period from the consumer addition prop
-	consumerUnbondingPeriod := prop.UnbondingPeriod
-
-	// Create client state by getting template client from parameters and filling in zeroed fields from proposal.
-	clientState := k.GetTemplateClient(ctx)
-	clientState.ChainId = chainID
-	clientState.LatestHeight = prop.InitialHeight
+	// the settings below are validated in ConsumerAdditionProposal.ValidateBasic,
+	// but are checked again, as they are only parsed when read from the store
+	if prop.ConsumerPowerReduction != "" {
+		if _, err := types.ParseConsumerPowerReduction(prop.ConsumerPowerReduction); err != nil {
+			return err
+		}
+	}
+	if prop.PowerMultiplier != "" {
+		if _, err := types.ParsePowerMultiplier(prop.PowerMultiplier); err != nil {
+			return err
+		}
+	}
+	if prop.ConsumerDowntimeJailDuration != 0 {
+		if err := ccv.ValidateDuration(prop.ConsumerDowntimeJailDuration); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidConsumerAdditionProposal, "invalid consumer downtime jail duration: %s", err)
+		}
+	}
 
-	trustPeriod, err := ccv.CalculateTrustPeriod(consumerUnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
+	// Consumers start out with the unbonding period from the consumer addition prop
+	clientState, err := k.makeConsumerClientState(ctx, chainID, prop.InitialHeight, prop.UnbondingPeriod)
 	if err != nil {
 		return err
 	}
-	clientState.TrustingPeriod = trustPeriod
-	clientState.UnbondingPeriod = consumerUnbondingPeriod
+	if prop.MaxClockDrift != 0 {
+		clientState.MaxClockDrift = prop.MaxClockDrift
+	}
+	if prop.TrustLevel != nil {
+		clientState.TrustLevel = *prop.TrustLevel
+	}
 
 	consumerGen, validatorSetHash, err := k.MakeConsumerGenesis(ctx, prop)
 	if err != nil {
@@ -81,8 +179,13 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	if err != nil {
 		return err
 	}
+	if err := k.checkCommittedGenesisHash(ctx, chainID, consumerGen); err != nil {
+		return err
+	}
 
-	// Create consensus state
+	// Create consensus state. The commitment root of the consumer chain cannot be known before
+	// the consumer chain produces blocks, thus the sentinel root is used even if the proposal
+	// sets UseRealCommitmentRoot, which only applies to the provider client in the consumer genesis.
 	consensusState := ibctmtypes.NewConsensusState(
 		ctx.BlockTime(),
 		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
@@ -93,15 +196,75 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	if err != nil {
 		return err
 	}
+	// an empty client ID would be indistinguishable from a consumer chain without a client
+	if clientID == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "empty client ID returned for consumer chain: %s", chainID)
+	}
+	if err := k.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseClientCreated); err != nil {
+		return err
+	}
 	k.SetConsumerClientId(ctx, chainID, clientID)
+	k.SetConsumerSpawnHeight(ctx, chainID, uint64(ctx.BlockHeight()))
+	// retain the unbonding time, as the staking unbonding time param may change afterwards
+	k.SetConsumerCreationUnbondingTime(ctx, chainID, consumerGen.ProviderClientState.UnbondingPeriod)
+	// the reward denom allowlist is not an initialization parameter, as it can be updated afterwards
+	if len(prop.RewardDenomAllowlist) > 0 {
+		k.SetRewardDenomAllowlist(ctx, chainID, prop.RewardDenomAllowlist)
+	}
+	// without a top N, all the bonded validators validate the consumer chain
+	topN, _ := k.GetProposalTopN(ctx, prop)
+	// retain the parameters the consumer chain is spawned with, with the defaults applied.
+	// The initialization parameters are the only store of the per-chain settings, e.g., the
+	// top N, the power reduction or the relayer allowlist, which are read via their getters.
+	k.SetConsumerInitParams(ctx, chainID, types.ConsumerInitParams{
+		Title:                             prop.Title,
+		Description:                       prop.Description,
+		InitialHeight:                     prop.InitialHeight,
+		GenesisHash:                       prop.GenesisHash,
+		BinaryHash:                        prop.BinaryHash,
+		SpawnTime:                         prop.SpawnTime,
+		UnbondingPeriod:                   clientState.UnbondingPeriod,
+		CcvTimeoutPeriod:                  consumerGen.Params.CcvTimeoutPeriod,
+		TransferTimeoutPeriod:             consumerGen.Params.TransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    consumerGen.Params.ConsumerRedistributionFraction,
+		BlocksPerDistributionTransmission: consumerGen.Params.BlocksPerDistributionTransmission,
+		HistoricalEntries:                 consumerGen.Params.HistoricalEntries,
+		ConsumerNativeUnbondingPeriod:     consumerGen.Params.UnbondingPeriod,
+		SlashEnabled:                      prop.SlashEnabled,
+		TopN:                              topN,
+		MinProviderPower:                  prop.MinProviderPower,
+		ConsumerPowerReduction:            prop.ConsumerPowerReduction,
+		PowerMultiplier:                   prop.PowerMultiplier,
+		RewardDenomAllowlist:              prop.RewardDenomAllowlist,
+		RelayerAllowlist:                  prop.RelayerAllowlist,
+		ConsumerDowntimeJailDuration:      prop.ConsumerDowntimeJailDuration,
+		MaxClockDrift:                     clientState.MaxClockDrift,
+		TrustingPeriodFraction:            k.GetTrustingPeriodFraction(ctx),
+		ValidatorApprovalRequired:         prop.ValidatorApprovalRequired,
+		AdditionalGenesisState:            prop.AdditionalGenesisState,
+		VscPacketTimeoutPeriod:            prop.VscPacketTimeoutPeriod,
+		InitialValSetHeight:               prop.InitialValSetHeight,
+		TrustLevel:                        &clientState.TrustLevel,
+		InitialBalances:                   prop.InitialBalances,
+	})
 
 	// add the init timeout timestamp for this consumer chain
 	ts := ctx.BlockTime().Add(k.GetParams(ctx).InitTimeoutPeriod)
 	k.SetInitTimeoutTimestamp(ctx, chainID, uint64(ts.UnixNano()))
 
+	// log the spawn, such that it can be queried without indexing events
+	k.AppendRecentSpawn(ctx, types.SpawnRecord{
+		ChainId:     chainID,
+		ClientId:    clientID,
+		SpawnTime:   prop.SpawnTime,
+		BlockHeight: ctx.BlockHeight(),
+	})
+
 	k.Logger(ctx).Info("consumer chain registered (client created)",
 		"chainID", chainID,
 		"clientID", clientID,
+		"spawn time", prop.SpawnTime.UTC(),
+		"block time", ctx.BlockTime().UTC(),
 	)
 
 	ctx.EventManager().EmitEvent(
@@ -120,6 +283,80 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 	return nil
 }
 
+// checkCommittedGenesisHash emits a genesis_hash_changed event if the genesis of the given consumer chain
+// differs from the genesis committed to when its consumer addition proposal was handled, e.g., because
+// the validator set changed in the meantime. The committed genesis hash is deleted afterwards.
+func (k Keeper) checkCommittedGenesisHash(ctx sdk.Context, chainID string, consumerGen consumertypes.GenesisState) error {
+	committedHash, found := k.GetCommittedGenesisHash(ctx, chainID)
+	if !found {
+		return nil
+	}
+	k.DeleteCommittedGenesisHash(ctx, chainID)
+
+	genesisHash, err := committedGenesisHash(consumerGen)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(committedHash, genesisHash) {
+		return nil
+	}
+
+	k.Logger(ctx).Info("consumer genesis differs from the committed genesis",
+		"chainID", chainID,
+		"committed genesis hash", fmt.Sprintf("%X", committedHash),
+		"genesis hash", fmt.Sprintf("%X", genesisHash),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeGenesisHashChanged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeCommittedGenesisHash, fmt.Sprintf("%X", committedHash)),
+			sdk.NewAttribute(ccv.AttributeGenesisHash, fmt.Sprintf("%X", genesisHash)),
+		),
+	)
+	return nil
+}
+
+// committedGenesisHash returns the hash of the given consumer genesis that is committed to when
+// the consumer addition proposal is handled. The fields that depend on the block in which the consumer
+// chain is spawned, i.e., the provider consensus state, the latest height of the provider client, and
+// the genesis time, are excluded, such that the hash only changes if, e.g., the validator set changes.
+func committedGenesisHash(gen consumertypes.GenesisState) ([]byte, error) {
+	gen.ProviderConsensusState = nil
+	gen.GenesisTime = nil
+	if gen.ProviderClientState != nil {
+		// copy the client state to not modify the given genesis
+		clientState := *gen.ProviderClientState
+		clientState.LatestHeight = clienttypes.Height{}
+		gen.ProviderClientState = &clientState
+	}
+	return gen.CanonicalHash()
+}
+
+// makeConsumerClientState creates the client state of a consumer client by getting the template client
+// from parameters and filling in the zeroed fields, i.e., the chain ID, the latest height, and the
+// trusting and unbonding periods derived from the given consumer unbonding period.
+func (k Keeper) makeConsumerClientState(
+	ctx sdk.Context,
+	chainID string,
+	latestHeight clienttypes.Height,
+	consumerUnbondingPeriod time.Duration,
+) (*ibctmtypes.ClientState, error) {
+	clientState := k.GetTemplateClient(ctx)
+	clientState.ChainId = chainID
+	clientState.LatestHeight = latestHeight
+
+	trustPeriod, err := ccv.CalculateTrustPeriod(consumerUnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
+	if err != nil {
+		return nil, err
+	}
+	clientState.TrustingPeriod = trustPeriod
+	clientState.UnbondingPeriod = consumerUnbondingPeriod
+
+	return clientState, nil
+}
+
 // HandleConsumerRemovalProposal stops a consumer chain and released the outstanding unbonding operations.
 // If the consumer can be successfully stopped in a cached context, it stores the proposal as a pending proposal.
 //
@@ -139,6 +376,7 @@ func (k Keeper) HandleConsumerRemovalProposal(ctx sdk.Context, p *types.Consumer
 		"chainID", p.ChainId,
 		"title", p.Title,
 		"stop time", p.StopTime.UTC(),
+		"preserve state", p.PreserveState,
 	)
 
 	return nil
@@ -151,16 +389,39 @@ func (k Keeper) HandleConsumerRemovalProposal(ctx sdk.Context, p *types.Consumer
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-stcc1
 // Spec tag: [CCV-PCF-STCC.1]
 func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan bool) (err error) {
+	return k.stopConsumerChain(ctx, chainID, closeChan, false)
+}
+
+// StopConsumerChainPreservingState stops the given consumer chain like StopConsumerChain,
+// but preserves its slash history, metadata, client history, genesis, and reward totals, e.g., for forensic purposes.
+// The preserved state remains readable via queries until it is purged via PurgeConsumerState.
+func (k Keeper) StopConsumerChainPreservingState(ctx sdk.Context, chainID string, closeChan bool) (err error) {
+	return k.stopConsumerChain(ctx, chainID, closeChan, true)
+}
+
+func (k Keeper) stopConsumerChain(ctx sdk.Context, chainID string, closeChan, preserveState bool) (err error) {
 	// check that a client for chainID exists
 	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
 		return sdkerrors.Wrap(ccv.ErrConsumerChainNotFound,
 			fmt.Sprintf("cannot stop non-existent consumer chain: %s", chainID))
 	}
 
+	// the phase is kept after the consumer chain is stopped
+	if err := k.TransitionConsumerPhase(ctx, chainID, types.ConsumerPhaseStopped); err != nil {
+		return err
+	}
+
 	// clean up states
 	k.DeleteConsumerClientId(ctx, chainID)
-	k.DeleteConsumerGenesis(ctx, chainID)
+	k.DeleteConsumerCandidateClientId(ctx, chainID)
+	k.DeleteConsumerLatestSeenHeight(ctx, chainID)
 	k.DeleteInitTimeoutTimestamp(ctx, chainID)
+	k.DeleteConsumerValSet(ctx, chainID)
+	if preserveState {
+		k.SetConsumerStatePreserved(ctx, chainID)
+	} else {
+		k.deletePreservableConsumerState(ctx, chainID)
+	}
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, chainID)
 
@@ -186,11 +447,16 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 
 		// delete VSC send timestamps
 		k.DeleteVscSendTimestampsForConsumer(ctx, chainID)
+		k.DeleteVscMaturityTimesForConsumer(ctx, chainID)
+		k.DeleteVscChunksForConsumer(ctx, chainID)
 	}
 
-	k.DeleteInitChainHeight(ctx, chainID)
+	k.DeleteIdempotencyTokens(ctx, chainID)
 	k.DeleteSlashAcks(ctx, chainID)
 	k.DeletePendingVSCPackets(ctx, chainID)
+	k.DeleteVscSendFailures(ctx, chainID)
+	k.DeleteVscSendRetryHeight(ctx, chainID)
+	k.DeleteLastMaturedVscId(ctx, chainID)
 
 	// release unbonding operations
 	for _, unbondingOpsIndex := range k.GetAllUnbondingOpIndexes(ctx, chainID) {
@@ -227,25 +493,324 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 	// since all unbonding operations for this consumer are release above.
 	k.DeleteThrottledPacketDataForConsumer(ctx, chainID)
 
-	k.Logger(ctx).Info("consumer chain removed from provider", "chainID", chainID)
+	k.Logger(ctx).Info("consumer chain removed from provider", "chainID", chainID, "preserve state", preserveState)
+
+	return nil
+}
+
+// PurgeConsumerState cleans up the state that was preserved
+// when the given consumer chain was stopped, see StopConsumerChainPreservingState.
+func (k Keeper) PurgeConsumerState(ctx sdk.Context, chainID string) error {
+	if !k.IsConsumerStatePreserved(ctx, chainID) {
+		return sdkerrors.Wrap(types.ErrConsumerStateNotPreserved, chainID)
+	}
+
+	k.deletePreservableConsumerState(ctx, chainID)
+	k.DeleteConsumerStatePreserved(ctx, chainID)
+
+	k.Logger(ctx).Info("preserved state of stopped consumer chain purged", "chainID", chainID)
 
 	return nil
 }
 
+// consumerChainKeys returns the keys under which the state of the given consumer chain
+// is stored with the following format: bytePrefix | chainID
+func consumerChainKeys(chainID string) [][]byte {
+	return [][]byte{
+		types.ChainToChannelKey(chainID),
+		types.ChainToClientKey(chainID),
+		types.InitTimeoutTimestampKey(chainID),
+		types.ConsumerGenesisKey(chainID),
+		types.SlashAcksKey(chainID),
+		types.InitChainHeightKey(chainID),
+		types.PendingVSCsKey(chainID),
+		types.ThrottledPacketDataSizeKey(chainID),
+		types.PendingCAPSpawnTimeKey(chainID),
+		types.ChainToCandidateClientKey(chainID),
+		types.ConsumerPhaseKey(chainID),
+		types.FailedCAPKey(chainID),
+		types.ConsumerAcceptedGenesisHashKey(chainID),
+		types.ConsumerCreationUnbondingTimeKey(chainID),
+		types.ConsumerStatePreservedKey(chainID),
+		types.VscSendFailuresKey(chainID),
+		types.VscSendRetryHeightKey(chainID),
+		types.CommittedGenesisHashKey(chainID),
+		types.LastMaturedVscIdKey(chainID),
+		types.ConsumerInitParamsKey(chainID),
+		types.ConsumerSpawnHeightKey(chainID),
+		types.ConsumerSpawnFailureCountKey(chainID),
+		types.ConsumerLatestSeenHeightKey(chainID),
+		types.ConsumerRewardsTotalsKey(chainID),
+	}
+}
+
+// consumerChainKeyPrefixes are the byte prefixes under which the state of a consumer chain
+// is stored with the following format: bytePrefix | len(chainID) | chainID | suffix
+var consumerChainKeyPrefixes = []byte{
+	types.UnbondingOpIndexBytePrefix,
+	types.VscSendTimestampBytePrefix,
+	types.VscMaturityTimeBytePrefix,
+	types.VscPendingChunkAcksBytePrefix,
+	types.VscMaturityDeferredBytePrefix,
+	types.ThrottledPacketDataBytePrefix,
+	types.ConsumerValidatorsBytePrefix,
+	types.ValidatorsByConsumerAddrBytePrefix,
+	types.KeyAssignmentReplacementsBytePrefix,
+	types.ConsumerAddrsToPruneBytePrefix,
+	types.ConsumerValSetBytePrefix,
+	types.ConsumerJailedValidatorsBytePrefix,
+	types.IdempotencyTokenBytePrefix,
+	types.RewardDenomAllowlistBytePrefix,
+	types.ApprovedValidatorBytePrefix,
+	types.PendingValidatorApprovalBytePrefix,
+	types.ConsumerClientHistoryBytePrefix,
+	types.PendingConsumerRewardsBytePrefix,
+}
+
+// hasConsumerChainState returns true if any state is stored for the given consumer chain,
+// apart from the phase of a stopped consumer chain, which is kept after its state is deleted.
+func (k Keeper) hasConsumerChainState(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range consumerChainKeys(chainID) {
+		if bytes.Equal(key, types.ConsumerPhaseKey(chainID)) && k.GetConsumerPhase(ctx, chainID) == types.ConsumerPhaseStopped {
+			continue
+		}
+		if store.Has(key) {
+			return true
+		}
+	}
+	for _, prefix := range consumerChainKeyPrefixes {
+		iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(prefix, chainID))
+		found := iterator.Valid()
+		iterator.Close()
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// RenameConsumerChain moves all the state of the given consumer chain from oldChainID to newChainID,
+// e.g., when the consumer chain migrates to a new chain ID. This includes the client and channel
+// mappings, the key assignments, the VSC state, the metadata, and the genesis of the consumer chain.
+// The rename is rejected if the consumer chain has no consumer client, or if any state is stored
+// for newChainID, e.g., the preserved state of a stopped consumer chain.
+//
+// Note that the rename does not update the consumer client, i.e., the client state still references
+// oldChainID until the consumer client is reset, e.g., via a ResetConsumerClientProposal.
+// The log of recent spawns is also left unchanged.
+func (k Keeper) RenameConsumerChain(ctx sdk.Context, oldChainID, newChainID string) error {
+	clientID, found := k.GetConsumerClientId(ctx, oldChainID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownConsumerChainId, oldChainID)
+	}
+	if oldChainID == newChainID {
+		return sdkerrors.Wrapf(types.ErrInvalidConsumerChainRename,
+			"the new chain id is the same as the old chain id: %s", oldChainID)
+	}
+	if k.hasConsumerChainState(ctx, newChainID) {
+		return sdkerrors.Wrap(ccv.ErrDuplicateConsumerChain,
+			fmt.Sprintf("cannot rename consumer chain %s to existent consumer chain: %s", oldChainID, newChainID))
+	}
+
+	// update the state that references the chain ID in its values
+	for _, index := range k.GetAllUnbondingOpIndexes(ctx, oldChainID) {
+		for _, id := range index.UnbondingOpIds {
+			unbondingOp, found := k.GetUnbondingOp(ctx, id)
+			if !found {
+				continue
+			}
+			for i, chainID := range unbondingOp.UnbondingConsumerChains {
+				if chainID == oldChainID {
+					unbondingOp.UnbondingConsumerChains[i] = newChainID
+				}
+			}
+			k.SetUnbondingOp(ctx, unbondingOp)
+		}
+	}
+	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
+		if prop.ChainId != oldChainID {
+			continue
+		}
+		k.DeletePendingConsumerRemovalProps(ctx, prop)
+		prop.ChainId = newChainID
+		k.SetPendingConsumerRemovalProp(ctx, &prop)
+	}
+	for _, entry := range k.GetAllGlobalSlashEntries(ctx) {
+		if entry.ConsumerChainID != oldChainID {
+			continue
+		}
+		k.DeleteGlobalSlashEntries(ctx, entry)
+		entry.ConsumerChainID = newChainID
+		k.QueueGlobalSlashEntry(ctx, entry)
+	}
+	if channelID, found := k.GetChainToChannel(ctx, oldChainID); found {
+		k.SetChannelToChain(ctx, channelID, newChainID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, token := range k.getIdempotencyTokens(ctx, types.ChainIdWithLenKey(types.IdempotencyTokenBytePrefix, oldChainID)) {
+		store.Delete(types.IdempotencyTokenExpiryKey(token.Expiry, oldChainID, token.Token))
+		store.Set(types.IdempotencyTokenExpiryKey(token.Expiry, newChainID, token.Token), []byte{})
+	}
+	store.Set(types.ClientToChainKey(clientID), []byte(newChainID))
+
+	// the phase of a stopped consumer chain with newChainID, if any, is replaced
+	k.DeleteConsumerPhase(ctx, newChainID)
+
+	// re-key the state that is indexed by the chain ID
+	newKeys := consumerChainKeys(newChainID)
+	for i, oldKey := range consumerChainKeys(oldChainID) {
+		if bz := store.Get(oldKey); bz != nil {
+			store.Set(newKeys[i], bz)
+			store.Delete(oldKey)
+		}
+	}
+	for _, prefix := range consumerChainKeyPrefixes {
+		oldPrefix := types.ChainIdWithLenKey(prefix, oldChainID)
+		newPrefix := types.ChainIdWithLenKey(prefix, newChainID)
+
+		var keys, values [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, oldPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, append([]byte{}, iterator.Key()...))
+			values = append(values, append([]byte{}, iterator.Value()...))
+		}
+		iterator.Close()
+
+		for i, key := range keys {
+			store.Set(append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...), values[i])
+			store.Delete(key)
+		}
+	}
+
+	k.Logger(ctx).Info("consumer chain renamed",
+		"old chainID", oldChainID,
+		"new chainID", newChainID,
+		"clientID", clientID,
+	)
+
+	return nil
+}
+
+// PurgeAllPendingClients deletes all the pending consumer addition proposals, i.e., the consumer chains
+// whose consumer clients are not yet created, e.g., in an emergency due to a bug in the spawn logic,
+// and returns the number of purged proposals. The idempotency tokens of the purged consumer chains
+// are deleted as well, such that the proposals can be resubmitted.
+//
+// Note that the provider does not escrow any deposits of pending proposals, i.e., the deposits
+// of the consumer addition proposals are handled by the gov module, so there is nothing to refund.
+func (k Keeper) PurgeAllPendingClients(ctx sdk.Context) uint64 {
+	props := k.GetAllPendingConsumerAdditionProps(ctx)
+	k.DeletePendingConsumerAdditionProps(ctx, props...)
+	for _, prop := range props {
+		k.DeleteIdempotencyTokens(ctx, prop.ChainId)
+		k.DeleteCommittedGenesisHash(ctx, prop.ChainId)
+		k.Logger(ctx).Info("pending consumer addition proposal purged",
+			"chainID", prop.ChainId, "spawn time", prop.SpawnTime.UTC())
+	}
+
+	return uint64(len(props))
+}
+
+// ForceSpawnPendingClient creates the consumer client of the given pending consumer chain immediately,
+// i.e., before the spawn time of its consumer addition proposal, e.g., to recover from a stuck spawn.
+// The proposal is removed from the pending proposals only if the consumer client is created.
+func (k Keeper) ForceSpawnPendingClient(ctx sdk.Context, chainID string) (clientID string, err error) {
+	spawnTime, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, chainID)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrUnknownPendingConsumerAdditionProp, chainID)
+	}
+	prop, found := k.GetPendingConsumerAdditionProp(ctx, spawnTime, chainID)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrUnknownPendingConsumerAdditionProp, chainID)
+	}
+
+	cachedCtx, writeFn, err := k.CreateConsumerClientInCachedCtx(ctx, prop)
+	if err != nil {
+		return "", err
+	}
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	writeFn()
+
+	k.DeletePendingConsumerAdditionProps(ctx, prop)
+	// a previously failed proposal of this consumer chain can no longer be requeued
+	k.DeleteFailedConsumerAdditionProp(ctx, chainID)
+	k.DeleteConsumerSpawnFailureCount(ctx, chainID)
+
+	clientID, _ = k.GetConsumerClientId(ctx, chainID)
+	k.Logger(ctx).Info("force spawned pending consumer chain",
+		"chainID", chainID,
+		"clientID", clientID,
+		"spawn time", spawnTime.UTC(),
+		"block time", ctx.BlockTime().UTC(),
+	)
+
+	return clientID, nil
+}
+
+// deletePreservableConsumerState deletes the slash history, the metadata, the client history,
+// the genesis, and the reward totals of the given consumer chain
+func (k Keeper) deletePreservableConsumerState(ctx sdk.Context, chainID string) {
+	k.DeleteConsumerGenesis(ctx, chainID)
+	k.DeleteConsumerAcceptedGenesisHash(ctx, chainID)
+	k.DeleteAllJailedByConsumer(ctx, chainID)
+	k.DeleteRewardDenomAllowlist(ctx, chainID)
+	// deletes the per-chain settings stored in the initialization parameters,
+	// e.g., the top N, the slash enabled flag and the relayer allowlist
+	k.DeleteConsumerInitParams(ctx, chainID)
+	k.DeleteInitChainHeight(ctx, chainID)
+	k.DeleteConsumerSpawnHeight(ctx, chainID)
+	k.DeleteAllApprovedValidators(ctx, chainID)
+	k.DeleteAllPendingValidatorApprovals(ctx, chainID)
+	k.DeleteConsumerCreationUnbondingTime(ctx, chainID)
+	k.DeleteConsumerClientHistory(ctx, chainID)
+	k.DeleteConsumerRewardsTotals(ctx, chainID)
+}
+
 // MakeConsumerGenesis constructs the consumer CCV module part of the genesis state.
+// The initial validator set consists of the top N bonded validators by power, see GetProposalTopN.
+//
+// The time spent is recorded as a telemetry metric, labeled by the chain id and
+// the number of validators in the initial validator set.
 func (k Keeper) MakeConsumerGenesis(
 	ctx sdk.Context,
 	prop *types.ConsumerAdditionProposal,
 ) (gen consumertypes.GenesisState, nextValidatorsHash []byte, err error) {
 	chainID := prop.ChainId
+	numValidators := 0
+	defer func(start time.Time) {
+		metrics.MeasureSinceWithLabels(
+			[]string{types.ModuleName, "make_consumer_genesis"},
+			start.UTC(),
+			[]metrics.Label{
+				telemetry.NewLabel("chain_id", chainID),
+				telemetry.NewLabel("num_validators", strconv.Itoa(numValidators)),
+			},
+		)
+	}(time.Now())
+
 	providerUnbondingPeriod := k.stakingKeeper.UnbondingTime(ctx)
-	height := clienttypes.GetSelfHeight(ctx)
+
+	consState, height, err := k.getRecentSelfConsensusState(ctx)
+	if err != nil {
+		return gen, nil, err
+	}
+	// the self consensus state carries the app hash of the provider chain as commitment root,
+	// which consumer chains can require to be actually set instead of being empty or the sentinel root
+	if prop.UseRealCommitmentRoot {
+		root := consState.GetRoot()
+		if root == nil || root.Empty() || string(root.GetHash()) == ibctmtypes.SentinelRoot {
+			return gen, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus,
+				"self consensus state at height %s has no commitment root", height)
+		}
+	}
 
 	clientState := k.GetTemplateClient(ctx)
 	// this is the counter party chain ID for the consumer
 	clientState.ChainId = ctx.ChainID()
 	// this is the latest height the client was updated at, i.e.,
-	// the height of the latest consensus state (see below)
+	// the height of the latest consensus state (see above)
 	clientState.LatestHeight = height
 	trustPeriod, err := ccv.CalculateTrustPeriod(providerUnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
 	if err != nil {
@@ -253,40 +818,87 @@ func (k Keeper) MakeConsumerGenesis(
 	}
 	clientState.TrustingPeriod = trustPeriod
 	clientState.UnbondingPeriod = providerUnbondingPeriod
+	if prop.MaxClockDrift != 0 {
+		clientState.MaxClockDrift = prop.MaxClockDrift
+	}
+	if prop.TrustLevel != nil {
+		clientState.TrustLevel = *prop.TrustLevel
+	}
 
-	consState, err := k.clientKeeper.GetSelfConsensusState(ctx, height)
+	// The initial valset consists of the top N bonded validators by power (or all of them
+	// if no top N is set), either at spawn time or at the historical height pinned by the proposal
+	topN, found := k.GetProposalTopN(ctx, prop)
+	if !found {
+		topN = math.MaxUint32
+	}
+	var initialUpdates []abci.ValidatorUpdate
+	if prop.InitialValSetHeight != 0 {
+		initialUpdates, err = k.GetHistoricalTopNValidatorUpdates(ctx, int64(prop.InitialValSetHeight), topN)
+	} else {
+		initialUpdates, err = k.GetTopNValidatorUpdates(ctx, topN)
+	}
 	if err != nil {
-		return gen, nil, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "error %s getting self consensus state for: %s", err, height)
+		return gen, nil, err
 	}
+	numValidators = len(initialUpdates)
 
-	var lastPowers []stakingtypes.LastValidatorPower
+	// A consumer chain cannot start with an empty validator set, e.g.,
+	// on a new provider chain without bonded validators.
+	if numValidators == 0 {
+		return gen, nil, sdkerrors.Wrapf(types.ErrEmptyValidatorSet,
+			"no bonded validators to validate consumer chain %s", chainID)
+	}
 
-	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
-		lastPowers = append(lastPowers, stakingtypes.LastValidatorPower{Address: addr.String(), Power: power})
-		return false
-	})
+	// A consumer chain may require a minimum total power to secure it at launch.
+	if prop.MinProviderPower > 0 {
+		totalPower := int64(0)
+		for _, update := range initialUpdates {
+			totalPower += update.Power
+		}
+		if totalPower < prop.MinProviderPower {
+			return gen, nil, sdkerrors.Wrapf(types.ErrInsufficientProviderPower,
+				"total power %d of the initial validator set of consumer chain %s is below the minimum %d",
+				totalPower, chainID, prop.MinProviderPower)
+		}
+	}
 
-	initialUpdates := []abci.ValidatorUpdate{}
-	for _, p := range lastPowers {
-		addr, err := sdk.ValAddressFromBech32(p.Address)
+	// A consumer chain may use a different power reduction than the provider chain,
+	// i.e., the consumer powers are recomputed from the tokens of the validators.
+	if prop.ConsumerPowerReduction != "" {
+		powerReduction, err := types.ParseConsumerPowerReduction(prop.ConsumerPowerReduction)
 		if err != nil {
 			return gen, nil, err
 		}
-
-		val, found := k.stakingKeeper.GetValidator(ctx, addr)
-		if !found {
-			return gen, nil, sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "error getting validator from LastValidatorPowers: %s", err)
+		initialUpdates, err = k.ApplyConsumerPowerReduction(ctx, initialUpdates, powerReduction)
+		if err != nil {
+			return gen, nil, err
 		}
+	}
 
-		tmProtoPk, err := val.TmConsPublicKey()
+	// Store the initial valset (with provider keys and the powers before the power multiplier) of top N
+	// consumer chains, of consumer chains requiring validator approval and of consumer chains with a power
+	// reduction, i.e., the baseline for computing the validator set changes sent to the consumer chain,
+	// see GetTrackedValSetTopN.
+	if found || prop.ValidatorApprovalRequired || prop.ConsumerPowerReduction != "" {
+		k.SetConsumerValSet(ctx, chainID, initialUpdates)
+	}
+
+	// A consumer chain may also scale the powers uniformly, e.g., for stress testing.
+	if prop.PowerMultiplier != "" {
+		powerMultiplier, err := types.ParsePowerMultiplier(prop.PowerMultiplier)
 		if err != nil {
 			return gen, nil, err
 		}
+		initialUpdates, err = ApplyConsumerPowerMultiplier(initialUpdates, powerMultiplier)
+		if err != nil {
+			return gen, nil, err
+		}
+	}
 
-		initialUpdates = append(initialUpdates, abci.ValidatorUpdate{
-			PubKey: tmProtoPk,
-			Power:  p.Power,
-		})
+	// Reject initial valsets with powers Tendermint cannot handle, e.g.,
+	// due to a custom power reduction resulting in overflowing powers.
+	if err := ccv.ValidateValidatorUpdatesPower(initialUpdates, true); err != nil {
+		return gen, nil, err
 	}
 
 	// Apply key assignments to the initial valset.
@@ -299,6 +911,13 @@ func (k Keeper) MakeConsumerGenesis(
 	}
 	hash := tmtypes.NewValidatorSet(updatesAsValSet).Hash()
 
+	// The consumer native unbonding period defaults to the unbonding period of the proposal,
+	// i.e., the one the consumer chain was launched with before the native period existed
+	consumerNativeUnbondingPeriod := prop.ConsumerNativeUnbondingPeriod
+	if consumerNativeUnbondingPeriod == 0 {
+		consumerNativeUnbondingPeriod = prop.UnbondingPeriod
+	}
+
 	consumerGenesisParams := consumertypes.NewParams(
 		true,
 		prop.BlocksPerDistributionTransmission,
@@ -308,7 +927,7 @@ func (k Keeper) MakeConsumerGenesis(
 		prop.TransferTimeoutPeriod,
 		prop.ConsumerRedistributionFraction,
 		prop.HistoricalEntries,
-		prop.UnbondingPeriod,
+		consumerNativeUnbondingPeriod,
 		"0.05",
 	)
 
@@ -318,15 +937,112 @@ func (k Keeper) MakeConsumerGenesis(
 		initialUpdatesWithConsumerKeys,
 		consumerGenesisParams,
 	)
+	// The consumer chain is expected to start genesis_time_offset after the consumer client is created
+	genesisTime := ctx.BlockTime().Add(prop.GenesisTimeOffset).UTC()
+	gen.GenesisTime = &genesisTime
+	// The consumer chain only accepts a CCV channel with the pinned identifiers, if any
+	gen.CcvConnectionId = prop.CcvConnectionId
+	gen.CcvChannelId = prop.CcvChannelId
+	// The expected identifiers on the provider chain, if any, allow relayers to pre-configure the return path
+	gen.ExpectedProviderConnectionId = prop.ExpectedProviderConnectionId
+	gen.ExpectedProviderChannelId = prop.ExpectedProviderChannelId
+	// The consumer chain only accepts CCV packets relayed by the allowed relayers, if any
+	gen.RelayerAllowlist = prop.RelayerAllowlist
+	// The minimum gas prices decided by governance, if any, for the app config of the consumer nodes
+	gen.MinGasPrices = prop.ConsumerMinGasPrices
+	// An existing standalone chain keeps its validator set until the changeover, i.e., the
+	// initial validator set replaces the standalone one at the first block of the consumer chain.
+	// Note that the consumer genesis is still for a new chain, as the consumer chain still
+	// creates the client of the provider chain and establishes the CCV channel.
+	gen.PreCCV = prop.StandaloneChangeover
+
+	// The consumer's client of the provider must not trust headers for longer than
+	// the provider unbonding period, i.e., the period during which misbehaving
+	// validators can be slashed, regardless of how the trusting period is derived.
+	if gen.ProviderClientState.TrustingPeriod >= gen.ProviderClientState.UnbondingPeriod {
+		return gen, nil, sdkerrors.Wrapf(types.ErrInvalidConsumerGenesis,
+			"trusting period (%s) of the provider client must be less than the provider unbonding period (%s)",
+			gen.ProviderClientState.TrustingPeriod, gen.ProviderClientState.UnbondingPeriod)
+	}
+
 	return gen, hash, nil
 }
 
+// MakeFreshConsumerGenesis constructs the consumer genesis of the given consumer chain as if it was spawned
+// in the current block, i.e., with the params of the given stored genesis, the top N, the power reduction,
+// and the power multiplier of the consumer chain. The state is not modified, as the genesis is constructed in a cached context.
+//
+// Note that the genesis time offset cannot be recovered from the stored genesis, i.e., the genesis time
+// of the fresh consumer genesis is the current block time.
+func (k Keeper) MakeFreshConsumerGenesis(
+	ctx sdk.Context,
+	chainID string,
+	storedGen consumertypes.GenesisState,
+) (consumertypes.GenesisState, error) {
+	prop := &types.ConsumerAdditionProposal{
+		ChainId:                           chainID,
+		BlocksPerDistributionTransmission: storedGen.Params.BlocksPerDistributionTransmission,
+		CcvTimeoutPeriod:                  storedGen.Params.CcvTimeoutPeriod,
+		TransferTimeoutPeriod:             storedGen.Params.TransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    storedGen.Params.ConsumerRedistributionFraction,
+		HistoricalEntries:                 storedGen.Params.HistoricalEntries,
+		ConsumerNativeUnbondingPeriod:     storedGen.Params.UnbondingPeriod,
+		CcvConnectionId:                   storedGen.CcvConnectionId,
+		CcvChannelId:                      storedGen.CcvChannelId,
+		ExpectedProviderConnectionId:      storedGen.ExpectedProviderConnectionId,
+		ExpectedProviderChannelId:         storedGen.ExpectedProviderChannelId,
+		RelayerAllowlist:                  storedGen.RelayerAllowlist,
+		ConsumerMinGasPrices:              storedGen.MinGasPrices,
+	}
+	if topN, found := k.GetConsumerTopN(ctx, chainID); found {
+		prop.TopN = topN
+	}
+	if powerReduction, found := k.GetConsumerPowerReduction(ctx, chainID); found {
+		prop.ConsumerPowerReduction = powerReduction.String()
+	}
+	if powerMultiplier, found := k.GetConsumerPowerMultiplier(ctx, chainID); found {
+		prop.PowerMultiplier = powerMultiplier.String()
+	}
+
+	// MakeConsumerGenesis stores the initial validator set, which must not be persisted
+	cachedCtx, _ := ctx.CacheContext()
+	gen, _, err := k.MakeConsumerGenesis(cachedCtx, prop)
+	return gen, err
+}
+
+// getRecentSelfConsensusState returns the self consensus state of the provider chain at the
+// current height or, if it is missing, e.g., the historical info was pruned, at the previous height.
+// The height of the returned consensus state is also returned.
+func (k Keeper) getRecentSelfConsensusState(ctx sdk.Context) (ibcexported.ConsensusState, clienttypes.Height, error) {
+	height := clienttypes.GetSelfHeight(ctx)
+	heights := []clienttypes.Height{height}
+	if height.RevisionHeight > 1 {
+		heights = append(heights, clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight-1))
+	}
+
+	for _, h := range heights {
+		consState, err := k.clientKeeper.GetSelfConsensusState(ctx, h)
+		if err == nil {
+			return consState, h, nil
+		}
+		k.Logger(ctx).Info("self consensus state not found", "height", h, "error", err)
+	}
+
+	return nil, clienttypes.Height{}, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound,
+		"cannot get self consensus state for heights %v; the staking module retains historical info "+
+			"for the last %d heights (HistoricalEntries param), which must be non-zero",
+		heights, k.stakingKeeper.HistoricalEntries(ctx))
+}
+
 // SetPendingConsumerAdditionProp stores a pending consumer addition proposal.
 //
 // Note that the pending consumer addition proposals are stored under keys with
 // the following format: PendingCAPBytePrefix | spawnTime | chainID
 // Thus, if multiple consumer addition proposal for the same chain will pass at
 // the same time, then only the last one will be stored.
+//
+// The pending proposals are also indexed by chain id and spawn time, and the earliest spawn time
+// of the pending proposals of a chain by chain id, see GetPendingConsumerAdditionPropSpawnTime.
 func (k Keeper) SetPendingConsumerAdditionProp(ctx sdk.Context, prop *types.ConsumerAdditionProposal) {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := prop.Marshal()
@@ -334,7 +1050,55 @@ func (k Keeper) SetPendingConsumerAdditionProp(ctx sdk.Context, prop *types.Cons
 		// An error here would indicate something is very wrong
 		panic(fmt.Errorf("failed to marshal consumer addition proposal: %w", err))
 	}
+	// A proposal for an already launched consumer chain does not change its phase,
+	// since it fails when executed, see CreateConsumerClient.
+	if phase := k.GetConsumerPhase(ctx, prop.ChainId); phase == types.ConsumerPhaseUnspecified ||
+		phase == types.ConsumerPhaseStopped {
+		k.SetConsumerPhase(ctx, prop.ChainId, types.ConsumerPhasePending)
+	}
 	store.Set(types.PendingCAPKey(prop.SpawnTime, prop.ChainId), bz)
+	k.indexPendingConsumerAdditionProp(ctx, prop.ChainId, prop.SpawnTime)
+}
+
+// indexPendingConsumerAdditionProp indexes the pending consumer addition proposal
+// with the given chain id and spawn time
+func (k Keeper) indexPendingConsumerAdditionProp(ctx sdk.Context, chainID string, spawnTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingCAPByChainKey(chainID, spawnTime), []byte{})
+	if earliestSpawnTime, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, chainID); !found ||
+		spawnTime.Before(earliestSpawnTime) {
+		store.Set(types.PendingCAPSpawnTimeKey(chainID), sdk.FormatTimeBytes(spawnTime))
+	}
+}
+
+// BackfillPendingConsumerAdditionPropIndexes indexes the pending consumer addition proposals
+// by chain id, which is not done for the proposals stored before consensus version 2.
+// Re-running it is a no-op.
+func (k Keeper) BackfillPendingConsumerAdditionPropIndexes(ctx sdk.Context) {
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		k.indexPendingConsumerAdditionProp(ctx, prop.ChainId, prop.SpawnTime)
+	}
+}
+
+// GetPendingConsumerAdditionPropSpawnTime returns the spawn time of the pending
+// consumer addition proposal for the given chain id, without iterating over
+// all pending consumer addition proposals.
+//
+// Note that if multiple consumer addition proposals for the same chain are pending,
+// the earliest spawn time is returned, i.e., the spawn time of the proposal executed first.
+func (k Keeper) GetPendingConsumerAdditionPropSpawnTime(ctx sdk.Context, chainID string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingCAPSpawnTimeKey(chainID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	spawnTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the spawn time is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
+		panic(fmt.Errorf("failed to parse spawn time: %w", err))
+	}
+	return spawnTime, true
 }
 
 // GetPendingConsumerAdditionProp retrieves a pending consumer addition proposal
@@ -359,20 +1123,109 @@ func (k Keeper) GetPendingConsumerAdditionProp(ctx sdk.Context, spawnTime time.T
 	return prop, true
 }
 
+// PendingCAPKeyHex returns the hex-encoded key under which a pending consumer addition proposal
+// with the given spawn time and chain id is stored, i.e., the output of types.PendingCAPKey.
+//
+// Note: this method is only used for debugging, e.g., of the ordering of the pending proposals
+func (k Keeper) PendingCAPKeyHex(spawnTime time.Time, chainID string) string {
+	return hex.EncodeToString(types.PendingCAPKey(spawnTime, chainID))
+}
+
+// BeginBlockUnbondingTimeCheck records the current provider unbonding time and, if it changed
+// since the previous block, emits a warning event for every pending consumer addition proposal.
+//
+// The trusting and unbonding periods of the provider client in the consumer genesis are derived
+// from the provider unbonding time at spawn time, see MakeConsumerGenesis, i.e., a change of the
+// unbonding time, e.g., via governance, alters the client of every consumer chain not yet spawned.
+//
+// Note that BeginBlockUnbondingTimeCheck must be called before BeginBlockInit, so that the event
+// is also emitted for the consumer chains spawned in the block after the change.
+func (k Keeper) BeginBlockUnbondingTimeCheck(ctx sdk.Context) {
+	unbondingTime := k.stakingKeeper.UnbondingTime(ctx)
+	lastUnbondingTime, found := k.GetLastProviderUnbondingTime(ctx)
+	if found && lastUnbondingTime == unbondingTime {
+		return
+	}
+	// the store is only written on the first observation and whenever the unbonding time changes
+	k.SetLastProviderUnbondingTime(ctx, unbondingTime)
+	if !found {
+		return
+	}
+
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, prop.ChainId),
+			sdk.NewAttribute(ccv.AttributeTimestamp, prop.SpawnTime.UTC().String()),
+			sdk.NewAttribute(ccv.AttributeOldUnbondingPeriod, lastUnbondingTime.String()),
+			sdk.NewAttribute(ccv.AttributeUnbondingPeriod, unbondingTime.String()),
+		}
+		// the trusting period cannot be computed if the trusting period fraction is invalid,
+		// in which case the consumer chain fails to spawn anyway
+		if trustPeriod, err := ccv.CalculateTrustPeriod(unbondingTime, k.GetTrustingPeriodFraction(ctx)); err == nil {
+			attributes = append(attributes, sdk.NewAttribute(ccv.AttributeTrustingPeriod, trustPeriod.String()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(ccv.EventTypePendingSpawnParamsChanged, attributes...))
+		k.Logger(ctx).Info("provider unbonding time changed before consumer chain spawn",
+			"chainID", prop.ChainId,
+			"spawn time", prop.SpawnTime.UTC(),
+			"old unbonding time", lastUnbondingTime,
+			"new unbonding time", unbondingTime,
+		)
+	}
+}
+
 // BeginBlockInit iterates over the pending consumer addition proposals in order, and creates
-// clients for props in which the spawn time has passed. Executed proposals are deleted.
+// clients for props in which the spawn time has been reached. Executed proposals are deleted.
+// Proposals for which the client could not be created are stored as failed proposals.
+//
+// Note that the spawn of a consumer chain is deferred while the provider chain has no bonded
+// validators, since MakeConsumerGenesis refuses to create a genesis with an empty validator set
+// (ErrEmptyValidatorSet), or while the total power of the initial validator set is below the
+// MinProviderPower of the proposal (ErrInsufficientProviderPower). Such proposals are kept pending
+// and executed in the first block in which the initial validator set is sufficient.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-bblock-init1
 // Spec tag:[CCV-PCF-BBLOCK-INIT.1]
 func (k Keeper) BeginBlockInit(ctx sdk.Context) {
 	propsToExecute := k.GetConsumerAdditionPropsToExecute(ctx)
 
+	var executedProps []types.ConsumerAdditionProposal
+	spawned := 0
 	for _, prop := range propsToExecute {
 		// create consumer client in a cached context to handle errors
 		cachedCtx, writeFn, err := k.CreateConsumerClientInCachedCtx(ctx, prop)
+		if isSpawnDeferredErr(err) {
+			// keep the proposal pending until the initial validator set is sufficient
+			k.Logger(ctx).Info("consumer chain spawn deferred",
+				"chainID", prop.ChainId,
+				"spawn time", prop.SpawnTime.UTC(),
+				"block time", ctx.BlockTime().UTC(),
+				"reason", err,
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					ccv.EventTypeConsumerSpawnDeferred,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(ccv.AttributeChainID, prop.ChainId),
+					sdk.NewAttribute(ccv.AttributeTimestamp, prop.SpawnTime.UTC().String()),
+					sdk.NewAttribute(ccv.AttributeError, err.Error()),
+				),
+			)
+			continue
+		}
+		executedProps = append(executedProps, prop)
 		if err != nil {
-			// drop the proposal
-			ctx.Logger().Info("consumer client could not be created: %w", err)
+			// store the proposal as failed, so that it can be requeued,
+			// see RequeueFailedConsumerAdditionProp
+			k.SetFailedConsumerAdditionProp(ctx, prop, err.Error())
+			k.DeleteCommittedGenesisHash(ctx, prop.ChainId)
+			k.Logger(ctx).Info("consumer client could not be created",
+				"chainID", prop.ChainId,
+				"spawn time", prop.SpawnTime.UTC(),
+				"block time", ctx.BlockTime().UTC(),
+				"error", err,
+			)
 			continue
 		}
 		// The cached context is created with a new EventManager so we merge the event
@@ -380,20 +1233,50 @@ func (k Keeper) BeginBlockInit(ctx sdk.Context) {
 		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
 		// write cache
 		writeFn()
+		spawned++
+		// a previously failed proposal of this consumer chain can no longer be requeued
+		k.DeleteFailedConsumerAdditionProp(ctx, prop.ChainId)
+		k.DeleteConsumerSpawnFailureCount(ctx, prop.ChainId)
 
+		clientID, _ := k.GetConsumerClientId(ctx, prop.ChainId)
 		k.Logger(ctx).Info("executed consumer addition proposal",
 			"chainID", prop.ChainId,
+			"clientID", clientID,
 			"title", prop.Title,
 			"spawn time", prop.SpawnTime.UTC(),
+			"block time", ctx.BlockTime().UTC(),
 		)
 	}
 	// delete the executed proposals
-	k.DeletePendingConsumerAdditionProps(ctx, propsToExecute...)
+	k.DeletePendingConsumerAdditionProps(ctx, executedProps...)
+
+	if len(propsToExecute) == 0 {
+		return
+	}
+	// emit a summary of the props processed in this block;
+	// the remaining props include the deferred ones
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeConsumerSpawnSummary,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeSpawned, strconv.Itoa(spawned)),
+			sdk.NewAttribute(ccv.AttributeFailed, strconv.Itoa(len(executedProps)-spawned)),
+			sdk.NewAttribute(ccv.AttributeRemaining, strconv.Itoa(len(k.GetAllPendingConsumerAdditionProps(ctx)))),
+		),
+	)
+}
+
+// isSpawnDeferredErr returns true if the error returned by creating a consumer client
+// indicates that the initial validator set is not (yet) sufficient to launch the consumer chain
+func isSpawnDeferredErr(err error) bool {
+	return types.ErrEmptyValidatorSet.Is(err) || types.ErrInsufficientProviderPower.Is(err)
 }
 
 // GetConsumerAdditionPropsToExecute returns the pending consumer addition proposals
 // that are ready to be executed, i.e., consumer clients to be created.
-// A prop is included in the returned list if its proposed spawn time has passed.
+// A prop is included in the returned list if its proposed spawn time has been reached,
+// i.e., the spawn time is inclusive: a prop with a spawn time equal to the current block
+// time is executed in the current block rather than in the next one.
 //
 // Note: this method is split out from BeginBlockInit to be easily unit tested.
 func (k Keeper) GetConsumerAdditionPropsToExecute(ctx sdk.Context) (propsToExecute []types.ConsumerAdditionProposal) {
@@ -410,9 +1293,16 @@ func (k Keeper) GetConsumerAdditionPropsToExecute(ctx sdk.Context) (propsToExecu
 			panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
 		}
 
+		// If current block time is equal to or after spawn time, proposal is ready to be executed
 		if !ctx.BlockTime().Before(prop.SpawnTime) {
 			propsToExecute = append(propsToExecute, prop)
 		} else {
+			// No more proposals to check, since they're stored/ordered by timestamp.
+			k.Logger(ctx).Debug("next pending consumer addition proposal not yet due",
+				"chainID", prop.ChainId,
+				"spawn time", prop.SpawnTime.UTC(),
+				"block time", ctx.BlockTime().UTC(),
+			)
 			break
 		}
 	}
@@ -452,6 +1342,284 @@ func (k Keeper) DeletePendingConsumerAdditionProps(ctx sdk.Context, proposals ..
 
 	for _, p := range proposals {
 		store.Delete(types.PendingCAPKey(p.SpawnTime, p.ChainId))
+		store.Delete(types.PendingCAPByChainKey(p.ChainId, p.SpawnTime))
+
+		// only update the spawn time index if it refers to the deleted proposal
+		spawnTime, found := k.GetPendingConsumerAdditionPropSpawnTime(ctx, p.ChainId)
+		if !found || !spawnTime.Equal(p.SpawnTime) {
+			continue
+		}
+		// the index refers to the earliest remaining proposal of the chain, if any
+		if nextSpawnTime, found := k.getEarliestPendingConsumerAdditionPropSpawnTime(ctx, p.ChainId); found {
+			store.Set(types.PendingCAPSpawnTimeKey(p.ChainId), sdk.FormatTimeBytes(nextSpawnTime))
+			continue
+		}
+		store.Delete(types.PendingCAPSpawnTimeKey(p.ChainId))
+		// the consumer chain is no longer pending if its proposal was not executed
+		if k.GetConsumerPhase(ctx, p.ChainId) == types.ConsumerPhasePending {
+			k.DeleteConsumerPhase(ctx, p.ChainId)
+		}
+	}
+}
+
+// getEarliestPendingConsumerAdditionPropSpawnTime returns the earliest spawn time of the pending
+// consumer addition proposals for the given chain id, i.e., the one of the first index entry of the chain
+func (k Keeper) getEarliestPendingConsumerAdditionPropSpawnTime(ctx sdk.Context, chainID string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.PendingCAPByChainBytePrefix, chainID))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return time.Time{}, false
+	}
+	_, spawnTime, err := types.ParseChainIdAndTsKey(types.PendingCAPByChainBytePrefix, iterator.Key())
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the key is assumed to be correctly serialized in indexPendingConsumerAdditionProp.
+		panic(fmt.Errorf("failed to parse pending consumer addition proposal index key: %w", err))
+	}
+	return spawnTime, true
+}
+
+// SetFailedConsumerAdditionProp stores a consumer addition proposal for which
+// the consumer client could not be created, together with the returned error
+// and the number of failures of the consumer chain, which is incremented.
+//
+// Note that only the last failed proposal of a given consumer chain is stored.
+func (k Keeper) SetFailedConsumerAdditionProp(ctx sdk.Context, prop types.ConsumerAdditionProposal, errMsg string) {
+	failureCount := k.GetConsumerSpawnFailureCount(ctx, prop.ChainId) + 1
+	k.SetConsumerSpawnFailureCount(ctx, prop.ChainId, failureCount)
+	k.setFailedConsumerAdditionProp(ctx, types.FailedConsumerAdditionProposal{
+		Proposal:     prop,
+		Error:        errMsg,
+		FailureCount: failureCount,
+	})
+}
+
+// setFailedConsumerAdditionProp stores the given failed consumer addition proposal as is,
+// i.e., without incrementing the number of failures of the consumer chain
+func (k Keeper) setFailedConsumerAdditionProp(ctx sdk.Context, failedProp types.FailedConsumerAdditionProposal) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := failedProp.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong
+		panic(fmt.Errorf("failed to marshal failed consumer addition proposal: %w", err))
+	}
+	store.Set(types.FailedCAPKey(failedProp.Proposal.ChainId), bz)
+}
+
+// GetFailedConsumerAdditionProp returns the failed consumer addition proposal of the given consumer chain
+func (k Keeper) GetFailedConsumerAdditionProp(ctx sdk.Context, chainID string) (
+	failedProp types.FailedConsumerAdditionProposal, found bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FailedCAPKey(chainID))
+	if bz == nil {
+		return failedProp, false
+	}
+	if err := failedProp.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the failed proposal is assumed to be correctly serialized in SetFailedConsumerAdditionProp.
+		panic(fmt.Errorf("failed to unmarshal failed consumer addition proposal: %w", err))
+	}
+	return failedProp, true
+}
+
+// DeleteFailedConsumerAdditionProp deletes the failed consumer addition proposal of the given consumer chain
+func (k Keeper) DeleteFailedConsumerAdditionProp(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FailedCAPKey(chainID))
+}
+
+// IterateFailedConsumerAdditionProps iterates over the failed consumer addition proposals,
+// ordered by chain ID, and calls the given callback with the chain ID and the failed proposal,
+// i.e., the proposal, the last error and the failure count. The iteration stops if the callback returns true.
+func (k Keeper) IterateFailedConsumerAdditionProps(
+	ctx sdk.Context,
+	cb func(chainID string, failedProp types.FailedConsumerAdditionProposal) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.FailedCAPBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		chainID := string(iterator.Key()[1:])
+		var failedProp types.FailedConsumerAdditionProposal
+		if err := failedProp.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the failed proposal is assumed to be correctly serialized in SetFailedConsumerAdditionProp.
+			panic(fmt.Errorf("failed to unmarshal failed consumer addition proposal: %w", err))
+		}
+		if cb(chainID, failedProp) {
+			return
+		}
+	}
+}
+
+// GetAllFailedConsumerAdditionProps returns the failed consumer addition proposals, ordered by chain ID
+func (k Keeper) GetAllFailedConsumerAdditionProps(ctx sdk.Context) (failedProps []types.FailedConsumerAdditionProposal) {
+	k.IterateFailedConsumerAdditionProps(ctx, func(_ string, failedProp types.FailedConsumerAdditionProposal) (stop bool) {
+		failedProps = append(failedProps, failedProp)
+		return false
+	})
+	return failedProps
+}
+
+// SetConsumerSpawnFailureCount sets the number of times the consumer client
+// of the given consumer chain could not be created
+func (k Keeper) SetConsumerSpawnFailureCount(ctx sdk.Context, chainID string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.ConsumerSpawnFailureCountKey(chainID), bz)
+}
+
+// GetConsumerSpawnFailureCount returns the number of times the consumer client
+// of the given consumer chain could not be created since it was last created
+func (k Keeper) GetConsumerSpawnFailureCount(ctx sdk.Context, chainID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerSpawnFailureCountKey(chainID))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// DeleteConsumerSpawnFailureCount deletes the failure count of the given consumer chain
+func (k Keeper) DeleteConsumerSpawnFailureCount(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerSpawnFailureCountKey(chainID))
+}
+
+// RequeueFailedConsumerAdditionProp moves the failed consumer addition proposal
+// of the given consumer chain back to the pending consumer addition proposals,
+// with the given spawn time and initial height.
+func (k Keeper) RequeueFailedConsumerAdditionProp(ctx sdk.Context, chainID string,
+	spawnTime time.Time, initialHeight clienttypes.Height,
+) error {
+	failedProp, found := k.GetFailedConsumerAdditionProp(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownFailedConsumerAdditionProp, chainID)
+	}
+	if _, found := k.GetConsumerClientId(ctx, chainID); found {
+		return sdkerrors.Wrap(ccv.ErrDuplicateConsumerChain,
+			fmt.Sprintf("cannot requeue proposal for chain %s, consumer client already exists", chainID))
+	}
+
+	prop := failedProp.Proposal
+	prop.SpawnTime = spawnTime
+	prop.InitialHeight = initialHeight
+	if err := prop.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	k.SetPendingConsumerAdditionProp(ctx, &prop)
+	k.DeleteFailedConsumerAdditionProp(ctx, chainID)
+
+	return nil
+}
+
+// SetIdempotencyTokenExpiry stores the time until which the given idempotency token
+// of a consumer chain is retained, i.e., until which proposals for the consumer chain
+// with the same token are rejected. The token is also indexed by its expiry time,
+// such that the expired tokens can be pruned without iterating over all the tokens.
+func (k Keeper) SetIdempotencyTokenExpiry(ctx sdk.Context, chainID, token string, expiry time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	if prevExpiry, found := k.GetIdempotencyTokenExpiry(ctx, chainID, token); found {
+		store.Delete(types.IdempotencyTokenExpiryKey(prevExpiry, chainID, token))
+	}
+	store.Set(types.IdempotencyTokenKey(chainID, token), sdk.FormatTimeBytes(expiry))
+	store.Set(types.IdempotencyTokenExpiryKey(expiry, chainID, token), []byte{})
+}
+
+// GetIdempotencyTokenExpiry returns the time until which the given idempotency token
+// of a consumer chain is retained
+func (k Keeper) GetIdempotencyTokenExpiry(ctx sdk.Context, chainID, token string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.IdempotencyTokenKey(chainID, token))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	expiry, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the expiry time is assumed to be correctly serialized in SetIdempotencyTokenExpiry.
+		panic(fmt.Errorf("failed to parse idempotency token expiry time: %w", err))
+	}
+	return expiry, true
+}
+
+// GetAllIdempotencyTokens returns the idempotency tokens of all the consumer chains
+// together with their expiry times, ordered by chain ID and token.
+//
+// Note that the idempotency tokens are stored under keys with the following format:
+// IdempotencyTokenBytePrefix | len(chainID) | chainID | token
+func (k Keeper) GetAllIdempotencyTokens(ctx sdk.Context) (tokens []types.IdempotencyToken) {
+	return k.getIdempotencyTokens(ctx, []byte{types.IdempotencyTokenBytePrefix})
+}
+
+// getIdempotencyTokens returns the idempotency tokens stored under the given key prefix
+// together with their expiry times, ordered by chain ID and token
+func (k Keeper) getIdempotencyTokens(ctx sdk.Context, prefix []byte) (tokens []types.IdempotencyToken) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		chainIdL := sdk.BigEndianToUint64(key[1:9])
+		expiry, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the expiry time is assumed to be correctly serialized in SetIdempotencyTokenExpiry.
+			panic(fmt.Errorf("failed to parse idempotency token expiry time: %w", err))
+		}
+		tokens = append(tokens, types.IdempotencyToken{
+			ChainId: string(key[9 : 9+chainIdL]),
+			Token:   string(key[9+chainIdL:]),
+			Expiry:  expiry,
+		})
+	}
+
+	return tokens
+}
+
+// DeleteIdempotencyTokens deletes all the idempotency tokens of the given consumer chain
+func (k Keeper) DeleteIdempotencyTokens(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, token := range k.getIdempotencyTokens(ctx, types.ChainIdWithLenKey(types.IdempotencyTokenBytePrefix, chainID)) {
+		store.Delete(types.IdempotencyTokenKey(token.ChainId, token.Token))
+		store.Delete(types.IdempotencyTokenExpiryKey(token.Expiry, token.ChainId, token.Token))
+	}
+}
+
+// PruneExpiredIdempotencyTokens deletes the idempotency tokens
+// whose retention period ended at or before the current block time.
+//
+// Note that the tokens are iterated by their expiry times,
+// i.e., only the expired tokens are iterated over.
+func (k Keeper) PruneExpiredIdempotencyTokens(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{types.IdempotencyTokenExpiryBytePrefix})
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		expiry, chainID, token, err := types.ParseIdempotencyTokenExpiryKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetIdempotencyTokenExpiry.
+			panic(fmt.Errorf("failed to parse idempotency token expiry key: %w", err))
+		}
+		if ctx.BlockTime().Before(expiry) {
+			// the tokens are ordered by expiry time, i.e., all the remaining tokens are not expired
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key(), types.IdempotencyTokenKey(chainID, token))
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
 	}
 }
 
@@ -502,6 +1670,22 @@ func (k Keeper) BeginBlockCCR(ctx sdk.Context) {
 	propsToExecute := k.GetConsumerRemovalPropsToExecute(ctx)
 
 	for _, prop := range propsToExecute {
+		if prop.Cooldown > 0 {
+			// start the cooldown in a cached context to handle errors
+			cachedCtx, writeFn := ctx.CacheContext()
+			if err := k.StartConsumerChainCooldown(cachedCtx, prop); err != nil {
+				// drop the proposal
+				k.Logger(ctx).Info("consumer chain cooldown could not be started",
+					"chainID", prop.ChainId,
+					"error", err,
+				)
+				continue
+			}
+			ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+			writeFn()
+			continue
+		}
+
 		// stop consumer chain in a cached context to handle errors
 		cachedCtx, writeFn, err := k.StopConsumerChainInCachedCtx(ctx, prop)
 		if err != nil {
@@ -525,6 +1709,58 @@ func (k Keeper) BeginBlockCCR(ctx sdk.Context) {
 	k.DeletePendingConsumerRemovalProps(ctx, propsToExecute...)
 }
 
+// StartConsumerChainCooldown moves the consumer chain of the given consumer removal proposal
+// to the stopping phase, in which no VSC packets are sent to it, while its CCV channel is kept open.
+// The proposal is enqueued again without a cooldown and with a stop time at the end of the cooldown,
+// such that the consumer chain is stopped and removed once the cooldown elapsed.
+func (k Keeper) StartConsumerChainCooldown(ctx sdk.Context, prop types.ConsumerRemovalProposal) error {
+	if _, found := k.GetConsumerClientId(ctx, prop.ChainId); !found {
+		return sdkerrors.Wrap(ccv.ErrConsumerChainNotFound,
+			fmt.Sprintf("cannot stop non-existent consumer chain: %s", prop.ChainId))
+	}
+	if err := k.TransitionConsumerPhase(ctx, prop.ChainId, types.ConsumerPhaseStopping); err != nil {
+		return err
+	}
+
+	prop.StopTime = ctx.BlockTime().Add(prop.Cooldown)
+	prop.Cooldown = 0
+	k.SetPendingConsumerRemovalProp(ctx, &prop)
+
+	k.Logger(ctx).Info("consumer chain cooldown started",
+		"chainID", prop.ChainId,
+		"stop time", prop.StopTime.UTC(),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeConsumerChainStopping,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, prop.ChainId),
+			sdk.NewAttribute(ccv.AttributeTimestamp, prop.StopTime.UTC().String()),
+		),
+	)
+
+	return nil
+}
+
+// GetConsumerCooldownRemaining returns the time remaining until the given stopping consumer chain
+// is stopped and removed, i.e., until the stop time of its pending consumer removal proposal.
+// False is returned if the consumer chain is not stopping.
+func (k Keeper) GetConsumerCooldownRemaining(ctx sdk.Context, chainID string) (time.Duration, bool) {
+	if k.GetConsumerPhase(ctx, chainID) != types.ConsumerPhaseStopping {
+		return 0, false
+	}
+	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
+		if prop.ChainId == chainID && prop.Cooldown == 0 {
+			remaining := prop.StopTime.Sub(ctx.BlockTime())
+			if remaining < 0 {
+				remaining = 0
+			}
+			return remaining, true
+		}
+	}
+	return 0, false
+}
+
 // GetConsumerRemovalPropsToExecute iterates over the pending consumer removal proposals
 // and returns an ordered list of consumer removal proposals to be executed,
 // ie. consumer chains to be stopped and removed from the provider chain.
@@ -597,7 +1833,11 @@ func (k Keeper) CreateConsumerClientInCachedCtx(ctx sdk.Context, p types.Consume
 // from a given consumer removal proposal in a cached context
 func (k Keeper) StopConsumerChainInCachedCtx(ctx sdk.Context, p types.ConsumerRemovalProposal) (cc sdk.Context, writeCache func(), err error) {
 	cc, writeCache = ctx.CacheContext()
-	err = k.StopConsumerChain(cc, p.ChainId, true)
+	if p.PreserveState {
+		err = k.StopConsumerChainPreservingState(cc, p.ChainId, true)
+	} else {
+		err = k.StopConsumerChain(cc, p.ChainId, true)
+	}
 	return
 }
 
@@ -612,3 +1852,78 @@ func (k Keeper) HandleEquivocationProposal(ctx sdk.Context, p *types.Equivocatio
 	}
 	return nil
 }
+
+// HandleResetConsumerClientProposal handles a reset consumer client proposal, i.e., it recovers the
+// consumer client from the trusted consensus state of the proposal, e.g., if the client of a healthy
+// consumer chain is frozen or expired. To this end, a substitute client is created from the trusted
+// consensus state, with the same parameters as the consumer client, and the consumer client is updated
+// with the substitute client via IBC client recovery (see ClientUpdateProposal of the IBC client keeper).
+// Thus, the consumer client keeps its client ID, i.e., the connection and the established CCV channel
+// on top of it, as well as the rest of the state of the consumer chain on the provider, are preserved.
+//
+// Note that the consumer client must not be active and the trusted height must be greater
+// than the latest height of the consumer client.
+func (k Keeper) HandleResetConsumerClientProposal(ctx sdk.Context, p *types.ResetConsumerClientProposal) error {
+	clientID, found := k.GetConsumerClientId(ctx, p.ChainId)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrConsumerChainNotFound,
+			"cannot reset client of non-existent consumer chain: %s", p.ChainId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(ccv.ErrClientNotFound,
+			"cannot find client %s of consumer chain %s", clientID, p.ChainId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid type of client %s of consumer chain %s: %T", clientID, p.ChainId, clientState)
+	}
+
+	// the substitute client must match the consumer client apart from
+	// the chain ID, the latest height, the frozen height and the trusting period
+	trustPeriod, err := ccv.CalculateTrustPeriod(tmClientState.UnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
+	if err != nil {
+		return err
+	}
+	substituteClientState := *tmClientState
+	substituteClientState.ChainId = p.ChainId
+	substituteClientState.LatestHeight = p.TrustedHeight
+	substituteClientState.FrozenHeight = clienttypes.ZeroHeight()
+	substituteClientState.TrustingPeriod = trustPeriod
+	substituteClientID, err := k.clientKeeper.CreateClient(ctx, &substituteClientState, p.TrustedConsensusState)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.ClientUpdateProposal(ctx, &clienttypes.ClientUpdateProposal{
+		Title:              p.Title,
+		Description:        p.Description,
+		SubjectClientId:    clientID,
+		SubstituteClientId: substituteClientID,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidResetConsumerClientProp,
+			"cannot recover client %s of consumer chain %s: %s", clientID, p.ChainId, err)
+	}
+	// the latest height of the recovered client is recorded at the end of the block
+	k.SetConsumerClientUpdated(ctx, clientID)
+
+	k.Logger(ctx).Info("consumer client reset",
+		"chainID", p.ChainId,
+		"clientID", clientID,
+		"substitute clientID", substituteClientID,
+		"trusted height", p.TrustedHeight,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeResetConsumerClient,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, p.ChainId),
+			sdk.NewAttribute(ccv.AttributeClientID, clientID),
+			sdk.NewAttribute(ccv.AttributeSubstituteClientID, substituteClientID),
+		),
+	)
+
+	return nil
+}
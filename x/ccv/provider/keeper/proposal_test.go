@@ -2,17 +2,25 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"sort"
 	"testing"
 	"time"
 
 	_go "github.com/confio/ics23/go"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/golang/mock/gomock"
+	extra "github.com/oxyno-zeta/gomock-extra-matcher"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/stretchr/testify/require"
@@ -93,6 +101,48 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			blockTime:     now,
 			expAppendProp: false,
 		},
+		{
+			description: "expect to append valid proposal with a spawn time within the max spawn time lag",
+			malleate:    func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now.Add(-providertypes.DefaultMaxSpawnTimeLag), // Spawn time
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: true,
+		},
+		{
+			description: "expect to not append proposal with a spawn time older than the max spawn time lag",
+			malleate:    func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {},
+			prop: providertypes.NewConsumerAdditionProposal(
+				"title",
+				"description",
+				"chainID",
+				clienttypes.NewHeight(2, 3),
+				[]byte("gen_hash"),
+				[]byte("bin_hash"),
+				now.Add(-providertypes.DefaultMaxSpawnTimeLag-time.Second), // Spawn time
+				"0.75",
+				10,
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+			).(*providertypes.ConsumerAdditionProposal),
+			blockTime:     now,
+			expAppendProp: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -119,6 +169,9 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			gotProposal, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, tc.prop.SpawnTime, tc.prop.ChainId)
 			require.True(t, found)
 			require.Equal(t, *tc.prop, gotProposal)
+			// check that the proposal committed to the consumer genesis
+			_, found = providerKeeper.GetCommittedGenesisHash(ctx, tc.prop.ChainId)
+			require.True(t, found)
 		} else {
 			require.Error(t, err)
 			// check that prop wasn't added to the stored pending props
@@ -130,6 +183,52 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 	}
 }
 
+// TestCommittedGenesisHash tests that the genesis committed to when a consumer addition proposal
+// is handled is compared with the genesis the consumer chain is spawned with.
+func TestCommittedGenesisHash(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	genesisHashChanged := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == ccvtypes.EventTypeGenesisHashChanged {
+				return true
+			}
+		}
+		return false
+	}
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.SpawnTime = now
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	require.NoError(t, providerKeeper.HandleConsumerAdditionProposal(ctx, prop))
+	committedHash, found := providerKeeper.GetCommittedGenesisHash(ctx, prop.ChainId)
+	require.True(t, found)
+	require.NotEmpty(t, committedHash)
+
+	// spawning the consumer chain in a later block with the same validator set keeps the genesis hash
+	spawnCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(now.Add(time.Hour)).
+		WithEventManager(sdk.NewEventManager())
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(spawnCtx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	cachedCtx, _, err := providerKeeper.CreateConsumerClientInCachedCtx(spawnCtx, *prop)
+	require.NoError(t, err)
+	require.False(t, genesisHashChanged(cachedCtx))
+	_, found = providerKeeper.GetCommittedGenesisHash(cachedCtx, prop.ChainId)
+	require.False(t, found)
+
+	// a different genesis, e.g., due to a changed validator set, results in a genesis_hash_changed event
+	providerKeeper.SetCommittedGenesisHash(ctx, prop.ChainId, []byte("other genesis hash"))
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(spawnCtx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	cachedCtx, _, err = providerKeeper.CreateConsumerClientInCachedCtx(spawnCtx, *prop)
+	require.NoError(t, err)
+	require.True(t, genesisHashChanged(cachedCtx))
+	_, found = providerKeeper.GetCommittedGenesisHash(cachedCtx, prop.ChainId)
+	require.False(t, found)
+}
+
 // Tests the CreateConsumerClient method against the spec,
 // with more granularity than what's covered in TestHandleCreateConsumerChainProposal.
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-crclient1
@@ -166,6 +265,18 @@ func TestCreateConsumerClient(t *testing.T) {
 			},
 			expClientCreated: false,
 		},
+		{
+			description: "client keeper returns an empty client ID, client mapping is not stored",
+			setup: func(providerKeeper *providerkeeper.Keeper, ctx sdk.Context, mocks *testkeeper.MockedKeepers) {
+				gomock.InOrder(
+					append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, mocks, time.Hour),
+						mocks.MockClientKeeper.EXPECT().CreateClient(
+							gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).Times(1),
+					)...,
+				)
+			},
+			expClientCreated: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -185,6 +296,10 @@ func TestCreateConsumerClient(t *testing.T) {
 			testCreatedConsumerClient(t, ctx, providerKeeper, "chainID", "clientID")
 		} else {
 			require.Error(t, err)
+			// an empty client ID must never be stored
+			if clientID, found := providerKeeper.GetConsumerClientId(ctx, "chainID"); found {
+				require.NotEmpty(t, clientID)
+			}
 		}
 
 		// Assert mock calls from setup functions
@@ -203,6 +318,45 @@ func testCreatedConsumerClient(t *testing.T,
 	clientId, found := providerKeeper.GetConsumerClientId(ctx, expectedChainID)
 	require.True(t, found, "consumer client not found")
 	require.Equal(t, expectedClientID, clientId)
+	require.Equal(t, providertypes.ConsumerPhaseClientCreated, providerKeeper.GetConsumerPhase(ctx, expectedChainID))
+
+	// The initial height of the client should be stored.
+	initialHeight, found := providerKeeper.GetConsumerClientInitialHeight(ctx, expectedChainID)
+	require.True(t, found, "consumer client initial height not found")
+	require.Equal(t, testkeeper.GetTestConsumerAdditionProp().InitialHeight, initialHeight)
+
+	// The provider unbonding time at creation should be stored,
+	// i.e., the unbonding time injected by GetMocksForCreateConsumerClient.
+	unbondingTime, found := providerKeeper.GetConsumerCreationUnbondingTime(ctx, expectedChainID)
+	require.True(t, found, "consumer creation unbonding time not found")
+	require.Equal(t, time.Hour, unbondingTime)
+
+	// Without a top N, all the bonded validators validate the consumer chain,
+	// i.e., neither a top N nor a consumer validator set should be stored.
+	_, found = providerKeeper.GetConsumerTopN(ctx, expectedChainID)
+	require.False(t, found, "consumer top N found")
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, expectedChainID))
+
+	// The spawn should be the most recent one in the log of recent spawns.
+	recentSpawns := providerKeeper.GetRecentSpawns(ctx)
+	require.NotEmpty(t, recentSpawns)
+	require.Equal(t, expectedChainID, recentSpawns[0].ChainId)
+	require.Equal(t, expectedClientID, recentSpawns[0].ClientId)
+	require.Equal(t, ctx.BlockHeight(), recentSpawns[0].BlockHeight)
+
+	// The provider block height of the spawn should be stored.
+	spawnHeight, found := providerKeeper.GetConsumerSpawnHeight(ctx, expectedChainID)
+	require.True(t, found, "consumer spawn height not found")
+	require.Equal(t, uint64(ctx.BlockHeight()), spawnHeight)
+
+	// The init params should be stored, with the defaults applied.
+	initParams, found := providerKeeper.GetConsumerInitParams(ctx, expectedChainID)
+	require.True(t, found, "consumer init params not found")
+	require.Equal(t, testkeeper.GetTestConsumerAdditionProp().InitialHeight, initParams.InitialHeight)
+	require.Zero(t, initParams.TopN)
+	require.Equal(t, providerKeeper.GetTemplateClient(ctx).MaxClockDrift, initParams.MaxClockDrift)
+	require.Equal(t, &providerKeeper.GetTemplateClient(ctx).TrustLevel, initParams.TrustLevel)
+	require.Equal(t, providerKeeper.GetTrustingPeriodFraction(ctx), initParams.TrustingPeriodFraction)
 
 	// Only assert that consumer genesis was set,
 	// more granular tests on consumer genesis should be defined in TestMakeConsumerGenesis
@@ -210,6 +364,224 @@ func testCreatedConsumerClient(t *testing.T,
 	require.True(t, ok)
 }
 
+// TestCreateConsumerClientMaxClockDrift tests that the max clock drift of a consumer addition proposal
+// is applied to both the consumer client and the provider client in the consumer genesis
+func TestCreateConsumerClientMaxClockDrift(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.MaxClockDrift = 30 * time.Second
+
+	gomock.InOrder(
+		append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour),
+			mocks.MockClientKeeper.EXPECT().CreateClient(
+				gomock.Any(),
+				extra.StructMatcher().Field("MaxClockDrift", 30*time.Second),
+				gomock.Any(),
+			).Return("clientID", nil).Times(1),
+		)...,
+	)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, 30*time.Second, gen.ProviderClientState.MaxClockDrift)
+
+	initParams, found := providerKeeper.GetConsumerInitParams(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, 30*time.Second, initParams.MaxClockDrift)
+}
+
+// TestCreateConsumerClientTrustLevel tests that the trust level of a consumer addition proposal
+// is applied to both the consumer client and the provider client in the consumer genesis
+func TestCreateConsumerClientTrustLevel(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	trustLevel := ibctmtypes.Fraction{Numerator: 2, Denominator: 3}
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.TrustLevel = &trustLevel
+
+	gomock.InOrder(
+		append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour),
+			mocks.MockClientKeeper.EXPECT().CreateClient(
+				gomock.Any(),
+				extra.StructMatcher().Field("TrustLevel", trustLevel),
+				gomock.Any(),
+			).Return("clientID", nil).Times(1),
+		)...,
+	)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, trustLevel, gen.ProviderClientState.TrustLevel)
+
+	initParams, found := providerKeeper.GetConsumerInitParams(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, &trustLevel, initParams.TrustLevel)
+}
+
+// TestCreateConsumerClientUseRealCommitmentRoot tests that consumer chains requiring the actual
+// commitment root of the provider chain cannot be launched if the root cannot be retrieved
+func TestCreateConsumerClientUseRealCommitmentRoot(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.UseRealCommitmentRoot = true
+
+	// the self consensus state has no commitment root
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{}, nil).Times(1),
+	)
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.ErrorIs(t, err, clienttypes.ErrInvalidConsensus)
+	_, found := providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.False(t, found)
+
+	// the self consensus state has the sentinel root
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{
+			Root: commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
+		}, nil).Times(1),
+	)
+	err = providerKeeper.CreateConsumerClient(ctx, prop)
+	require.ErrorIs(t, err, clienttypes.ErrInvalidConsensus)
+
+	// the self consensus state carries the app hash of the provider chain
+	root := commitmenttypes.NewMerkleRoot([]byte("app_hash"))
+	validator := cryptoutil.NewCryptoIdentityFromIntSeed(0)
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{Root: root}, nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				cb(validator.SDKValOpAddress(), 1)
+			}).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), validator.SDKValOpAddress()).Return(
+			validator.SDKStakingValidator(), true).Times(1),
+		mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any()).Return("clientID", nil).Times(1),
+	)
+	err = providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, root, gen.ProviderConsensusState.Root)
+}
+
+// TestCreateConsumerClientAdditionalGenesisState tests that the genesis states of other modules,
+// the initial balances and the downtime jail duration of a consumer addition proposal are retained
+// and returned with the consumer genesis
+func TestCreateConsumerClientAdditionalGenesisState(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.AdditionalGenesisState = `{"tokenfactory":{"params":{}}}`
+	prop.InitialBalances = []banktypes.Balance{
+		{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))},
+	}
+	prop.ConsumerDowntimeJailDuration = time.Hour
+
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, clienttypes.NewHeight(4, 5))...)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	initParams, found := providerKeeper.GetConsumerInitParams(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, prop.AdditionalGenesisState, initParams.AdditionalGenesisState)
+	require.Equal(t, prop.InitialBalances, initParams.InitialBalances)
+
+	res, err := providerKeeper.QueryConsumerGenesis(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryConsumerGenesisRequest{ChainId: prop.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, prop.AdditionalGenesisState, res.AdditionalGenesisState)
+	require.Equal(t, prop.InitialBalances, res.InitialBalances)
+	require.Equal(t, time.Hour, res.DowntimeJailDuration)
+}
+
+// TestQueryConsumerGenesisProviderChainId tests that the chain id of the provider chain
+// embedded in the consumer genesis is returned with the consumer genesis, as well as
+// the downtime jail duration of the provider if the consumer chain has none
+func TestQueryConsumerGenesisProviderChainId(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithChainID("provider-1")
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, clienttypes.NewHeight(4, 5))...)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(10 * time.Minute).Times(1)
+	res, err := providerKeeper.QueryConsumerGenesis(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryConsumerGenesisRequest{ChainId: prop.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Minute, res.DowntimeJailDuration)
+	require.Equal(t, "provider-1", res.ProviderChainId)
+	require.Equal(t, res.GenesisState.ProviderClientState.ChainId, res.ProviderChainId)
+}
+
+// TestCreateConsumerClientInvalidDowntimeJailDuration tests that the consumer client is not created
+// if the downtime jail duration of the consumer addition proposal is negative
+func TestCreateConsumerClientInvalidDowntimeJailDuration(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ConsumerDowntimeJailDuration = -time.Hour
+
+	// the downtime jail duration is checked before the consumer client is created
+	mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerAdditionProposal)
+	_, found := providerKeeper.GetConsumerDowntimeJailDuration(ctx, prop.ChainId)
+	require.False(t, found)
+}
+
+// TestCreateConsumerClientVscPacketTimeoutPeriod tests that the VSC packet timeout period
+// of a consumer addition proposal is set for the consumer chain
+func TestCreateConsumerClientVscPacketTimeoutPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.VscPacketTimeoutPeriod = time.Hour
+
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, clienttypes.NewHeight(4, 5))...)
+
+	err := providerKeeper.CreateConsumerClient(ctx, prop)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Hour, providerKeeper.GetVscPacketTimeoutPeriod(ctx, prop.ChainId))
+	initParams, found := providerKeeper.GetConsumerInitParams(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, time.Hour, initParams.VscPacketTimeoutPeriod)
+}
+
 // TestPendingConsumerAdditionPropDeletion tests the getting/setting
 // and deletion keeper methods for pending consumer addition props
 func TestPendingConsumerAdditionPropDeletion(t *testing.T) {
@@ -241,17 +613,85 @@ func TestPendingConsumerAdditionPropDeletion(t *testing.T) {
 	numDeleted := 0
 	for _, tc := range testCases {
 		res, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, tc.SpawnTime, tc.ChainId)
+		spawnTime, spawnTimeFound := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, tc.ChainId)
 		if !tc.ExpDeleted {
 			require.True(t, found)
 			require.NotEmpty(t, res, "consumer addition proposal was deleted: %s %s", tc.ChainId, tc.SpawnTime.String())
+			require.True(t, spawnTimeFound)
+			require.Equal(t, tc.SpawnTime, spawnTime)
 			continue
 		}
 		require.Empty(t, res, "consumer addition proposal was not deleted %s %s", tc.ChainId, tc.SpawnTime.String())
+		require.False(t, spawnTimeFound, "spawn time was not deleted %s", tc.ChainId)
 		require.Equal(t, propsToExecute[numDeleted].ChainId, tc.ChainId)
 		numDeleted += 1
 	}
 }
 
+// TestPendingConsumerAdditionPropSpawnTime tests that the spawn time index
+// of pending consumer addition props is kept consistent
+func TestPendingConsumerAdditionPropSpawnTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	_, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID")
+	require.False(t, found)
+
+	// an earlier pending prop of another chain, whose chain id has the chain id as prefix
+	other := providertypes.ConsumerAdditionProposal{ChainId: "chainID-2", SpawnTime: now.Add(-time.Hour)}
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &other)
+
+	// two pending props for the same chain, the index refers to the earliest one
+	first := providertypes.ConsumerAdditionProposal{ChainId: "chainID", SpawnTime: now}
+	second := providertypes.ConsumerAdditionProposal{ChainId: "chainID", SpawnTime: now.Add(time.Hour)}
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &second)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &first)
+	spawnTime, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, first.SpawnTime, spawnTime)
+
+	// deleting the second prop does not affect the index
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, second)
+	spawnTime, found = providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, first.SpawnTime, spawnTime)
+
+	// deleting the earliest prop moves the index to the remaining one
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &second)
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, first)
+	spawnTime, found = providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, second.SpawnTime, spawnTime)
+	require.Equal(t, providertypes.ConsumerPhasePending, providerKeeper.GetConsumerPhase(ctx, "chainID"))
+
+	// deleting the last prop removes the index
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, second)
+	_, found = providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID")
+	require.False(t, found)
+
+	// the index of the other chain is not affected
+	spawnTime, found = providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, "chainID-2")
+	require.True(t, found)
+	require.Equal(t, other.SpawnTime, spawnTime)
+}
+
+// TestPendingCAPKeyHex tests that the hex-encoded key of a pending consumer addition proposal
+// consists of the prefix, the big endian spawn time in nanoseconds, and the chain id
+func TestPendingCAPKeyHex(t *testing.T) {
+	providerKeeper, _, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	spawnTime := time.Unix(0, 258).UTC()
+	keyHex := providerKeeper.PendingCAPKeyHex(spawnTime, "chainID")
+	require.Equal(t, hex.EncodeToString([]byte{providertypes.PendingCAPBytePrefix})+
+		"0000000000000102"+hex.EncodeToString([]byte("chainID")), keyHex)
+
+	// the keys are ordered by spawn time first, regardless of the chain id
+	laterKeyHex := providerKeeper.PendingCAPKeyHex(spawnTime.Add(time.Nanosecond), "a")
+	require.Less(t, keyHex, laterKeyHex)
+}
+
 // TestGetConsumerAdditionPropsToExecute tests that pending consumer addition proposals
 // that are ready to execute are accessed in order by timestamp via the iterator
 func TestGetConsumerAdditionPropsToExecute(t *testing.T) {
@@ -328,6 +768,60 @@ func TestGetConsumerAdditionPropsToExecute(t *testing.T) {
 	}
 }
 
+// TestGetConsumerAdditionPropsToExecuteAtSpawnTime pins the semantics of the spawn time,
+// i.e., a proposal is executed in the first block with a block time equal to or after its spawn time
+func TestGetConsumerAdditionPropsToExecuteAtSpawnTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	spawnTime := time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC)
+	prop := providertypes.ConsumerAdditionProposal{ChainId: "chainID", SpawnTime: spawnTime}
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &prop)
+
+	// block time right before the spawn time
+	propsToExecute := providerKeeper.GetConsumerAdditionPropsToExecute(ctx.WithBlockTime(spawnTime.Add(-time.Nanosecond)))
+	require.Empty(t, propsToExecute)
+
+	// block time equal to the spawn time
+	propsToExecute = providerKeeper.GetConsumerAdditionPropsToExecute(ctx.WithBlockTime(spawnTime))
+	require.Equal(t, []providertypes.ConsumerAdditionProposal{prop}, propsToExecute)
+
+	// block time equal to the spawn time in a different location
+	propsToExecute = providerKeeper.GetConsumerAdditionPropsToExecute(ctx.WithBlockTime(spawnTime.In(time.FixedZone("UTC+2", 2*60*60))))
+	require.Equal(t, []providertypes.ConsumerAdditionProposal{prop}, propsToExecute)
+}
+
+// TestPendingConsumerAdditionPropsWithAdversarialChainIDs tests that pending proposals with the same
+// spawn time are kept apart and executed in order of chain IDs, also if the chain IDs are prefixes
+// of each other or start with the bytes of a timestamp
+func TestPendingConsumerAdditionPropsWithAdversarialChainIDs(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	spawnTime := time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC)
+	laterBz := string(sdk.Uint64ToBigEndian(uint64(spawnTime.Add(time.Nanosecond).UnixNano())))
+	props := []providertypes.ConsumerAdditionProposal{
+		{ChainId: laterBz, SpawnTime: spawnTime},
+		{ChainId: "chain", SpawnTime: spawnTime},
+		{ChainId: "chain-1", SpawnTime: spawnTime},
+		{ChainId: "chain-1", SpawnTime: spawnTime.Add(time.Nanosecond)},
+	}
+	for i := range props {
+		providerKeeper.SetPendingConsumerAdditionProp(ctx, &props[i])
+	}
+
+	propsToExecute := providerKeeper.GetConsumerAdditionPropsToExecute(ctx.WithBlockTime(spawnTime))
+	require.Equal(t, props[:3], propsToExecute)
+
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, props[1])
+	_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, spawnTime, "chain")
+	require.False(t, found)
+	for _, prop := range []providertypes.ConsumerAdditionProposal{props[0], props[2], props[3]} {
+		_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+		require.True(t, found)
+	}
+}
+
 // Test getting both matured and pending consumer addition proposals
 func TestGetAllConsumerAdditionProps(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -407,6 +901,8 @@ func TestHandleConsumerRemovalProposal(t *testing.T) {
 				"description",
 				"chainID",
 				now,
+				false,
+				0,
 			).(*providertypes.ConsumerRemovalProposal),
 			blockTime:     hourAfterNow, // After stop time.
 			expAppendProp: true,
@@ -422,6 +918,8 @@ func TestHandleConsumerRemovalProposal(t *testing.T) {
 				"description",
 				"chainID",
 				hourBeforeNow,
+				false,
+				0,
 			).(*providertypes.ConsumerRemovalProposal),
 			blockTime:     hourAfterNow, // After stop time.
 			expAppendProp: true,
@@ -437,6 +935,8 @@ func TestHandleConsumerRemovalProposal(t *testing.T) {
 				"description",
 				"chainID",
 				hourAfterNow,
+				false,
+				0,
 			).(*providertypes.ConsumerRemovalProposal),
 			blockTime:     now,
 			expAppendProp: true,
@@ -450,6 +950,8 @@ func TestHandleConsumerRemovalProposal(t *testing.T) {
 				"description",
 				"chainID-2",
 				hourAfterNow,
+				false,
+				0,
 			).(*providertypes.ConsumerRemovalProposal),
 			blockTime:     hourAfterNow, // After stop time.
 			expAppendProp: false,
@@ -564,6 +1066,8 @@ func TestStopConsumerChain(t *testing.T) {
 			require.Error(t, err)
 		} else {
 			require.NoError(t, err)
+			// the phase is kept after the consumer chain is stopped
+			require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, "chainID"))
 		}
 
 		testProviderStateIsCleaned(t, ctx, providerKeeper, "chainID", "channelID")
@@ -572,6 +1076,56 @@ func TestStopConsumerChain(t *testing.T) {
 	}
 }
 
+// TestStopConsumerChainPreservingState tests that stopping a consumer chain while preserving its state
+// removes the routing state, but keeps the slash history, the metadata, the client history, and the genesis until purged.
+func TestStopConsumerChainPreservingState(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	providerAddr := cryptoutil.NewCryptoIdentityFromIntSeed(7).ProviderConsAddress()
+	providerKeeper.SetJailedByConsumer(ctx, "chainID", providerAddr)
+
+	err := providerKeeper.StopConsumerChainPreservingState(ctx, "chainID", true)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, "chainID"))
+	require.True(t, providerKeeper.IsConsumerStatePreserved(ctx, "chainID"))
+
+	// the routing state is removed
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, "chainID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+
+	// the slash history, the metadata, the client history, and the genesis are preserved
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddr}, providerKeeper.GetAllJailedByConsumer(ctx, "chainID"))
+	require.NotEmpty(t, providerKeeper.GetConsumerClientHistory(ctx, "chainID"))
+	_, found = providerKeeper.GetConsumerGenesis(ctx, "chainID")
+	require.True(t, found)
+	_, found = providerKeeper.GetConsumerClientInitialHeight(ctx, "chainID")
+	require.True(t, found)
+	_, found = providerKeeper.GetConsumerCreationUnbondingTime(ctx, "chainID")
+	require.True(t, found)
+
+	// the consumer chain cannot be added again until its preserved state is purged
+	err = providerKeeper.CreateConsumerClient(ctx, testkeeper.GetTestConsumerAdditionProp())
+	require.Error(t, err)
+
+	err = providerKeeper.PurgeConsumerState(ctx, "chainID")
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsConsumerStatePreserved(ctx, "chainID"))
+	testProviderStateIsCleaned(t, ctx, providerKeeper, "chainID", "channelID")
+	_, found = providerKeeper.GetConsumerGenesis(ctx, "chainID")
+	require.False(t, found)
+
+	// the preserved state cannot be purged twice
+	err = providerKeeper.PurgeConsumerState(ctx, "chainID")
+	require.True(t, providertypes.ErrConsumerStateNotPreserved.Is(err))
+}
+
 // testProviderStateIsCleaned executes test assertions for the proposer's state being cleaned after a stopped consumer chain.
 func testProviderStateIsCleaned(t *testing.T, ctx sdk.Context, providerKeeper providerkeeper.Keeper,
 	expectedChainID, expectedChannelID string,
@@ -579,18 +1133,42 @@ func testProviderStateIsCleaned(t *testing.T, ctx sdk.Context, providerKeeper pr
 	t.Helper()
 	_, found := providerKeeper.GetConsumerClientId(ctx, expectedChainID)
 	require.False(t, found)
+	require.Empty(t, providerKeeper.GetConsumerClientHistory(ctx, expectedChainID))
+	_, found = providerKeeper.GetConsumerCandidateClientId(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerTopN(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerPowerReduction(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerPowerMultiplier(ctx, expectedChainID)
+	require.False(t, found)
+	require.Equal(t, uint64(len(providerKeeper.GetAllConsumerChains(ctx))), providerKeeper.GetConsumerChainCount(ctx))
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, expectedChainID))
+	_, found = providerKeeper.GetConsumerAcceptedGenesisHash(ctx, expectedChainID)
+	require.False(t, found)
 	_, found = providerKeeper.GetChainToChannel(ctx, expectedChainID)
 	require.False(t, found)
 	_, found = providerKeeper.GetChannelToChain(ctx, expectedChannelID)
 	require.False(t, found)
 	_, found = providerKeeper.GetInitChainHeight(ctx, expectedChainID)
 	require.False(t, found)
+	_, found = providerKeeper.GetConsumerClientInitialHeight(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerCreationUnbondingTime(ctx, expectedChainID)
+	require.False(t, found)
 	acks := providerKeeper.GetSlashAcks(ctx, expectedChainID)
 	require.Empty(t, acks)
 	_, found = providerKeeper.GetInitTimeoutTimestamp(ctx, expectedChainID)
 	require.False(t, found)
 
 	require.Empty(t, providerKeeper.GetAllVscSendTimestamps(ctx, expectedChainID))
+	_, found = providerKeeper.GetVscMaturityTime(ctx, expectedChainID, 1)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllJailedByConsumer(ctx, expectedChainID))
+	require.False(t, providerKeeper.IsConsumerStatePreserved(ctx, expectedChainID))
+	require.Zero(t, providerKeeper.GetVscSendFailures(ctx, expectedChainID))
+	_, found = providerKeeper.GetVscSendRetryHeight(ctx, expectedChainID)
+	require.False(t, found)
 
 	// test key assignment state is cleaned
 	require.Empty(t, providerKeeper.GetAllValidatorConsumerPubKeys(ctx, &expectedChainID))
@@ -812,13 +1390,19 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		},
 		// Note these are unused provider parameters for this test, and not actually asserted against
 		// They must be populated with reasonable values to satisfy SetParams though.
-		TrustingPeriodFraction:      providertypes.DefaultTrustingPeriodFraction,
-		CcvTimeoutPeriod:            ccvtypes.DefaultCCVTimeoutPeriod,
-		InitTimeoutPeriod:           providertypes.DefaultInitTimeoutPeriod,
-		VscTimeoutPeriod:            providertypes.DefaultVscTimeoutPeriod,
-		SlashMeterReplenishPeriod:   providertypes.DefaultSlashMeterReplenishPeriod,
-		SlashMeterReplenishFraction: providertypes.DefaultSlashMeterReplenishFraction,
-		MaxThrottledPackets:         providertypes.DefaultMaxThrottledPackets,
+		TrustingPeriodFraction:                 providertypes.DefaultTrustingPeriodFraction,
+		CcvTimeoutPeriod:                       ccvtypes.DefaultCCVTimeoutPeriod,
+		InitTimeoutPeriod:                      providertypes.DefaultInitTimeoutPeriod,
+		VscTimeoutPeriod:                       providertypes.DefaultVscTimeoutPeriod,
+		SlashMeterReplenishPeriod:              providertypes.DefaultSlashMeterReplenishPeriod,
+		SlashMeterReplenishFraction:            providertypes.DefaultSlashMeterReplenishFraction,
+		MaxThrottledPackets:                    providertypes.DefaultMaxThrottledPackets,
+		ConsumerRewardsToCommunityPoolFraction: providertypes.DefaultConsumerRewardsToCommunityPoolFraction,
+		DefaultTopN:                            providertypes.DefaultTopN,
+		MaxSpawnTimeLag:                        providertypes.DefaultMaxSpawnTimeLag,
+		MaxVscSendBackoffBlocks:                providertypes.DefaultMaxVscSendBackoffBlocks,
+		MaxRecentSpawns:                        providertypes.DefaultMaxRecentSpawns,
+		ReconciliationInterval:                 providertypes.DefaultReconciliationInterval,
 	}
 	providerKeeper.SetParams(ctx, moduleParams)
 	defer ctrl.Finish()
@@ -826,8 +1410,9 @@ func TestMakeConsumerGenesis(t *testing.T) {
 	//
 	// Other setup not covered by custom template client state
 	//
-	ctx = ctx.WithChainID("testchain1") // chainID is obtained from ctx
-	ctx = ctx.WithBlockHeight(5)        // RevisionHeight obtained from ctx
+	ctx = ctx.WithChainID("testchain1")                                  // chainID is obtained from ctx
+	ctx = ctx.WithBlockHeight(5)                                         // RevisionHeight obtained from ctx
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)) // genesis time is computed from ctx
 	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
 
 	// matches params from jsonString
@@ -840,12 +1425,14 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		TransferTimeoutPeriod:             3600000000000,
 		ConsumerRedistributionFraction:    "0.75",
 		HistoricalEntries:                 10000,
-		UnbondingPeriod:                   1728000000000000,
+		UnbondingPeriod:                   1814400000000000,
+		ConsumerNativeUnbondingPeriod:     1728000000000000,
+		GenesisTimeOffset:                 10 * time.Minute,
 	}
 	actualGenesis, _, err := providerKeeper.MakeConsumerGenesis(ctx, &prop)
 	require.NoError(t, err)
 
-	jsonString := `{"params":{"enabled":true, "blocks_per_distribution_transmission":1000, "ccv_timeout_period":2419200000000000, "transfer_timeout_period": 3600000000000, "consumer_redistribution_fraction":"0.75", "historical_entries":10000, "unbonding_period": 1728000000000000, "soft_opt_out_threshold": "0.05"},"new_chain":true,"provider_client_state":{"chain_id":"testchain1","trust_level":{"numerator":1,"denominator":3},"trusting_period":1197504000000000,"unbonding_period":1814400000000000,"max_clock_drift":10000000000,"frozen_height":{},"latest_height":{"revision_height":5},"proof_specs":[{"leaf_spec":{"hash":1,"prehash_value":1,"length":1,"prefix":"AA=="},"inner_spec":{"child_order":[0,1],"child_size":33,"min_prefix_length":4,"max_prefix_length":12,"hash":1}},{"leaf_spec":{"hash":1,"prehash_value":1,"length":1,"prefix":"AA=="},"inner_spec":{"child_order":[0,1],"child_size":32,"min_prefix_length":1,"max_prefix_length":1,"hash":1}}],"upgrade_path":["upgrade","upgradedIBCState"],"allow_update_after_expiry":true,"allow_update_after_misbehaviour":true},"provider_consensus_state":{"timestamp":"2020-01-02T00:00:10Z","root":{"hash":"LpGpeyQVLUo9HpdsgJr12NP2eCICspcULiWa5u9udOA="},"next_validators_hash":"E30CE736441FB9101FADDAF7E578ABBE6DFDB67207112350A9A904D554E1F5BE"},"unbonding_sequences":null,"initial_val_set":[{"pub_key":{"type":"tendermint/PubKeyEd25519","value":"dcASx5/LIKZqagJWN0frOlFtcvz91frYmj/zmoZRWro="},"power":1}]}`
+	jsonString := `{"params":{"enabled":true, "blocks_per_distribution_transmission":1000, "ccv_timeout_period":2419200000000000, "transfer_timeout_period": 3600000000000, "consumer_redistribution_fraction":"0.75", "historical_entries":10000, "unbonding_period": 1728000000000000, "soft_opt_out_threshold": "0.05"},"new_chain":true,"provider_client_state":{"chain_id":"testchain1","trust_level":{"numerator":1,"denominator":3},"trusting_period":1197504000000000,"unbonding_period":1814400000000000,"max_clock_drift":10000000000,"frozen_height":{},"latest_height":{"revision_height":5},"proof_specs":[{"leaf_spec":{"hash":1,"prehash_value":1,"length":1,"prefix":"AA=="},"inner_spec":{"child_order":[0,1],"child_size":33,"min_prefix_length":4,"max_prefix_length":12,"hash":1}},{"leaf_spec":{"hash":1,"prehash_value":1,"length":1,"prefix":"AA=="},"inner_spec":{"child_order":[0,1],"child_size":32,"min_prefix_length":1,"max_prefix_length":1,"hash":1}}],"upgrade_path":["upgrade","upgradedIBCState"],"allow_update_after_expiry":true,"allow_update_after_misbehaviour":true},"provider_consensus_state":{"timestamp":"2020-01-02T00:00:10Z","root":{"hash":"LpGpeyQVLUo9HpdsgJr12NP2eCICspcULiWa5u9udOA="},"next_validators_hash":"E30CE736441FB9101FADDAF7E578ABBE6DFDB67207112350A9A904D554E1F5BE"},"unbonding_sequences":null,"initial_val_set":[{"pub_key":{"type":"tendermint/PubKeyEd25519","value":"dcASx5/LIKZqagJWN0frOlFtcvz91frYmj/zmoZRWro="},"power":1}],"genesis_time":"2023-01-01T00:10:00Z"}`
 
 	var expectedGenesis consumertypes.GenesisState
 	err = json.Unmarshal([]byte(jsonString), &expectedGenesis)
@@ -858,24 +1445,245 @@ func TestMakeConsumerGenesis(t *testing.T) {
 	expectedGenesis.ProviderConsensusState = &ibctmtypes.ConsensusState{}
 
 	require.Equal(t, expectedGenesis, actualGenesis, "consumer chain genesis created incorrectly")
-}
 
-// TestBeginBlockInit directly tests BeginBlockInit against the spec using helpers defined above.
-//
-// See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-bblock-init1
-// Spec tag:[CCV-PCF-BBLOCK-INIT.1]
-func TestBeginBlockInit(t *testing.T) {
-	now := time.Now().UTC()
+	// An unset consumer native unbonding period defaults to the unbonding period of the proposal,
+	// even if the provider unbonding time differs
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	prop.ConsumerNativeUnbondingPeriod = 0
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, prop.UnbondingPeriod, actualGenesis.Params.UnbondingPeriod)
 
-	keeperParams := testkeeper.NewInMemKeeperParams(t)
-	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
-	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	// The CCV identifiers pinned by the proposal are carried into the consumer genesis
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
+	prop.CcvConnectionId = "connection-0"
+	prop.CcvChannelId = "channel-0"
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, "connection-0", actualGenesis.CcvConnectionId)
+	require.Equal(t, "channel-0", actualGenesis.CcvChannelId)
+
+	// The expected provider identifiers of the proposal are carried into the consumer genesis
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
+	prop.ExpectedProviderConnectionId = "connection-1"
+	prop.ExpectedProviderChannelId = "channel-1"
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, "connection-1", actualGenesis.ExpectedProviderConnectionId)
+	require.Equal(t, "channel-1", actualGenesis.ExpectedProviderChannelId)
+
+	// The consumer min gas prices of the proposal are carried into the consumer genesis
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
+	prop.ConsumerMinGasPrices = "0.01ufoo"
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, "0.01ufoo", actualGenesis.MinGasPrices)
+
+	// The max clock drift of the proposal overrides the one of the template client
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
+	prop.MaxClockDrift = 30 * time.Second
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, actualGenesis.ProviderClientState.MaxClockDrift)
+
+	// The relayer allowlist of the proposal is carried into the consumer genesis
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 1814400000000000)...)
+	prop.RelayerAllowlist = []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}
+	actualGenesis, _, err = providerKeeper.MakeConsumerGenesis(ctx, &prop)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"}, actualGenesis.RelayerAllowlist)
+}
+
+// TestMakeConsumerGenesisPrunedHistoricalInfo tests that MakeConsumerGenesis falls back
+// to the previous height if the self consensus state at the current height is missing.
+func TestMakeConsumerGenesisPrunedHistoricalInfo(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
-	ctx = ctx.WithBlockTime(now)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockHeight(10)
 
-	pendingProps := []*providertypes.ConsumerAdditionProposal{
-		providertypes.NewConsumerAdditionProposal(
-			"title", "spawn time passed", "chain1", clienttypes.NewHeight(3, 4), []byte{}, []byte{},
+	height := clienttypes.GetSelfHeight(ctx)
+	prevHeight := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight-1)
+	validator := cryptoutil.NewCryptoIdentityFromIntSeed(0)
+
+	// the historical info at the current height is pruned
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), height).Return(
+			nil, stakingtypes.ErrNoHistoricalInfo).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), prevHeight).Return(
+			&ibctmtypes.ConsensusState{}, nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				cb(validator.SDKValOpAddress(), 1)
+			}).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), validator.SDKValOpAddress()).Return(
+			validator.SDKStakingValidator(), true).Times(1),
+	)
+	gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, testkeeper.GetTestConsumerAdditionProp())
+	require.NoError(t, err)
+	require.Equal(t, prevHeight, gen.ProviderClientState.LatestHeight)
+
+	// the historical info at both the current and the previous heights is pruned
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), height).Return(
+			nil, stakingtypes.ErrNoHistoricalInfo).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), prevHeight).Return(
+			nil, stakingtypes.ErrNoHistoricalInfo).Times(1),
+		mocks.MockStakingKeeper.EXPECT().HistoricalEntries(gomock.Any()).Return(uint32(0)).Times(1),
+	)
+	_, _, err = providerKeeper.MakeConsumerGenesis(ctx, testkeeper.GetTestConsumerAdditionProp())
+	require.ErrorIs(t, err, clienttypes.ErrConsensusStateNotFound)
+	require.Contains(t, err.Error(), height.String())
+	require.Contains(t, err.Error(), prevHeight.String())
+	require.Contains(t, err.Error(), "HistoricalEntries")
+}
+
+// TestMakeConsumerGenesisTrustingPeriod tests that MakeConsumerGenesis rejects consumer genesis states
+// in which the trusting period of the provider client is not less than the provider unbonding period.
+func TestMakeConsumerGenesisTrustingPeriod(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		trustingPeriodFraction string
+		expPass                bool
+	}{
+		{"default trusting period fraction", providertypes.DefaultTrustingPeriodFraction, true},
+		{"trusting period just below the unbonding period", "0.999999999999999999", true},
+		{"trusting period equal to the unbonding period", "1", false},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+
+		params := providertypes.DefaultParams()
+		params.TrustingPeriodFraction = tc.trustingPeriodFraction
+		providerKeeper.SetParams(ctx, params)
+
+		gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+		gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, testkeeper.GetTestConsumerAdditionProp())
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Less(t, gen.ProviderClientState.TrustingPeriod, time.Hour, tc.name)
+			require.Equal(t, time.Hour, gen.ProviderClientState.UnbondingPeriod, tc.name)
+		} else {
+			require.ErrorIs(t, err, providertypes.ErrInvalidConsumerGenesis, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestMakeConsumerGenesisMinProviderPower tests that MakeConsumerGenesis rejects initial
+// validator sets with a total power below the min provider power of the proposal.
+func TestMakeConsumerGenesisMinProviderPower(t *testing.T) {
+	testCases := []struct {
+		name             string
+		minProviderPower int64
+		expPass          bool
+	}{
+		{"no min provider power", 0, true},
+		{"total power equal to the min provider power", 1, true},
+		{"total power below the min provider power", 2, false},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		// the mocked initial validator set consists of a single validator with power 1
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.MinProviderPower = tc.minProviderPower
+
+		gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+		_, _, err := providerKeeper.MakeConsumerGenesis(ctx, prop)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, providertypes.ErrInsufficientProviderPower, tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
+// TestMakeConsumerGenesisInitialValSetHeight tests that the initial validator set is taken from
+// the historical info of the initial validator set height of the proposal, if set.
+func TestMakeConsumerGenesisInitialValSetHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	validator := cryptoutil.NewCryptoIdentityFromIntSeed(0)
+	val := validator.SDKStakingValidator()
+	val.Status = stakingtypes.Bonded
+	val.Tokens = sdk.TokensFromConsensusPower(3, sdk.DefaultPowerReduction)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.InitialValSetHeight = 5
+
+	// the validator set at the pinned height is used instead of the live one
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{}, nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(
+			stakingtypes.HistoricalInfo{Valset: []stakingtypes.Validator{val}}, true).Times(1),
+		mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).Times(1),
+	)
+	gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: validator.TMProtoCryptoPublicKey(), Power: 3}}, gen.InitialValSet)
+
+	// the historical info of the pinned height is not retained
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+			clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{}, nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(5)).Return(
+			stakingtypes.HistoricalInfo{}, false).Times(1),
+	)
+	_, _, err = providerKeeper.MakeConsumerGenesis(ctx, prop)
+	require.ErrorIs(t, err, providertypes.ErrUnknownHistoricalValSet)
+}
+
+// TestMakeConsumerGenesisStandaloneChangeover tests that the consumer genesis of an existing standalone chain
+// is marked as pre-CCV, while it is still for a new chain with the initial validator set.
+func TestMakeConsumerGenesisStandaloneChangeover(t *testing.T) {
+	for _, standaloneChangeover := range []bool{false, true} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.StandaloneChangeover = standaloneChangeover
+
+		gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+		gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, prop)
+		require.NoError(t, err)
+		require.Equal(t, standaloneChangeover, gen.PreCCV)
+		require.True(t, gen.NewChain)
+		require.NotEmpty(t, gen.InitialValSet)
+
+		ctrl.Finish()
+	}
+}
+
+// TestBeginBlockInit directly tests BeginBlockInit against the spec using helpers defined above.
+//
+// See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-bblock-init1
+// Spec tag:[CCV-PCF-BBLOCK-INIT.1]
+func TestBeginBlockInit(t *testing.T) {
+	now := time.Now().UTC()
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	pendingProps := []*providertypes.ConsumerAdditionProposal{
+		providertypes.NewConsumerAdditionProposal(
+			"title", "spawn time passed", "chain1", clienttypes.NewHeight(3, 4), []byte{}, []byte{},
 			now.Add(-time.Hour*2).UTC(),
 			"0.75",
 			10,
@@ -941,10 +1749,607 @@ func TestBeginBlockInit(t *testing.T) {
 		ctx, pendingProps[2].SpawnTime, pendingProps[2].ChainId)
 	require.True(t, found)
 
-	// check that the invalid proposal was dropped
+	// check that the invalid proposal was dropped and stored as failed
 	_, found = providerKeeper.GetPendingConsumerAdditionProp(
 		ctx, pendingProps[3].SpawnTime, pendingProps[3].ChainId)
 	require.False(t, found)
+	failedProp, found := providerKeeper.GetFailedConsumerAdditionProp(ctx, pendingProps[3].ChainId)
+	require.True(t, found)
+	require.Equal(t, pendingProps[3].Description, failedProp.Proposal.Description)
+	require.NotEmpty(t, failedProp.Error)
+
+	_, found = providerKeeper.GetFailedConsumerAdditionProp(ctx, pendingProps[0].ChainId)
+	require.False(t, found)
+
+	// check the summary event of the processed proposals
+	var summary *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ccvtypes.EventTypeConsumerSpawnSummary {
+			event := event
+			summary = &event
+		}
+	}
+	require.NotNil(t, summary)
+	attributes := map[string]string{}
+	for _, attr := range summary.Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+	require.Equal(t, "2", attributes[ccvtypes.AttributeSpawned])
+	require.Equal(t, "1", attributes[ccvtypes.AttributeFailed])
+	require.Equal(t, "1", attributes[ccvtypes.AttributeRemaining])
+}
+
+// TestBeginBlockInitWithoutValidators tests that consumer addition proposals can be
+// queued while the provider has no bonded validators, and that their execution
+// is deferred until there is at least one bonded validator.
+func TestBeginBlockInitWithoutValidators(t *testing.T) {
+	now := time.Now().UTC()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chainID"
+	prop.SpawnTime = now
+
+	// mocks for MakeConsumerGenesis with an empty validator set
+	getMocksWithoutValidators := func() []*gomock.Call {
+		return []*gomock.Call{
+			mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(),
+				clienttypes.GetSelfHeight(ctx)).Return(&ibctmtypes.ConsensusState{}, nil).Times(1),
+			mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).Times(1),
+		}
+	}
+
+	// the proposal is queued even though there are no bonded validators
+	gomock.InOrder(getMocksWithoutValidators()...)
+	err := providerKeeper.HandleConsumerAdditionProposal(ctx, prop)
+	require.NoError(t, err)
+	_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+	require.True(t, found)
+
+	// the spawn is deferred, i.e., the proposal is kept pending and not stored as failed
+	gomock.InOrder(getMocksWithoutValidators()...)
+	providerKeeper.BeginBlockInit(ctx)
+	_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+	require.True(t, found)
+	_, found = providerKeeper.GetFailedConsumerAdditionProp(ctx, prop.ChainId)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.False(t, found)
+
+	deferred := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ccvtypes.EventTypeConsumerSpawnDeferred {
+			deferred = true
+		}
+	}
+	require.True(t, deferred)
+
+	// once there is a bonded validator, the consumer chain is spawned
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	providerKeeper.BeginBlockInit(ctx)
+	_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.True(t, found)
+}
+
+// TestBeginBlockInitWithInsufficientProviderPower tests that the execution of a consumer addition
+// proposal is deferred while the total power of the initial validator set is below its min provider power.
+func TestBeginBlockInitWithInsufficientProviderPower(t *testing.T) {
+	now := time.Now().UTC()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	// the mocked initial validator set consists of a single validator with power 1
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chainID"
+	prop.SpawnTime = now
+	prop.MinProviderPower = 2
+
+	// the proposal is queued even though the initial validator set has insufficient power
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	err := providerKeeper.HandleConsumerAdditionProposal(ctx, prop)
+	require.NoError(t, err)
+
+	// the spawn is deferred, i.e., the proposal is kept pending and not stored as failed
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	providerKeeper.BeginBlockInit(ctx)
+	_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+	require.True(t, found)
+	_, found = providerKeeper.GetFailedConsumerAdditionProp(ctx, prop.ChainId)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.False(t, found)
+
+	var reason string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ccvtypes.EventTypeConsumerSpawnDeferred {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == ccvtypes.AttributeError {
+					reason = string(attr.Value)
+				}
+			}
+		}
+	}
+	require.Contains(t, reason, providertypes.ErrInsufficientProviderPower.Error())
+}
+
+// TestBeginBlockUnbondingTimeCheck tests that a change of the provider unbonding time
+// is reported for every pending consumer chain, whose client is created with the unbonding
+// time at spawn time, not the one when the proposal was handled.
+func TestBeginBlockUnbondingTimeCheck(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chainID"
+	prop.SpawnTime = time.Now().UTC().Add(time.Hour)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+
+	changedChainIDs := func(ctx sdk.Context) (chainIDs []string) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != ccvtypes.EventTypePendingSpawnParamsChanged {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == ccvtypes.AttributeChainID {
+					chainIDs = append(chainIDs, string(attr.Value))
+				}
+			}
+		}
+		return chainIDs
+	}
+
+	// the first observed unbonding time is only recorded
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(3 * 7 * 24 * time.Hour).Times(2)
+	providerKeeper.BeginBlockUnbondingTimeCheck(ctx)
+	unbondingTime, found := providerKeeper.GetLastProviderUnbondingTime(ctx)
+	require.True(t, found)
+	require.Equal(t, 3*7*24*time.Hour, unbondingTime)
+	require.Empty(t, changedChainIDs(ctx))
+
+	// an unchanged unbonding time is not reported nor written to the store
+	gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	providerKeeper.BeginBlockUnbondingTimeCheck(gasCtx)
+	require.Empty(t, changedChainIDs(ctx))
+	require.Less(t, gasCtx.GasMeter().GasConsumed(), storetypes.KVGasConfig().WriteCostFlat)
+
+	// a changed unbonding time is reported for the pending consumer chain
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(2 * 7 * 24 * time.Hour).Times(1)
+	providerKeeper.BeginBlockUnbondingTimeCheck(ctx)
+	require.Equal(t, []string{"chainID"}, changedChainIDs(ctx))
+	unbondingTime, _ = providerKeeper.GetLastProviderUnbondingTime(ctx)
+	require.Equal(t, 2*7*24*time.Hour, unbondingTime)
+
+	// the client is created with the unbonding time at spawn time
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, 2*7*24*time.Hour)...)
+	gen, _, err := providerKeeper.MakeConsumerGenesis(ctx, prop)
+	require.NoError(t, err)
+	require.Equal(t, 2*7*24*time.Hour, gen.ProviderClientState.UnbondingPeriod)
+
+	// without pending consumer chains, a changed unbonding time is not reported
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.DeletePendingConsumerAdditionProps(ctx, *prop)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).Times(1)
+	providerKeeper.BeginBlockUnbondingTimeCheck(ctx)
+	require.Empty(t, changedChainIDs(ctx))
+}
+
+// TestRequeueFailedConsumerAdditionProp tests that a failed consumer addition proposal
+// can be requeued with an updated spawn time and initial height.
+func TestRequeueFailedConsumerAdditionProp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chainID"
+
+	// cannot requeue a proposal that did not fail
+	err := providerKeeper.RequeueFailedConsumerAdditionProp(ctx, "chainID", now, clienttypes.NewHeight(2, 3))
+	require.ErrorIs(t, err, providertypes.ErrUnknownFailedConsumerAdditionProp)
+
+	providerKeeper.SetFailedConsumerAdditionProp(ctx, *prop, "error")
+
+	// cannot requeue with an invalid initial height
+	err = providerKeeper.RequeueFailedConsumerAdditionProp(ctx, "chainID", now, clienttypes.Height{})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerAdditionProposal)
+	_, found := providerKeeper.GetFailedConsumerAdditionProp(ctx, "chainID")
+	require.True(t, found)
+
+	// cannot requeue a proposal for a chain that already has a consumer client
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	err = providerKeeper.RequeueFailedConsumerAdditionProp(ctx, "chainID", now, clienttypes.NewHeight(2, 3))
+	require.ErrorIs(t, err, ccvtypes.ErrDuplicateConsumerChain)
+	providerKeeper.DeleteConsumerClientId(ctx, "chainID")
+
+	err = providerKeeper.RequeueFailedConsumerAdditionProp(ctx, "chainID", now, clienttypes.NewHeight(2, 3))
+	require.NoError(t, err)
+
+	_, found = providerKeeper.GetFailedConsumerAdditionProp(ctx, "chainID")
+	require.False(t, found)
+	pendingProp, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, now, "chainID")
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(2, 3), pendingProp.InitialHeight)
+	require.Equal(t, prop.GenesisHash, pendingProp.GenesisHash)
+	require.Equal(t, providertypes.ConsumerPhasePending, providerKeeper.GetConsumerPhase(ctx, "chainID"))
+}
+
+// TestFailedConsumerAdditionProps tests that the failed consumer addition proposals
+// record the last error and the number of failures, and that they can be enumerated.
+func TestFailedConsumerAdditionProps(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	chainIDs := []string{"chain-3", "chain-1", "chain-2"}
+	for _, chainID := range chainIDs {
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = chainID
+		providerKeeper.SetFailedConsumerAdditionProp(ctx, *prop, "first error")
+	}
+
+	// the failure count persists across requeues
+	err := providerKeeper.RequeueFailedConsumerAdditionProp(ctx, "chain-1", now, clienttypes.NewHeight(2, 3))
+	require.NoError(t, err)
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chain-1"
+	providerKeeper.SetFailedConsumerAdditionProp(ctx, *prop, "second error")
+
+	failedProp, found := providerKeeper.GetFailedConsumerAdditionProp(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, "second error", failedProp.Error)
+	require.Equal(t, uint64(2), failedProp.FailureCount)
+
+	// the failed proposals are iterated by chain ID
+	var iteratedChainIDs []string
+	providerKeeper.IterateFailedConsumerAdditionProps(ctx, func(chainID string, failedProp providertypes.FailedConsumerAdditionProposal) bool {
+		require.Equal(t, chainID, failedProp.Proposal.ChainId)
+		iteratedChainIDs = append(iteratedChainIDs, chainID)
+		return false
+	})
+	require.Equal(t, []string{"chain-1", "chain-2", "chain-3"}, iteratedChainIDs)
+
+	// the iteration stops when the callback returns true
+	iteratedChainIDs = nil
+	providerKeeper.IterateFailedConsumerAdditionProps(ctx, func(chainID string, _ providertypes.FailedConsumerAdditionProposal) bool {
+		iteratedChainIDs = append(iteratedChainIDs, chainID)
+		return true
+	})
+	require.Equal(t, []string{"chain-1"}, iteratedChainIDs)
+
+	// the failed proposals can be queried page by page
+	var queried []providertypes.FailedConsumerAdditionProposal
+	var nextKey []byte
+	for {
+		res, err := providerKeeper.QueryFailedConsumerAdditionProposals(sdk.WrapSDKContext(ctx),
+			&providertypes.QueryFailedConsumerAdditionProposalsRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+			})
+		require.NoError(t, err)
+		queried = append(queried, res.FailedProposals...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Len(t, queried, 3)
+	require.Equal(t, "chain-1", queried[0].Proposal.ChainId)
+	require.Equal(t, uint64(2), queried[0].FailureCount)
+	require.Equal(t, uint64(1), queried[1].FailureCount)
+	require.Equal(t, "first error", queried[2].Error)
+
+	_, err = providerKeeper.QueryFailedConsumerAdditionProposals(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	// the failure count is reset once the consumer chain is spawned
+	providerKeeper.DeleteConsumerSpawnFailureCount(ctx, "chain-1")
+	require.Equal(t, uint64(0), providerKeeper.GetConsumerSpawnFailureCount(ctx, "chain-1"))
+}
+
+// TestRenameConsumerChain tests that all the state of a consumer chain is moved to the new chain ID,
+// and that no state remains under the old chain ID.
+func TestRenameConsumerChain(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	oldChainID, newChainID := "old-chain", "renamed-chain"
+	validator := cryptoutil.NewCryptoIdentityFromIntSeed(0)
+
+	// cannot rename an unknown consumer chain
+	err := providerKeeper.RenameConsumerChain(ctx, oldChainID, newChainID)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	providerKeeper.SetConsumerClientId(ctx, oldChainID, "clientID")
+	providerKeeper.SetChainToChannel(ctx, oldChainID, "channelID")
+	providerKeeper.SetChannelToChain(ctx, "channelID", oldChainID)
+	providerKeeper.SetConsumerPhase(ctx, oldChainID, providertypes.ConsumerPhaseChannelEstablished)
+	providerKeeper.SetInitChainHeight(ctx, oldChainID, 10)
+	providerKeeper.SetSlashAcks(ctx, oldChainID, []string{"ack"})
+	providerKeeper.SetConsumerTopN(ctx, oldChainID, 95)
+	providerKeeper.SetValidatorApprovalRequired(ctx, oldChainID)
+	providerKeeper.SetApprovedValidator(ctx, oldChainID, validator.ProviderConsAddress())
+	providerKeeper.SetRelayerAllowlist(ctx, oldChainID, []string{"relayer"})
+	providerKeeper.SetValidatorConsumerPubKey(ctx, oldChainID, validator.ProviderConsAddress(), validator.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, oldChainID, validator.ConsumerConsAddress(), validator.ProviderConsAddress())
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, oldChainID, 1, validator.ConsumerConsAddress())
+	providerKeeper.SetVscSendTimestamp(ctx, oldChainID, 1, ctx.BlockTime())
+	providerKeeper.AppendPendingVSCPackets(ctx, oldChainID, ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: 2})
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"other-chain", oldChainID}})
+	providerKeeper.SetUnbondingOpIndex(ctx, oldChainID, 1, []uint64{1})
+	providerKeeper.QueueGlobalSlashEntry(ctx, providertypes.NewGlobalSlashEntry(ctx.BlockTime(), oldChainID, 1, validator.ProviderConsAddress()))
+	providerKeeper.SetPendingConsumerRemovalProp(ctx, &providertypes.ConsumerRemovalProposal{ChainId: oldChainID, StopTime: ctx.BlockTime()})
+	tokenExpiry := time.Now().UTC()
+	providerKeeper.SetIdempotencyTokenExpiry(ctx, oldChainID, "token", tokenExpiry)
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, oldChainID, consumertypes.GenesisState{}))
+	rewardsTotals := providertypes.ConsumerRewardsTotals{
+		CommunityPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		FeeCollector:  sdk.NewCoins(sdk.NewInt64Coin("stake", 2)),
+	}
+	providerKeeper.SetConsumerRewardsTotals(ctx, oldChainID, rewardsTotals)
+	pendingRewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 3))
+	providerKeeper.AddPendingConsumerRewards(ctx, oldChainID, pendingRewards)
+
+	// cannot rename to a chain ID with existing state
+	providerKeeper.SetConsumerClientId(ctx, "other-chain", "otherClientID")
+	err = providerKeeper.RenameConsumerChain(ctx, oldChainID, "other-chain")
+	require.ErrorIs(t, err, ccvtypes.ErrDuplicateConsumerChain)
+	providerKeeper.SetRelayerAllowlist(ctx, newChainID, []string{"relayer"})
+	err = providerKeeper.RenameConsumerChain(ctx, oldChainID, newChainID)
+	require.ErrorIs(t, err, ccvtypes.ErrDuplicateConsumerChain)
+	providerKeeper.DeleteRelayerAllowlist(ctx, newChainID)
+	providerKeeper.AddPendingConsumerRewards(ctx, newChainID, pendingRewards)
+	err = providerKeeper.RenameConsumerChain(ctx, oldChainID, newChainID)
+	require.ErrorIs(t, err, ccvtypes.ErrDuplicateConsumerChain)
+	providerKeeper.DeletePendingConsumerRewards(ctx, newChainID)
+	providerKeeper.SetConsumerRewardsTotals(ctx, newChainID, rewardsTotals)
+	err = providerKeeper.RenameConsumerChain(ctx, oldChainID, newChainID)
+	require.ErrorIs(t, err, ccvtypes.ErrDuplicateConsumerChain)
+	providerKeeper.DeleteConsumerRewardsTotals(ctx, newChainID)
+
+	// can rename to the chain ID of a stopped consumer chain whose state is not preserved,
+	// i.e., its client history is deleted and only its phase is kept
+	providerKeeper.SetConsumerClientId(ctx, newChainID, "stoppedClientID")
+	require.Len(t, providerKeeper.GetConsumerClientHistory(ctx, newChainID), 1)
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, newChainID, false))
+	require.Empty(t, providerKeeper.GetConsumerClientHistory(ctx, newChainID))
+	require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, newChainID))
+
+	// only the governance account can rename a consumer chain
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	_, err = msgServer.RenameConsumerChain(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgRenameConsumerChain("invalid", oldChainID, newChainID))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.RenameConsumerChain(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgRenameConsumerChain(providerKeeper.GetAuthority(), oldChainID, newChainID))
+	require.NoError(t, err)
+
+	// no keys reference the old chain ID
+	store := ctx.KVStore(keeperParams.StoreKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		require.False(t, bytes.Contains(iterator.Key(), []byte(oldChainID)), "stale key: %X", iterator.Key())
+	}
+
+	clientID, found := providerKeeper.GetConsumerClientId(ctx, newChainID)
+	require.True(t, found)
+	require.Equal(t, "clientID", clientID)
+	chainID, found := providerKeeper.GetChainIDByClientID(ctx, "clientID")
+	require.True(t, found)
+	require.Equal(t, newChainID, chainID)
+	chainID, found = providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.True(t, found)
+	require.Equal(t, newChainID, chainID)
+	channelID, found := providerKeeper.GetChainToChannel(ctx, newChainID)
+	require.True(t, found)
+	require.Equal(t, "channelID", channelID)
+	require.Equal(t, providertypes.ConsumerPhaseChannelEstablished, providerKeeper.GetConsumerPhase(ctx, newChainID))
+	topN, found := providerKeeper.GetConsumerTopN(ctx, newChainID)
+	require.True(t, found)
+	require.Equal(t, uint32(95), topN)
+	require.True(t, providerKeeper.IsValidatorApprovalRequired(ctx, newChainID))
+	require.True(t, providerKeeper.IsApprovedValidator(ctx, newChainID, validator.ProviderConsAddress()))
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, newChainID, validator.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, validator.TMProtoCryptoPublicKey(), consumerKey)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, newChainID), 1)
+	require.Len(t, providerKeeper.GetAllUnbondingOpIndexes(ctx, newChainID), 1)
+	unbondingOp, found := providerKeeper.GetUnbondingOp(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, []string{"other-chain", newChainID}, unbondingOp.UnbondingConsumerChains)
+	require.Equal(t, newChainID, providerKeeper.GetAllGlobalSlashEntries(ctx)[0].ConsumerChainID)
+	require.Equal(t, newChainID, providerKeeper.GetAllPendingConsumerRemovalProps(ctx)[0].ChainId)
+	_, found = providerKeeper.GetConsumerGenesis(ctx, newChainID)
+	require.True(t, found)
+	require.Equal(t, uint64(2), providerKeeper.GetConsumerChainCount(ctx))
+	require.Equal(t, rewardsTotals, providerKeeper.GetConsumerRewardsTotals(ctx, newChainID))
+	require.Equal(t, pendingRewards, providerKeeper.GetPendingConsumerRewards(ctx, newChainID))
+
+	// the idempotency token is still pruned once expired
+	providerKeeper.PruneExpiredIdempotencyTokens(ctx.WithBlockTime(tokenExpiry))
+	require.Empty(t, providerKeeper.GetAllIdempotencyTokens(ctx))
+}
+
+// TestPurgeAllPendingClients tests that all the pending consumer addition proposals are purged,
+// together with their spawn time index, their pending phase, and their idempotency tokens.
+func TestPurgeAllPendingClients(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+
+	// nothing to purge
+	require.Equal(t, uint64(0), providerKeeper.PurgeAllPendingClients(ctx))
+
+	chainIDs := []string{"chain-1", "chain-2", "chain-3"}
+	for i, chainID := range chainIDs {
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = chainID
+		prop.SpawnTime = now.Add(time.Duration(i) * time.Hour)
+		providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+		providerKeeper.SetIdempotencyTokenExpiry(ctx, chainID, "token", now.Add(time.Hour))
+	}
+	// the removal proposals are not affected
+	providerKeeper.SetPendingConsumerRemovalProp(ctx, &providertypes.ConsumerRemovalProposal{ChainId: "chain-4", StopTime: now})
+
+	require.Equal(t, uint64(len(chainIDs)), providerKeeper.PurgeAllPendingClients(ctx))
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	for _, chainID := range chainIDs {
+		_, found := providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, chainID)
+		require.False(t, found)
+		require.NotEqual(t, providertypes.ConsumerPhasePending, providerKeeper.GetConsumerPhase(ctx, chainID))
+		_, found = providerKeeper.GetIdempotencyTokenExpiry(ctx, chainID, "token")
+		require.False(t, found)
+	}
+	require.Len(t, providerKeeper.GetAllPendingConsumerRemovalProps(ctx), 1)
+}
+
+// TestForceSpawnPendingClient tests that the consumer client of a pending consumer chain
+// can be created before the spawn time of its proposal
+func TestForceSpawnPendingClient(t *testing.T) {
+	now := time.Now().UTC()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	// no pending proposal for the chain
+	_, err := providerKeeper.ForceSpawnPendingClient(ctx, "chainID")
+	require.ErrorIs(t, err, providertypes.ErrUnknownPendingConsumerAdditionProp)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.SpawnTime = now.Add(time.Hour)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	otherProp := testkeeper.GetTestConsumerAdditionProp()
+	otherProp.ChainId = "otherChainID"
+	otherProp.SpawnTime = now.Add(time.Hour)
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, otherProp)
+
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	clientID, err := providerKeeper.ForceSpawnPendingClient(ctx, prop.ChainId)
+	require.NoError(t, err)
+	require.Equal(t, "clientID", clientID)
+
+	// the proposal is no longer pending, while the other one is not affected
+	storedClientID, found := providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.True(t, found)
+	require.Equal(t, clientID, storedClientID)
+	_, found = providerKeeper.GetPendingConsumerAdditionPropSpawnTime(ctx, prop.ChainId)
+	require.False(t, found)
+	_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+	require.False(t, found)
+	require.Len(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx), 1)
+
+	// the proposal cannot be spawned twice
+	_, err = providerKeeper.ForceSpawnPendingClient(ctx, prop.ChainId)
+	require.ErrorIs(t, err, providertypes.ErrUnknownPendingConsumerAdditionProp)
+
+	// only the governance account can force spawn a pending consumer chain
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	_, err = msgServer.ForceSpawnPendingClient(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgForceSpawnPendingClient("invalid", otherProp.ChainId))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx), 1)
+}
+
+// TestHandleConsumerAdditionProposalIdempotencyToken tests that a consumer addition proposal
+// is rejected if a proposal for the same chain with the same idempotency token was handled
+// within the retention period.
+func TestHandleConsumerAdditionProposalIdempotencyToken(t *testing.T) {
+	now := time.Now().UTC()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.SpawnTime = now.Add(time.Hour)
+	prop.IdempotencyToken = "token"
+
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	err := providerKeeper.HandleConsumerAdditionProposal(ctx, prop)
+	require.NoError(t, err)
+	expiry, found := providerKeeper.GetIdempotencyTokenExpiry(ctx, prop.ChainId, prop.IdempotencyToken)
+	require.True(t, found)
+	require.Equal(t, now.Add(providertypes.IdempotencyTokenRetentionPeriod), expiry)
+
+	// a duplicate proposal with a different spawn time is rejected
+	duplicate := *prop
+	duplicate.SpawnTime = now.Add(2 * time.Hour)
+	err = providerKeeper.HandleConsumerAdditionProposal(ctx, &duplicate)
+	require.True(t, providertypes.ErrDuplicateIdempotencyToken.Is(err))
+	_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, duplicate.SpawnTime, duplicate.ChainId)
+	require.False(t, found)
+
+	// the token is not pruned before the end of the retention period
+	ctx = ctx.WithBlockTime(expiry.Add(-time.Second))
+	providerKeeper.PruneExpiredIdempotencyTokens(ctx)
+	_, found = providerKeeper.GetIdempotencyTokenExpiry(ctx, prop.ChainId, prop.IdempotencyToken)
+	require.True(t, found)
+
+	// once the retention period ended, the token is pruned and the duplicate is accepted
+	ctx = ctx.WithBlockTime(expiry)
+	providerKeeper.PruneExpiredIdempotencyTokens(ctx)
+	_, found = providerKeeper.GetIdempotencyTokenExpiry(ctx, prop.ChainId, prop.IdempotencyToken)
+	require.False(t, found)
+
+	duplicate.SpawnTime = expiry.Add(time.Hour)
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, prop.InitialHeight)...)
+	err = providerKeeper.HandleConsumerAdditionProposal(ctx, &duplicate)
+	require.NoError(t, err)
+
+	// the tokens are deleted together with the consumer chain state
+	providerKeeper.DeleteIdempotencyTokens(ctx, prop.ChainId)
+	_, found = providerKeeper.GetIdempotencyTokenExpiry(ctx, prop.ChainId, prop.IdempotencyToken)
+	require.False(t, found)
+}
+
+// TestPruneExpiredIdempotencyTokens tests that only the expired idempotency tokens are pruned,
+// using the index of the tokens by expiry time, and that the index is kept in sync with the tokens
+func TestPruneExpiredIdempotencyTokens(t *testing.T) {
+	now := time.Now().UTC()
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	store := ctx.KVStore(keeperParams.StoreKey)
+
+	providerKeeper.SetIdempotencyTokenExpiry(ctx, "chain-1", "token", now.Add(2*time.Hour))
+	providerKeeper.SetIdempotencyTokenExpiry(ctx, "chain-2", "token", now.Add(time.Hour))
+	providerKeeper.SetIdempotencyTokenExpiry(ctx, "chain-3", "token", now.Add(3*time.Hour))
+	// overwriting the expiry of a token replaces its index entry
+	providerKeeper.SetIdempotencyTokenExpiry(ctx, "chain-1", "token", now)
+	require.False(t, store.Has(providertypes.IdempotencyTokenExpiryKey(now.Add(2*time.Hour), "chain-1", "token")))
+	require.True(t, store.Has(providertypes.IdempotencyTokenExpiryKey(now, "chain-1", "token")))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	providerKeeper.PruneExpiredIdempotencyTokens(ctx)
+	require.Equal(t, []providertypes.IdempotencyToken{
+		{ChainId: "chain-3", Token: "token", Expiry: now.Add(3 * time.Hour)},
+	}, providerKeeper.GetAllIdempotencyTokens(ctx))
+	require.False(t, store.Has(providertypes.IdempotencyTokenExpiryKey(now, "chain-1", "token")))
+	require.False(t, store.Has(providertypes.IdempotencyTokenExpiryKey(now.Add(time.Hour), "chain-2", "token")))
+
+	// deleting the tokens of a consumer chain deletes their index entries as well
+	providerKeeper.DeleteIdempotencyTokens(ctx, "chain-3")
+	require.Empty(t, providerKeeper.GetAllIdempotencyTokens(ctx))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{providertypes.IdempotencyTokenExpiryBytePrefix})
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
 }
 
 // TestBeginBlockCCR tests BeginBlockCCR against the spec.
@@ -962,13 +2367,16 @@ func TestBeginBlockCCR(t *testing.T) {
 
 	pendingProps := []*providertypes.ConsumerRemovalProposal{
 		providertypes.NewConsumerRemovalProposal(
-			"title", "description", "chain1", now.Add(-time.Hour).UTC(),
+			"title", "description", "chain1", now.Add(-time.Hour).UTC(), false,
+			0,
 		).(*providertypes.ConsumerRemovalProposal),
 		providertypes.NewConsumerRemovalProposal(
-			"title", "description", "chain2", now,
+			"title", "description", "chain2", now, false,
+			0,
 		).(*providertypes.ConsumerRemovalProposal),
 		providertypes.NewConsumerRemovalProposal(
-			"title", "description", "chain3", now.Add(time.Hour).UTC(),
+			"title", "description", "chain3", now.Add(time.Hour).UTC(), false,
+			0,
 		).(*providertypes.ConsumerRemovalProposal),
 	}
 
@@ -1007,7 +2415,8 @@ func TestBeginBlockCCR(t *testing.T) {
 
 	// Add an invalid prop to the store with an non-existing chain id
 	invalidProp := providertypes.NewConsumerRemovalProposal(
-		"title", "description", "chain4", now.Add(-time.Hour).UTC(),
+		"title", "description", "chain4", now.Add(-time.Hour).UTC(), false,
+		0,
 	).(*providertypes.ConsumerRemovalProposal)
 	providerKeeper.SetPendingConsumerRemovalProp(ctx, invalidProp)
 
@@ -1031,6 +2440,64 @@ func TestBeginBlockCCR(t *testing.T) {
 	require.False(t, found)
 }
 
+// TestBeginBlockCCRCooldown tests that a consumer chain removed via a consumer removal proposal
+// with a cooldown is stopping until the cooldown elapsed, i.e., no VSC packets are sent to it,
+// while its CCV channel is kept open, and that it is stopped and removed afterwards
+func TestBeginBlockCCRCooldown(t *testing.T) {
+	now := time.Now().UTC()
+	cooldown := time.Hour
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	prop := providertypes.NewConsumerRemovalProposal(
+		"title", "description", "chain1", now, false, cooldown,
+	).(*providertypes.ConsumerRemovalProposal)
+
+	gomock.InOrder(append(
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, prop.ChainId, clienttypes.NewHeight(2, 3)),
+		testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, prop.ChainId)...,
+	)...)
+	additionProp := testkeeper.GetTestConsumerAdditionProp()
+	additionProp.ChainId = prop.ChainId
+	additionProp.InitialHeight = clienttypes.NewHeight(2, 3)
+	require.NoError(t, providerKeeper.CreateConsumerClient(ctx, additionProp))
+	require.NoError(t, providerKeeper.SetConsumerChain(ctx, "channelID"))
+	providerKeeper.SetPendingConsumerRemovalProp(ctx, prop)
+
+	// the consumer chain is stopping and the proposal is enqueued again until the end of the cooldown
+	providerKeeper.BeginBlockCCR(ctx)
+	require.Equal(t, providertypes.ConsumerPhaseStopping, providerKeeper.GetConsumerPhase(ctx, prop.ChainId))
+	_, found := providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.True(t, found)
+	_, found = providerKeeper.GetChainToChannel(ctx, prop.ChainId)
+	require.True(t, found)
+	require.False(t, providerKeeper.PendingConsumerRemovalPropExists(ctx, prop.ChainId, now))
+	require.True(t, providerKeeper.PendingConsumerRemovalPropExists(ctx, prop.ChainId, now.Add(cooldown)))
+
+	res, err := providerKeeper.QueryConsumerChainPhase(sdk.WrapSDKContext(ctx.WithBlockTime(now.Add(time.Minute))),
+		&providertypes.QueryConsumerChainPhaseRequest{ChainId: prop.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerPhaseStopping, res.Phase)
+	require.Equal(t, cooldown-time.Minute, res.CooldownRemaining)
+
+	// no VSC packets are sent to a stopping consumer chain
+	providerKeeper.AppendPendingVSCPackets(ctx, prop.ChainId, ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	providerKeeper.SendVSCPackets(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, prop.ChainId), 1)
+
+	// the consumer chain is stopped once the cooldown elapsed
+	ctx = ctx.WithBlockTime(now.Add(cooldown))
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChain(ctx, &mocks)...)
+	providerKeeper.BeginBlockCCR(ctx)
+	require.Equal(t, providertypes.ConsumerPhaseStopped, providerKeeper.GetConsumerPhase(ctx, prop.ChainId))
+	_, found = providerKeeper.GetConsumerClientId(ctx, prop.ChainId)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllPendingConsumerRemovalProps(ctx))
+}
+
 func TestHandleEquivocationProposal(t *testing.T) {
 	equivocations := []*evidencetypes.Equivocation{
 		{
@@ -1090,3 +2557,138 @@ func TestHandleEquivocationProposal(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+// TestHandleResetConsumerClientProposal tests that a reset consumer client proposal recovers
+// the client of the consumer chain via a substitute client, while preserving the client ID
+// and the state of the consumer chain.
+func TestHandleResetConsumerClientProposal(t *testing.T) {
+	trustedHeight := clienttypes.NewHeight(1, 1000)
+	trustedConsensusState := ibctmtypes.NewConsensusState(time.Now(),
+		commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32))
+	prop := providertypes.NewResetConsumerClientProposal("title", "description", "chainID",
+		trustedHeight, trustedConsensusState).(*providertypes.ResetConsumerClientProposal)
+	frozenClientState := &ibctmtypes.ClientState{
+		ChainId:         "chainID",
+		UnbondingPeriod: time.Hour,
+		TrustingPeriod:  time.Minute,
+		MaxClockDrift:   time.Second,
+		LatestHeight:    clienttypes.NewHeight(1, 500),
+		FrozenHeight:    clienttypes.NewHeight(0, 1),
+	}
+
+	testCases := []struct {
+		name    string
+		setup   func(sdk.Context, *providerkeeper.Keeper, testkeeper.MockedKeepers)
+		expPass bool
+	}{
+		{
+			"consumer chain without client",
+			func(ctx sdk.Context, k *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {},
+			false,
+		},
+		{
+			"client of consumer chain not found",
+			func(ctx sdk.Context, k *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+				k.SetConsumerClientId(ctx, "chainID", "clientID")
+				mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false).Times(1)
+			},
+			false,
+		},
+		{
+			"substitute client creation fails",
+			func(ctx sdk.Context, k *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+				k.SetConsumerClientId(ctx, "chainID", "clientID")
+				gomock.InOrder(
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(frozenClientState, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().CreateClient(ctx, gomock.Any(), trustedConsensusState).Return(
+						"", clienttypes.ErrInvalidConsensus).Times(1),
+				)
+			},
+			false,
+		},
+		{
+			"client recovery fails, e.g., the consumer client is active",
+			func(ctx sdk.Context, k *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+				k.SetConsumerClientId(ctx, "chainID", "clientID")
+				gomock.InOrder(
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(frozenClientState, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().CreateClient(ctx, gomock.Any(), trustedConsensusState).Return(
+						"clientID-substitute", nil).Times(1),
+					mocks.MockClientKeeper.EXPECT().ClientUpdateProposal(ctx, gomock.Any()).Return(
+						clienttypes.ErrInvalidUpdateClientProposal).Times(1),
+				)
+			},
+			false,
+		},
+		{
+			"client reset",
+			func(ctx sdk.Context, k *providerkeeper.Keeper, mocks testkeeper.MockedKeepers) {
+				k.SetConsumerClientId(ctx, "chainID", "clientID")
+				gomock.InOrder(
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(frozenClientState, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().CreateClient(ctx, gomock.Any(), trustedConsensusState).DoAndReturn(
+						func(_ sdk.Context, clientState *ibctmtypes.ClientState, _ *ibctmtypes.ConsensusState) (string, error) {
+							// the substitute client matches the consumer client apart from
+							// the latest height, the frozen height and the trusting period
+							require.Equal(t, "chainID", clientState.ChainId)
+							require.Equal(t, trustedHeight, clientState.LatestHeight)
+							require.True(t, clientState.FrozenHeight.IsZero())
+							require.Equal(t, time.Hour, clientState.UnbondingPeriod)
+							require.Equal(t, time.Second, clientState.MaxClockDrift)
+							require.Less(t, clientState.TrustingPeriod, time.Hour)
+							return "clientID-substitute", nil
+						}).Times(1),
+					mocks.MockClientKeeper.EXPECT().ClientUpdateProposal(ctx, &clienttypes.ClientUpdateProposal{
+						Title:              prop.Title,
+						Description:        prop.Description,
+						SubjectClientId:    "clientID",
+						SubstituteClientId: "clientID-substitute",
+					}).Return(nil).Times(1),
+				)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		// state of the consumer chain that must be preserved
+		providerKeeper.SetChainToChannel(ctx, "chainID", "channelID")
+		providerKeeper.SetInitChainHeight(ctx, "chainID", 10)
+		tc.setup(ctx, &providerKeeper, mocks)
+		prevClientID, prevFound := providerKeeper.GetConsumerClientId(ctx, "chainID")
+
+		err := providerKeeper.HandleResetConsumerClientProposal(ctx, prop)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events, tc.name)
+			event := events[len(events)-1]
+			require.Equal(t, ccvtypes.EventTypeResetConsumerClient, event.Type, tc.name)
+			attributes := map[string]string{}
+			for _, attr := range event.Attributes {
+				attributes[string(attr.Key)] = string(attr.Value)
+			}
+			require.Equal(t, "clientID", attributes[ccvtypes.AttributeClientID], tc.name)
+			require.Equal(t, "clientID-substitute", attributes[ccvtypes.AttributeSubstituteClientID], tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+
+		// the client ID of the consumer chain is never changed
+		clientID, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+		require.Equal(t, prevFound, found, tc.name)
+		require.Equal(t, prevClientID, clientID, tc.name)
+		channelID, found := providerKeeper.GetChainToChannel(ctx, "chainID")
+		require.True(t, found, tc.name)
+		require.Equal(t, "channelID", channelID, tc.name)
+		initChainHeight, found := providerKeeper.GetInitChainHeight(ctx, "chainID")
+		require.True(t, found, tc.name)
+		require.Equal(t, uint64(10), initChainHeight, tc.name)
+
+		ctrl.Finish()
+	}
+}
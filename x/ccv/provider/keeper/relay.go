@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // OnRecvVSCMaturedPacket handles a VSCMatured packet
@@ -23,17 +25,28 @@ func (k Keeper) OnRecvVSCMaturedPacket(
 	// check that the channel is established, panic if not
 	chainID, found := k.GetChannelToChain(ctx, packet.DestinationChannel)
 	if !found {
-		// VSCMatured packet was sent on a channel different than any of the established CCV channels;
-		// this should never happen
+		// VSCMatured packet was sent on a channel different than any of the established CCV channels,
+		// e.g., the channel of a consumer chain removed without closing the channel
 		k.Logger(ctx).Error("VSCMaturedPacket received on unknown channel",
 			"channelID", packet.DestinationChannel,
 		)
-		panic(fmt.Errorf("VSCMaturedPacket received on unknown channel %s", packet.DestinationChannel))
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorUnknownChannel)
 	}
 
 	if err := k.QueueThrottledVSCMaturedPacketData(ctx, chainID, packet.Sequence, data); err != nil {
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf(
-			"failed to queue VSCMatured packet data: %s", err.Error()))
+		k.Logger(ctx).Error("failed to queue VSCMatured packet data",
+			"error", err.Error(),
+			"chainID", chainID,
+		)
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorThrottleQueue)
+	}
+
+	// the first VSCMatured packet received shows that the consumer chain is running
+	if k.GetConsumerPhase(ctx, chainID) == providertypes.ConsumerPhaseChannelEstablished {
+		if err := k.TransitionConsumerPhase(ctx, chainID, providertypes.ConsumerPhaseActive); err != nil {
+			// this should never happen as ChannelEstablished -> Active is a legal transition
+			panic(fmt.Errorf("failed to activate consumer chain %s: %w", chainID, err))
+		}
 	}
 
 	k.Logger(ctx).Info("VSCMaturedPacket received and enqueued",
@@ -45,6 +58,71 @@ func (k Keeper) OnRecvVSCMaturedPacket(
 	return ack
 }
 
+// OnRecvGenesisAcceptedPacket handles a GenesisAccepted packet, i.e., the confirmation of
+// the genesis state the consumer chain started with. The hash of the accepted genesis state
+// is recorded and compared to the hash of the consumer genesis created by the provider.
+// A mismatch, e.g., due to a hand-edited genesis, is surfaced through an event.
+func (k Keeper) OnRecvGenesisAcceptedPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.GenesisAcceptedPacketData,
+) exported.Acknowledgement {
+	// check that the channel is established, panic if not
+	chainID, found := k.GetChannelToChain(ctx, packet.DestinationChannel)
+	if !found {
+		// GenesisAccepted packet was sent on a channel different than any of the established CCV channels,
+		// e.g., the channel of a consumer chain removed without closing the channel
+		k.Logger(ctx).Error("GenesisAcceptedPacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorUnknownChannel)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		k.Logger(ctx).Error("invalid GenesisAccepted packet",
+			"error", err.Error(),
+			"chainID", chainID,
+		)
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidPacketData)
+	}
+
+	gen, found := k.GetConsumerGenesis(ctx, chainID)
+	if !found {
+		// the consumer genesis is stored until the consumer chain is stopped; this should never happen
+		panic(fmt.Errorf("cannot find genesis for consumer chain %s", chainID))
+	}
+	expectedHash, err := gen.CanonicalHash()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the consumer genesis is assumed to be correctly serialized in SetConsumerGenesis.
+		panic(fmt.Errorf("failed to compute genesis hash of consumer chain %s: %w", chainID, err))
+	}
+
+	k.SetConsumerAcceptedGenesisHash(ctx, chainID, data.GenesisHash)
+
+	if !bytes.Equal(expectedHash, data.GenesisHash) {
+		k.Logger(ctx).Error("consumer chain started with a different genesis state",
+			"chainID", chainID,
+			"expected genesis hash", fmt.Sprintf("%X", expectedHash),
+			"accepted genesis hash", fmt.Sprintf("%X", data.GenesisHash),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeConsumerGenesisMismatch,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, chainID),
+				sdk.NewAttribute(ccv.AttributeExpectedGenesisHash, fmt.Sprintf("%X", expectedHash)),
+				sdk.NewAttribute(ccv.AttributeAcceptedGenesisHash, fmt.Sprintf("%X", data.GenesisHash)),
+			),
+		)
+	} else {
+		k.Logger(ctx).Info("GenesisAcceptedPacket received", "chainID", chainID)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	return ack
+}
+
 // HandleLeadingVSCMaturedPackets handles all VSCMatured packet data that has been queued this block,
 // but does not need to be throttled. The handled data is then removed from the queue.
 //
@@ -68,6 +146,56 @@ func (k Keeper) HandleLeadingVSCMaturedPackets(ctx sdk.Context) {
 // Note: This method should only panic for a system critical error like a
 // failed marshal/unmarshal, or persistence of critical data.
 func (k Keeper) HandleVSCMaturedPacket(ctx sdk.Context, chainID string, data ccv.VSCMaturedPacketData) {
+	// a VSC packet split into chunks only matures once all its chunks are acknowledged,
+	// i.e., once the consumer chain received the entire validator set change
+	if _, found := k.GetVscPendingChunkAcks(ctx, chainID, data.ValsetUpdateId); found {
+		k.SetVscMaturityDeferred(ctx, chainID, data.ValsetUpdateId)
+
+		k.Logger(ctx).Info("VSCMaturedPacket deferred until all the chunks of the VSC packet are acknowledged",
+			"chainID", chainID,
+			"vscID", data.ValsetUpdateId,
+		)
+		return
+	}
+
+	k.matureVSC(ctx, chainID, data)
+
+	k.Logger(ctx).Info("VSCMaturedPacket handled",
+		"chainID", chainID,
+		"vscID", data.ValsetUpdateId,
+	)
+}
+
+// ForceMatureVscPackets marks all the outstanding VSC packets of the given consumer chain as matured,
+// i.e., as if the VSCMatured packets were received from the consumer chain, and returns the IDs of the
+// released unbonding operations, i.e., the ones that are not waiting for any other consumer chain.
+//
+// Note that the unbonding operations are released without the consumer chain confirming that
+// the unbonding period elapsed on it, i.e., validators may escape slashing for infractions
+// committed on the consumer chain. It is only meant for consumer chains that halted permanently.
+func (k Keeper) ForceMatureVscPackets(ctx sdk.Context, chainID string) ([]uint64, error) {
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		return nil, sdkerrors.Wrap(providertypes.ErrUnknownConsumerChainId, chainID)
+	}
+
+	releasedIds := []uint64{}
+	for _, unbondingOpsIndex := range k.GetAllUnbondingOpIndexes(ctx, chainID) {
+		releasedIds = append(releasedIds,
+			k.matureVSC(ctx, chainID, ccv.VSCMaturedPacketData{ValsetUpdateId: unbondingOpsIndex.VscId})...)
+	}
+
+	k.Logger(ctx).Info("VSC packets force matured",
+		"chainID", chainID,
+		"released unbonding ops", len(releasedIds),
+	)
+
+	return releasedIds, nil
+}
+
+// matureVSC releases the unbonding operations waiting for the VSC packet with the given ID
+// to mature on the given consumer chain, and returns the IDs of the unbonding operations
+// that are not waiting for any other consumer chain, i.e., that can be completed
+func (k Keeper) matureVSC(ctx sdk.Context, chainID string, data ccv.VSCMaturedPacketData) []uint64 {
 	// iterate over the unbonding operations mapped to (chainID, data.ValsetUpdateId)
 	var maturedIds []uint64
 	for _, unbondingOp := range k.GetUnbondingOpsFromIndex(ctx, chainID, data.ValsetUpdateId) {
@@ -87,14 +215,21 @@ func (k Keeper) HandleVSCMaturedPacket(ctx sdk.Context, chainID string, data ccv
 
 	// remove the VSC timeout timestamp for this chainID and vscID
 	k.DeleteVscSendTimestamp(ctx, chainID, data.ValsetUpdateId)
+	k.DeleteVscMaturityTime(ctx, chainID, data.ValsetUpdateId)
+	// the chunks of a force matured VSC packet may not be acknowledged yet
+	k.DeleteVscPendingChunkAcks(ctx, chainID, data.ValsetUpdateId)
+	k.DeleteVscMaturityDeferred(ctx, chainID, data.ValsetUpdateId)
+
+	// the VSCMatured packets are received in order over the ordered CCV channel,
+	// but the VSC packets may have been force matured before, see ForceMatureVscPackets
+	if lastMaturedVscID, found := k.GetLastMaturedVscId(ctx, chainID); !found || data.ValsetUpdateId > lastMaturedVscID {
+		k.SetLastMaturedVscId(ctx, chainID, data.ValsetUpdateId)
+	}
 
 	// prune previous consumer validator address that are no longer needed
 	k.PruneKeyAssignments(ctx, chainID, data.ValsetUpdateId)
 
-	k.Logger(ctx).Info("VSCMaturedPacket handled",
-		"chainID", chainID,
-		"vscID", data.ValsetUpdateId,
-	)
+	return maturedIds
 }
 
 // CompleteMaturedUnbondingOps attempts to complete all matured unbonding operations
@@ -127,11 +262,48 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		}
 		return sdkerrors.Wrapf(providertypes.ErrUnknownConsumerChannelId, "recv ErrorAcknowledgement on unknown channel %s", packet.SourceChannel)
 	}
+
+	// only the VSC packets split into chunks are tracked until all their chunks are acknowledged
+	chainID, ok := k.GetChannelToChain(ctx, packet.SourceChannel)
+	if !ok || !k.HasVscPendingChunkAcks(ctx, chainID) {
+		return nil
+	}
+	var chunk ccv.ValidatorSetChangePacketChunkData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &chunk); err != nil {
+		// the acknowledged packet is a VSC packet that was not split into chunks
+		return nil
+	}
+	k.onVscChunkAcknowledged(ctx, chainID, chunk.Data.ValsetUpdateId)
 	return nil
 }
 
+// onVscChunkAcknowledged records the acknowledgement of a chunk of the VSC packet with the given ID.
+// Once all the chunks are acknowledged, the VSC packet matures if its VSCMatured packet was already received.
+func (k Keeper) onVscChunkAcknowledged(ctx sdk.Context, chainID string, vscID uint64) {
+	pending, found := k.GetVscPendingChunkAcks(ctx, chainID, vscID)
+	if !found {
+		return
+	}
+	if pending > 1 {
+		k.SetVscPendingChunkAcks(ctx, chainID, vscID, pending-1)
+		return
+	}
+	k.DeleteVscPendingChunkAcks(ctx, chainID, vscID)
+
+	if k.IsVscMaturityDeferred(ctx, chainID, vscID) {
+		k.DeleteVscMaturityDeferred(ctx, chainID, vscID)
+		k.matureVSC(ctx, chainID, ccv.VSCMaturedPacketData{ValsetUpdateId: vscID})
+
+		k.Logger(ctx).Info("deferred VSCMaturedPacket handled",
+			"chainID", chainID,
+			"vscID", vscID,
+		)
+	}
+}
+
 // OnTimeoutPacket aborts the transaction if no chain exists for the destination channel,
-// otherwise it stops the chain
+// otherwise it emits a VSC packet timeout event and stops the chain, i.e., the unresponsive
+// consumer chain is removed and the unbonding operations waiting on it are released
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	chainID, found := k.GetChannelToChain(ctx, packet.SourceChannel)
 	if !found {
@@ -143,6 +315,17 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 		)
 	}
 	k.Logger(ctx).Info("packet timeout, removing the consumer:", "chainID", chainID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeVSCPacketTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(ccv.AttributeChainID, chainID),
+			sdk.NewAttribute(ccv.AttributeChannelID, packet.SourceChannel),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+
 	// stop consumer chain and release unbondings
 	return k.StopConsumerChain(ctx, chainID, false)
 }
@@ -162,12 +345,79 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) {
 	k.SendVSCPackets(ctx)
 }
 
+// EndBlockCHT contains the EndBlock logic needed for tracking the heights of the consumer chains,
+// i.e., it records the latest height of every consumer client updated in this block,
+// such that the latest height of a consumer chain can be read without decoding its client state.
+// Only the client states of the consumer clients flagged via SetConsumerClientUpdated are decoded.
+func (k Keeper) EndBlockCHT(ctx sdk.Context) {
+	for _, clientID := range k.GetAllUpdatedConsumerClients(ctx) {
+		k.DeleteConsumerClientUpdated(ctx, clientID)
+
+		// the client may no longer be a consumer client, e.g., if the consumer chain was stopped
+		chainID, found := k.GetChainIDByClientID(ctx, clientID)
+		if !found {
+			continue
+		}
+		clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+		if !found {
+			// the dangling client mapping is reported by EndBlockReconcile
+			continue
+		}
+		latestHeight := clientState.GetLatestHeight()
+		k.OnConsumerClientUpdate(ctx, chainID,
+			clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()))
+	}
+}
+
+// SetConsumerClientUpdated flags that the given client was updated in the current block, i.e., via
+// a successful MsgUpdateClient or MsgUpgradeClient (see ClientMsgServer), if it is the client of a consumer chain
+func (k Keeper) SetConsumerClientUpdated(ctx sdk.Context, clientID string) {
+	if _, found := k.GetChainIDByClientID(ctx, clientID); !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.UpdatedConsumerClientKey(clientID), []byte{})
+}
+
+// GetAllUpdatedConsumerClients returns the IDs of the consumer clients updated in the current block
+func (k Keeper) GetAllUpdatedConsumerClients(ctx sdk.Context) (clientIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{providertypes.UpdatedConsumerClientBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		clientIDs = append(clientIDs, string(iterator.Key()[1:]))
+	}
+	return clientIDs
+}
+
+// DeleteConsumerClientUpdated deletes the flag recording that the given client was updated in the current block
+func (k Keeper) DeleteConsumerClientUpdated(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(providertypes.UpdatedConsumerClientKey(clientID))
+}
+
+// OnConsumerClientUpdate records the given height of a consumer chain seen via an update
+// of its client, if it is greater than the latest height seen so far.
+// Note that the latest seen height never decreases, e.g., when the consumer client is reset.
+func (k Keeper) OnConsumerClientUpdate(ctx sdk.Context, chainID string, height clienttypes.Height) {
+	if latestSeenHeight, found := k.GetConsumerLatestSeenHeight(ctx, chainID); found && !height.GT(latestSeenHeight) {
+		return
+	}
+	k.SetConsumerLatestSeenHeight(ctx, chainID, height)
+}
+
 // SendVSCPackets iterates over all registered consumers and sends pending
 // VSC packets to the chains with established CCV channels.
 // If the CCV channel is not established for a consumer chain,
 // the updates will remain queued until the channel is established
 func (k Keeper) SendVSCPackets(ctx sdk.Context) {
 	for _, chain := range k.GetAllConsumerChains(ctx) {
+		// the VSC packets of a stopping consumer chain remain queued until it is stopped,
+		// while its CCV channel is kept open, see StartConsumerChainCooldown
+		if k.GetConsumerPhase(ctx, chain.ChainId) == providertypes.ConsumerPhaseStopping {
+			continue
+		}
 		// check if CCV channel is established and send
 		if channelID, found := k.GetChainToChannel(ctx, chain.ChainId); found {
 			k.SendVSCPacketsToChain(ctx, chain.ChainId, channelID)
@@ -175,21 +425,52 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) {
 	}
 }
 
-// SendVSCPacketsToChain sends all queued VSC packets to the specified chain
+// SendVSCPacketsToChain sends all queued VSC packets to the specified chain.
+// The packets that cannot be sent remain queued and are retried with a bounded backoff,
+// see handleVSCSendFailure.
 func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, chainID, channelID string) {
+	// do not send while backing off after previous send failures
+	if retryHeight, found := k.GetVscSendRetryHeight(ctx, chainID); found && uint64(ctx.BlockHeight()) < retryHeight {
+		return
+	}
+
 	pendingPackets := k.GetPendingVSCPackets(ctx, chainID)
-	for _, data := range pendingPackets {
-		// send packet over IBC
-		err := ccv.SendIBCPacket(
-			ctx,
-			k.scopedKeeper,
-			k.channelKeeper,
-			channelID,          // source channel id
-			ccv.ProviderPortID, // source port id
-			data.GetBytes(),
-			k.GetCCVTimeoutPeriod(ctx),
-		)
+	for i, data := range pendingPackets {
+		// split large validator set changes into chunks that fit into IBC packets,
+		// unless the CCV channel was negotiated with a version that does not support chunks;
+		// over such channels, the VSC packet data must be sent unchanged
+		packets := [][]byte{data.GetBytes()}
+		if len(data.ValidatorUpdates) > ccv.MaxValidatorUpdatesPerVSCPacket && k.supportsVSCChunks(ctx, channelID) {
+			packets = [][]byte{}
+			for _, chunk := range data.Split(ccv.MaxValidatorUpdatesPerVSCPacket) {
+				packets = append(packets, chunk.GetBytes())
+			}
+		}
+
+		// send the packets over IBC in a cached context,
+		// such that a failed send does not leave any partial state behind
+		cachedCtx, writeFn := ctx.CacheContext()
+		var err error
+		for _, packet := range packets {
+			err = ccv.SendIBCPacket(
+				cachedCtx,
+				k.scopedKeeper,
+				k.channelKeeper,
+				channelID,          // source channel id
+				ccv.ProviderPortID, // source port id
+				packet,
+				k.GetVscPacketTimeoutPeriod(ctx, chainID),
+			)
+			if err != nil {
+				break
+			}
+		}
 		if err != nil {
+			// leave the packets that were not sent stored to be sent later;
+			// the packets before index i were sent successfully
+			k.DeletePendingVSCPackets(ctx, chainID)
+			k.AppendPendingVSCPackets(ctx, chainID, pendingPackets[i:]...)
+
 			if clienttypes.ErrClientNotActive.Is(err) {
 				// IBC client is expired!
 				// leave the packet data stored to be sent once the client is upgraded
@@ -197,16 +478,86 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, chainID, channelID string
 				k.Logger(ctx).Debug("IBC client is expired, cannot send VSC, leaving packet data stored:", "chainID", chainID, "vscid", data.ValsetUpdateId)
 				return
 			}
-			// TODO do not panic if the send fails
-			// https://github.com/cosmos/interchain-security/issues/649
-			panic(fmt.Errorf("packet could not be sent over IBC: %w", err))
+			k.handleVSCSendFailure(ctx, chainID, channelID, data.ValsetUpdateId, err)
+			return
 		}
+		// The cached context is created with a new EventManager so we merge the event
+		// into the original context
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		// write cache
+		writeFn()
+
 		// set the VSC send timestamp for this packet;
 		// note that the VSC send timestamp are set when the packets
 		// are actually sent over IBC
 		k.SetVscSendTimestamp(ctx, chainID, data.ValsetUpdateId, ctx.BlockTime())
+		k.SetVscMaturityTime(ctx, chainID, data.ValsetUpdateId, ctx.BlockTime().Add(k.GetVscUnbondingPeriod(ctx, chainID)))
+		if len(packets) > 1 {
+			k.SetVscPendingChunkAcks(ctx, chainID, data.ValsetUpdateId, uint64(len(packets)))
+		}
 	}
 	k.DeletePendingVSCPackets(ctx, chainID)
+
+	// all pending packets were sent, reset the backoff
+	k.DeleteVscSendFailures(ctx, chainID)
+	k.DeleteVscSendRetryHeight(ctx, chainID)
+}
+
+// supportsVSCChunks returns true if VSC packets can be split into chunks over the given CCV channel,
+// i.e., if the channel was not negotiated with a version preceding the chunking of VSC packets
+func (k Keeper) supportsVSCChunks(ctx sdk.Context, channelID string) bool {
+	version, found := k.GetConsumerChannelVersion(ctx, channelID)
+	return found && version != ccv.Version2 && version != ccv.Version1
+}
+
+// handleVSCSendFailure records a failure to send the pending VSC packets to the specified chain
+// and defers the next attempt by a backoff that doubles with every consecutive failure,
+// up to the MaxVscSendBackoffBlocks param. Once the number of consecutive failures reaches
+// VscSendFailuresEventThreshold, i.e., the channel appears to be persistently broken,
+// an event is emitted on every failure.
+func (k Keeper) handleVSCSendFailure(ctx sdk.Context, chainID, channelID string, vscID uint64, err error) {
+	failures := k.GetVscSendFailures(ctx, chainID) + 1
+	k.SetVscSendFailures(ctx, chainID, failures)
+
+	retryHeight := uint64(ctx.BlockHeight()) + vscSendBackoff(failures, k.GetMaxVscSendBackoffBlocks(ctx))
+	k.SetVscSendRetryHeight(ctx, chainID, retryHeight)
+
+	k.Logger(ctx).Error("cannot send VSC, leaving packet data stored to retry:",
+		"chainID", chainID,
+		"channelID", channelID,
+		"vscID", vscID,
+		"consecutive failures", failures,
+		"retry height", retryHeight,
+		"error", err,
+	)
+
+	if failures >= providertypes.VscSendFailuresEventThreshold {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeVSCSendFailure,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, chainID),
+				sdk.NewAttribute(ccv.AttributeChannelID, channelID),
+				sdk.NewAttribute(ccv.AttributeConsecutiveFailures, strconv.FormatUint(failures, 10)),
+				sdk.NewAttribute(ccv.AttributeRetryHeight, strconv.FormatUint(retryHeight, 10)),
+				sdk.NewAttribute(ccv.AttributeError, err.Error()),
+			),
+		)
+	}
+}
+
+// vscSendBackoff returns the number of blocks to wait before retrying to send
+// the pending VSC packets after the given number of consecutive failures,
+// i.e., 2^(failures-1) blocks bounded by maxBackoff.
+func vscSendBackoff(failures uint64, maxBackoff int64) uint64 {
+	backoff := uint64(1)
+	for i := uint64(1); i < failures && backoff < uint64(maxBackoff); i++ {
+		backoff *= 2
+	}
+	if backoff > uint64(maxBackoff) {
+		backoff = uint64(maxBackoff)
+	}
+	return backoff
 }
 
 // QueueVSCPackets queues latest validator updates for every registered consumer chain
@@ -218,8 +569,26 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) {
 	valUpdates := k.stakingKeeper.GetValidatorUpdates(ctx)
 
 	for _, chain := range k.GetAllConsumerChains(ctx) {
+		// The validator updates are computed in a cached context, so that a consumer chain
+		// for which they cannot be computed is left untouched before being stopped.
+		cachedCtx, writeCache := ctx.CacheContext()
+		chainValUpdates, err := k.computeConsumerValUpdates(cachedCtx, chain.ChainId, valUpdates)
+		if err != nil {
+			// Note: an invalid power would result in the consumer chain rejecting the updates,
+			// thus, stop the consumer chain instead of sending corrupt updates to it.
+			k.Logger(ctx).Error("cannot compute the validator updates for consumer chain; stopping it",
+				"chainID", chain.ChainId,
+				"error", err,
+			)
+			if err := k.StopConsumerChain(ctx, chain.ChainId, true); err != nil {
+				panic(fmt.Errorf("consumer chain failed to stop: %w", err))
+			}
+			continue
+		}
+		writeCache()
+
 		// Apply the key assignment to the validator updates.
-		valUpdates := k.MustApplyKeyAssignmentToValUpdates(ctx, chain.ChainId, valUpdates)
+		valUpdates := k.MustApplyKeyAssignmentToValUpdates(ctx, chain.ChainId, chainValUpdates)
 
 		// check whether there are changes in the validator set;
 		// note that this also entails unbonding operations
@@ -241,6 +610,42 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) {
 	k.IncrementValidatorSetUpdateId(ctx)
 }
 
+// computeConsumerValUpdates returns the validator updates (with provider keys) to send to the given
+// consumer chain from the given validator updates of the provider chain, i.e., restricted to the top N
+// validators and with the power reduction and the power multiplier of the consumer chain applied.
+// An error is returned if the resulting powers are invalid.
+func (k Keeper) computeConsumerValUpdates(
+	ctx sdk.Context,
+	chainID string,
+	valUpdates []abci.ValidatorUpdate,
+) ([]abci.ValidatorUpdate, error) {
+	chainValUpdates := valUpdates
+	// Top N consumer chains only receive the changes to the top N bonded validators by power,
+	// while consumer chains requiring validator approval only receive the changes to approved validators.
+	// Consumer chains with a custom power reduction receive the changes to the powers recomputed from
+	// the tokens, which are compared with the stored validator set every block.
+	if topN, found := k.GetTrackedValSetTopN(ctx, chainID); found {
+		var err error
+		chainValUpdates, err = k.ComputeConsumerValSetChanges(ctx, chainID, topN)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the validator set changes: %w", err)
+		}
+	}
+
+	if powerMultiplier, found := k.GetConsumerPowerMultiplier(ctx, chainID); found {
+		var err error
+		chainValUpdates, err = ApplyConsumerPowerMultiplier(chainValUpdates, powerMultiplier)
+		if err != nil {
+			return nil, fmt.Errorf("cannot apply the power multiplier: %w", err)
+		}
+	}
+
+	if err := ccv.ValidateValidatorUpdatesPower(chainValUpdates, false); err != nil {
+		return nil, err
+	}
+	return chainValUpdates, nil
+}
+
 // EndBlockCIS contains the EndBlock logic needed for
 // the Consumer Initiated Slashing sub-protocol
 func (k Keeper) EndBlockCIS(ctx sdk.Context) {
@@ -248,7 +653,12 @@ func (k Keeper) EndBlockCIS(ctx sdk.Context) {
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	valUpdateID := k.GetValidatorSetUpdateId(ctx)
 	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
+	// the time of the current block is a lower bound on the time of any
+	// infraction that references this vscID on the consumer chain
+	k.SetValsetUpdateBlockTime(ctx, valUpdateID, ctx.BlockTime())
 	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	// the block times are only needed within the unbonding window
+	k.PruneValsetUpdateBlockTimes(ctx)
 
 	// Replenish slash meter if necessary, BEFORE executing slash packet throttling logic.
 	// This ensures the meter value is replenished, and not greater than the allowance (max value)
@@ -286,12 +696,12 @@ func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, d
 	// check that the channel is established, panic if not
 	chainID, found := k.GetChannelToChain(ctx, packet.DestinationChannel)
 	if !found {
-		// SlashPacket packet was sent on a channel different than any of the established CCV channels;
-		// this should never happen
+		// SlashPacket packet was sent on a channel different than any of the established CCV channels,
+		// e.g., the channel of a consumer chain removed without closing the channel
 		k.Logger(ctx).Error("SlashPacket received on unknown channel",
 			"channelID", packet.DestinationChannel,
 		)
-		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorUnknownChannel)
 	}
 
 	if err := k.ValidateSlashPacket(ctx, chainID, packet, data); err != nil {
@@ -302,7 +712,10 @@ func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, d
 			"vscID", data.ValsetUpdateId,
 			"infractionType", data.Infraction,
 		)
-		return channeltypes.NewErrorAcknowledgement(err)
+		if providertypes.ErrUnknownInfractionHeight.Is(err) {
+			return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorStaleInfraction)
+		}
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidInfraction)
 	}
 
 	// The slash packet validator address may be known only on the consumer chain,
@@ -338,7 +751,11 @@ func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, packet channeltypes.Packet, d
 	// Queue slash packet data in the same (consumer chain specific) queue as vsc matured packet data,
 	// to enforce order of handling between the two packet data types.
 	if err := k.QueueThrottledSlashPacketData(ctx, chainID, packet.Sequence, data); err != nil {
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to queue slash packet data: %s", err.Error()))
+		k.Logger(ctx).Error("failed to queue slash packet data",
+			"error", err.Error(),
+			"chainID", chainID,
+		)
+		return ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorThrottleQueue)
 	}
 
 	k.Logger(ctx).Info("slash packet received and enqueued",
@@ -361,12 +778,12 @@ func (k Keeper) ValidateSlashPacket(ctx sdk.Context, chainID string,
 	_, found := k.getMappedInfractionHeight(ctx, chainID, data.ValsetUpdateId)
 	// return error if we cannot find infraction height matching the validator update id
 	if !found {
-		return fmt.Errorf("cannot find infraction height matching "+
+		return sdkerrors.Wrapf(providertypes.ErrUnknownInfractionHeight, "cannot find infraction height matching "+
 			"the validator update id %d for chain %s", data.ValsetUpdateId, chainID)
 	}
 
 	if data.Infraction != stakingtypes.DoubleSign && data.Infraction != stakingtypes.Downtime {
-		return fmt.Errorf("invalid infraction type: %s", data.Infraction)
+		return sdkerrors.Wrapf(providertypes.ErrInvalidInfractionType, "%s", data.Infraction)
 	}
 
 	return nil
@@ -421,15 +838,56 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 	// Note: the SlashPacket is for downtime infraction, as SlashPackets
 	// for double-signing infractions are already dropped when received
 
+	// drop packets for infractions that are older than the unbonding period,
+	// i.e., the stake that was bonded at the infraction height could have already unbonded
+	if !k.WithinUnbondingWindow(ctx, data.ValsetUpdateId) {
+		k.Logger(ctx).Info(
+			"slash packet dropped because infraction is outside the unbonding window",
+			"chainID", chainID,
+			"provider cons addr", providerConsAddr.String(),
+			"vscID", data.ValsetUpdateId,
+			"infraction height", infractionHeight,
+		)
+		// the slash ack is still appended so that the consumer chain
+		// can clear the outstanding downtime flag of the validator
+		k.AppendSlashAck(ctx, chainID, consumerConsAddr.String())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeDropStaleSlashPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, chainID),
+				sdk.NewAttribute(ccv.AttributeValidatorAddress, providerConsAddr.String()),
+				sdk.NewAttribute(ccv.AttributeInfractionHeight, strconv.Itoa(int(infractionHeight))),
+				sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
+			),
+		)
+		return
+	}
+
 	// append the validator address to the slash ack for its chain id
 	// TODO: consumer cons address should be accepted here
 	k.AppendSlashAck(ctx, chainID, consumerConsAddr.String())
 
-	// jail validator
+	// slash and jail validator, unless already jailed, e.g., for a downtime infraction on another
+	// consumer chain, such that a single downtime episode is only slashed once;
+	// the validator is only slashed if the consumer chain opted into slashing for downtime
 	if !validator.IsJailed() {
+		if k.IsSlashEnabled(ctx, chainID) {
+			k.stakingKeeper.Slash(
+				ctx,
+				providerConsAddr.ToSdkConsAddr(),
+				int64(infractionHeight),
+				data.Validator.Power,
+				k.slashingKeeper.SlashFractionDowntime(ctx),
+				stakingtypes.Downtime,
+			)
+			k.Logger(ctx).Info("validator slashed", "provider cons addr", providerConsAddr.String())
+		}
 		k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr())
+		k.SetJailedByConsumer(ctx, chainID, providerConsAddr)
 		k.Logger(ctx).Info("validator jailed", "provider cons addr", providerConsAddr.String())
-		jailTime := ctx.BlockTime().Add(k.slashingKeeper.DowntimeJailDuration(ctx))
+		jailTime := ctx.BlockTime().Add(k.GetEffectiveConsumerDowntimeJailDuration(ctx, chainID))
 		k.slashingKeeper.JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), jailTime)
 	}
 
@@ -499,6 +957,32 @@ func (k Keeper) EndBlockCCR(ctx sdk.Context) {
 	}
 }
 
+// WithinUnbondingWindow returns whether an infraction that references the given vscID
+// happened within the unbonding period, i.e., less than UnbondingTime before the current block time.
+// An infraction exactly UnbondingTime old is outside the window, as unbonding operations that
+// started at the infraction time have already matured.
+//
+// Note that the vscIDs without a mapped block time are handled as follows:
+//   - the vscID 0 of the initial validator sets of the consumer chains is within the unbonding window;
+//   - the vscIDs lower than the earliest mapped one, i.e., either pruned (see PruneValsetUpdateBlockTimes)
+//     or mapped to block heights before the block times were recorded, are within the unbonding window
+//     iff the earliest mapped block time, an upper bound on their block times, is within the unbonding window;
+//   - any other vscID is within the unbonding window, e.g., if no block time is mapped yet.
+func (k Keeper) WithinUnbondingWindow(ctx sdk.Context, valsetUpdateID uint64) bool {
+	if valsetUpdateID == 0 {
+		return true
+	}
+	infractionTime, found := k.GetValsetUpdateBlockTime(ctx, valsetUpdateID)
+	if !found {
+		var earliestID uint64
+		earliestID, infractionTime, found = k.getEarliestValsetUpdateBlockTime(ctx)
+		if !found || valsetUpdateID > earliestID {
+			return true
+		}
+	}
+	return infractionTime.Add(k.stakingKeeper.UnbondingTime(ctx)).After(ctx.BlockTime())
+}
+
 // getMappedInfractionHeight gets the infraction height mapped from val set ID for the given chain ID
 func (k Keeper) getMappedInfractionHeight(ctx sdk.Context,
 	chainID string, valsetUpdateID uint64,
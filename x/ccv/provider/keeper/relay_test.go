@@ -1,23 +1,31 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	exported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ibcsimapp "github.com/cosmos/interchain-security/legacy_ibc_testing/simapp"
 	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/golang/mock/gomock"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -89,6 +97,393 @@ func TestQueueVSCPackets(t *testing.T) {
 	}
 }
 
+// TestQueueVSCPacketsStopsConsumerWithInvalidPowers tests that a consumer chain for which the
+// validator updates result in invalid powers is stopped, while the other consumer chains
+// receive the validator updates
+func TestQueueVSCPacketsStopsConsumerWithInvalidPowers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "clientID-1")
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "clientID-2")
+	// the scaled power of the validator exceeds the maximum power on chain-2
+	providerKeeper.SetConsumerPowerMultiplier(ctx, "chain-2", sdk.NewDec(3))
+
+	valUpdates := []abci.ValidatorUpdate{
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(0).TMProtoCryptoPublicKey(), Power: tmtypes.MaxTotalVotingPower / 2},
+	}
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return(valUpdates).Times(1)
+
+	require.NotPanics(t, func() { providerKeeper.QueueVSCPackets(ctx) })
+
+	pending := providerKeeper.GetPendingVSCPackets(ctx, "chain-1")
+	require.Len(t, pending, 1)
+	require.Equal(t, valUpdates, pending[0].ValidatorUpdates)
+
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chain-2")
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain-2"))
+}
+
+// TestSendVSCPacketsToChainWithBackoff tests that the VSC packets that cannot be sent
+// remain queued and that sending them is retried with a bounded backoff.
+func TestSendVSCPacketsToChainWithBackoff(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxVscSendBackoffBlocks = 4
+	providerKeeper.SetParams(ctx, params)
+
+	chainID := "consumer"
+	channelID := "channelID"
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1},
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2},
+	)
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).AnyTimes()
+	expectChannelNotFound := func() *gomock.Call {
+		return mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+			channeltypes.Channel{}, false,
+		).Times(1)
+	}
+	expectSendPacket := func() []*gomock.Call {
+		return []*gomock.Call{
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				channeltypes.Channel{State: channeltypes.OPEN}, true,
+			).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(),
+				host.ChannelCapabilityPath(ccv.ProviderPortID, channelID),
+			).Return(&capabilitytypes.Capability{}, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				uint64(1), true,
+			).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+		}
+	}
+	hasFailureEvent := func(ctx sdk.Context) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == ccv.EventTypeVSCSendFailure {
+				return true
+			}
+		}
+		return false
+	}
+
+	// every failure doubles the backoff, up to MaxVscSendBackoffBlocks
+	height := int64(10)
+	for i, expectedBackoff := range []int64{1, 2, 4, 4, 4, 4} {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		expectChannelNotFound()
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+
+		failures := uint64(i + 1)
+		require.Equal(t, failures, providerKeeper.GetVscSendFailures(ctx, chainID))
+		retryHeight, found := providerKeeper.GetVscSendRetryHeight(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, uint64(height+expectedBackoff), retryHeight)
+		require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 2)
+		require.Equal(t, failures >= providertypes.VscSendFailuresEventThreshold, hasFailureEvent(ctx))
+
+		// no send is attempted before the retry height
+		ctx = ctx.WithBlockHeight(height + expectedBackoff - 1)
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+
+		height += expectedBackoff
+	}
+
+	// the first packet is sent, while the second one remains queued
+	ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	gomock.InOrder(append(expectSendPacket(), expectChannelNotFound())...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+
+	pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(2), pending[0].ValsetUpdateId)
+	_, found := providerKeeper.GetVscSendTimestamp(ctx, chainID, 1)
+	require.True(t, found)
+	_, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 2)
+	require.False(t, found)
+	require.Equal(t, uint64(7), providerKeeper.GetVscSendFailures(ctx, chainID))
+
+	// once all packets are sent, the backoff is reset
+	height += 4
+	ctx = ctx.WithBlockHeight(height)
+	gomock.InOrder(expectSendPacket()...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+	_, found = providerKeeper.GetVscSendTimestamp(ctx, chainID, 2)
+	require.True(t, found)
+	require.Zero(t, providerKeeper.GetVscSendFailures(ctx, chainID))
+	_, found = providerKeeper.GetVscSendRetryHeight(ctx, chainID)
+	require.False(t, found)
+}
+
+// TestSendVSCPacketsToChainTimeoutPeriod tests that the VSC packets sent to a consumer chain
+// time out after the VSC packet timeout period of the chain, or after the ccv timeout period if not set.
+func TestSendVSCPacketsToChainTimeoutPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	channelID := "channelID"
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).AnyTimes()
+	expectSendPacket := func(expectedTimeout time.Duration) []*gomock.Call {
+		return []*gomock.Call{
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				channeltypes.Channel{State: channeltypes.OPEN}, true,
+			).Times(1),
+			mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(),
+				host.ChannelCapabilityPath(ccv.ProviderPortID, channelID),
+			).Return(&capabilitytypes.Capability{}, true).Times(1),
+			mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+				uint64(1), true,
+			).Times(1),
+			mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+					require.Equal(t, uint64(ctx.BlockTime().Add(expectedTimeout).UnixNano()), packet.GetTimeoutTimestamp())
+					return nil
+				},
+			).Times(1),
+		}
+	}
+
+	// the ccv timeout period of the provider params is used by default
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	gomock.InOrder(expectSendPacket(providerKeeper.GetCCVTimeoutPeriod(ctx))...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	require.Equal(t, providerKeeper.GetCCVTimeoutPeriod(ctx), providerKeeper.GetVscPacketTimeoutPeriod(ctx, chainID))
+
+	// the VSC packet timeout period of the consumer chain is used if set
+	providerKeeper.SetConsumerVscPacketTimeoutPeriod(ctx, chainID, time.Hour)
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2})
+	gomock.InOrder(expectSendPacket(time.Hour)...)
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	require.Equal(t, time.Hour, providerKeeper.GetVscPacketTimeoutPeriod(ctx, chainID))
+
+	providerKeeper.DeleteConsumerVscPacketTimeoutPeriod(ctx, chainID)
+	_, found := providerKeeper.GetConsumerVscPacketTimeoutPeriod(ctx, chainID)
+	require.False(t, found)
+}
+
+// TestSendVSCPacketsToChainChunks tests that large validator set changes are split into chunks
+// with the same VSC ID, and that the VSC packet only matures once all its chunks are acknowledged
+func TestSendVSCPacketsToChainChunks(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "consumer"
+	channelID := "channelID"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).AnyTimes()
+
+	// the validator set change requires three chunks
+	ids := cryptotestutil.GenMultipleCryptoIds(2*ccv.MaxValidatorUpdatesPerVSCPacket+1, 0)
+	updates := []abci.ValidatorUpdate{}
+	for _, id := range ids {
+		updates = append(updates, abci.ValidatorUpdate{PubKey: id.TMProtoCryptoPublicKey(), Power: 1})
+	}
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.NewValidatorSetChangePacketData(updates, 1, []string{"slashAck"}))
+
+	packets := []channeltypes.Packet{}
+	// the channel version is checked once before splitting the validator set change
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+		channeltypes.Channel{State: channeltypes.OPEN, Version: handshakeMetadataVersion(t, ccv.Version)}, true,
+	).Times(4)
+	mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(),
+		host.ChannelCapabilityPath(ccv.ProviderPortID, channelID),
+	).Return(&capabilitytypes.Capability{}, true).Times(3)
+	mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).DoAndReturn(
+		func(sdk.Context, string, string) (uint64, bool) {
+			return uint64(len(packets) + 1), true
+		},
+	).Times(3)
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+			packets = append(packets, packet.(channeltypes.Packet))
+			return nil
+		},
+	).Times(3)
+
+	providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+	require.Len(t, packets, 3)
+
+	sent := []abci.ValidatorUpdate{}
+	for i, packet := range packets {
+		var chunk ccv.ValidatorSetChangePacketChunkData
+		require.NoError(t, ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &chunk))
+		require.Equal(t, uint64(1), chunk.Data.ValsetUpdateId)
+		require.Equal(t, uint64(i), chunk.ChunkIndex)
+		require.Equal(t, uint64(3), chunk.ChunkTotal)
+		if i == 0 {
+			require.Equal(t, []string{"slashAck"}, chunk.Data.SlashAcks)
+		} else {
+			require.Empty(t, chunk.Data.SlashAcks)
+		}
+		sent = append(sent, chunk.Data.ValidatorUpdates...)
+	}
+	require.Equal(t, updates, sent)
+	pending, found := providerKeeper.GetVscPendingChunkAcks(ctx, chainID, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(3), pending)
+
+	// the VSCMatured packet is received before all the chunks are acknowledged
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{chainID}})
+	providerKeeper.SetUnbondingOpIndex(ctx, chainID, 1, []uint64{1})
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packets[0], ack))
+	providerKeeper.HandleVSCMaturedPacket(ctx, chainID, ccv.VSCMaturedPacketData{ValsetUpdateId: 1})
+	require.True(t, providerKeeper.IsVscMaturityDeferred(ctx, chainID, 1))
+	require.Empty(t, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+	_, found = providerKeeper.GetLastMaturedVscId(ctx, chainID)
+	require.False(t, found)
+
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packets[1], ack))
+	require.Empty(t, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+
+	// the VSC packet matures once its last chunk is acknowledged
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packets[2], ack))
+	require.Equal(t, []uint64{1}, providerKeeper.ConsumeMaturedUnbondingOps(ctx))
+	lastMaturedVscID, found := providerKeeper.GetLastMaturedVscId(ctx, chainID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), lastMaturedVscID)
+	_, found = providerKeeper.GetVscPendingChunkAcks(ctx, chainID, 1)
+	require.False(t, found)
+	require.False(t, providerKeeper.IsVscMaturityDeferred(ctx, chainID, 1))
+}
+
+// TestSendVSCPacketsToChainNoChunks tests that large validator set changes are not split into chunks
+// over CCV channels negotiated with a version preceding the chunking of VSC packets, and that the
+// VSC packets sent over such channels can be decoded by consumer chains running an earlier version
+func TestSendVSCPacketsToChainNoChunks(t *testing.T) {
+	for _, version := range []string{ccv.Version2, ccv.Version1} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+		chainID := "consumer"
+		channelID := "channelID"
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		providerKeeper.SetChannelToChain(ctx, channelID, chainID)
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour).AnyTimes()
+
+		ids := cryptotestutil.GenMultipleCryptoIds(ccv.MaxValidatorUpdatesPerVSCPacket+1, 0)
+		updates := []abci.ValidatorUpdate{}
+		for _, id := range ids {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: id.TMProtoCryptoPublicKey(), Power: 1})
+		}
+		providerKeeper.AppendPendingVSCPackets(ctx, chainID, ccv.NewValidatorSetChangePacketData(updates, 1, nil))
+
+		packets := []channeltypes.Packet{}
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+			channeltypes.Channel{State: channeltypes.OPEN, Version: handshakeMetadataVersion(t, version)}, true,
+		).Times(2)
+		mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(),
+			host.ChannelCapabilityPath(ccv.ProviderPortID, channelID),
+		).Return(&capabilitytypes.Capability{}, true).Times(1)
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), ccv.ProviderPortID, channelID).Return(
+			uint64(1), true).Times(1)
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI) error {
+				packets = append(packets, packet.(channeltypes.Packet))
+				return nil
+			},
+		).Times(1)
+
+		providerKeeper.SendVSCPacketsToChain(ctx, chainID, channelID)
+		require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, chainID))
+		require.Len(t, packets, 1)
+		var data ccv.ValidatorSetChangePacketData
+		require.NoError(t, ccv.ModuleCdc.UnmarshalJSON(packets[0].GetData(), &data))
+		require.Equal(t, updates, data.ValidatorUpdates)
+
+		// consumer chains running an earlier version reject unknown fields
+		var legacy struct {
+			ValidatorUpdates []json.RawMessage `json:"validator_updates"`
+			ValsetUpdateId   string            `json:"valset_update_id"`
+			SlashAcks        []string          `json:"slash_acks"`
+		}
+		dec := json.NewDecoder(bytes.NewReader(packets[0].GetData()))
+		dec.DisallowUnknownFields()
+		require.NoError(t, dec.Decode(&legacy), version)
+		require.Len(t, legacy.ValidatorUpdates, len(updates))
+		require.Equal(t, "1", legacy.ValsetUpdateId)
+		_, found := providerKeeper.GetVscPendingChunkAcks(ctx, chainID, 1)
+		require.False(t, found)
+
+		ctrl.Finish()
+	}
+}
+
+// handshakeMetadataVersion returns the channel version of the provider end of a CCV channel
+// negotiated with the given CCV version
+func handshakeMetadataVersion(t *testing.T, version string) string {
+	t.Helper()
+	md := providertypes.HandshakeMetadata{ProviderFeePoolAddr: "feePoolAddr", Version: version}
+	bz, err := (&md).Marshal()
+	require.NoError(t, err)
+	return string(bz)
+}
+
+// TestOnTimeoutPacket tests that a timed out VSC packet removes the consumer chain,
+// emits a VSC packet timeout event, and releases the unbonding operations waiting on the chain.
+func TestOnTimeoutPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// a packet timing out on an unknown channel aborts the transaction
+	err := providerKeeper.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "unknown"})
+	require.Error(t, err)
+
+	// the channel is not closed when the consumer chain is removed after a timeout
+	expectations := testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))
+	expectations = append(expectations, testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "chainID")...)
+	gomock.InOrder(expectations...)
+	err = providerKeeper.CreateConsumerClient(ctx, testkeeper.GetTestConsumerAdditionProp())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerChain(ctx, "channelID")
+	require.NoError(t, err)
+
+	providerKeeper.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"chainID"}})
+	providerKeeper.SetUnbondingOpIndex(ctx, "chainID", 1, []uint64{1})
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.OnTimeoutPacket(ctx, channeltypes.Packet{Sequence: 3, SourceChannel: "channelID"})
+	require.NoError(t, err)
+
+	// the consumer chain is removed
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelToChain(ctx, "channelID")
+	require.False(t, found)
+
+	// the unbonding operation waiting on the consumer chain is released
+	require.Equal(t, []uint64{1}, providerKeeper.GetMaturedUnbondingOps(ctx))
+	require.Empty(t, providerKeeper.GetAllUnbondingOpIndexes(ctx, "chainID"))
+
+	var timeoutEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == ccv.EventTypeVSCPacketTimeout {
+			event := event
+			timeoutEvent = &event
+		}
+	}
+	require.NotNil(t, timeoutEvent)
+	attributes := map[string]string{}
+	for _, attr := range timeoutEvent.Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+	require.Equal(t, "chainID", attributes[ccv.AttributeChainID])
+	require.Equal(t, "channelID", attributes[ccv.AttributeChannelID])
+	require.Equal(t, "3", attributes[channeltypes.AttributeKeySequence])
+}
+
 // TestOnRecvVSCMaturedPacket tests the OnRecvVSCMaturedPacket method of the keeper.
 // Particularly the behavior that VSC matured packet data should be handled immediately
 // if the pending packet data queue is empty, and should be queued otherwise.
@@ -102,11 +497,19 @@ func TestOnRecvVSCMaturedPacket(t *testing.T) {
 	// Set channel to chain (faking multiple established channels)
 	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
 	providerKeeper.SetChannelToChain(ctx, "channel-2", "chain-2")
+	providerKeeper.SetConsumerPhase(ctx, "chain-1", providertypes.ConsumerPhaseChannelEstablished)
+
+	// a packet received on an unknown channel is rejected
+	ack := executeOnRecvVSCMaturedPacket(t, &providerKeeper, ctx, "channel-3", 1)
+	require.Equal(t, ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorUnknownChannel), ack)
 
 	// Execute on recv for chain-1
-	ack := executeOnRecvVSCMaturedPacket(t, &providerKeeper, ctx, "channel-1", 1)
+	ack = executeOnRecvVSCMaturedPacket(t, &providerKeeper, ctx, "channel-1", 1)
 	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
 
+	// Assert that chain-1 is active after the first VSCMatured packet
+	require.Equal(t, providertypes.ConsumerPhaseActive, providerKeeper.GetConsumerPhase(ctx, "chain-1"))
+
 	// Assert that the packet data was queued for chain-1
 	require.Equal(t, uint64(1), providerKeeper.GetThrottledPacketDataSize(ctx, "chain-1"))
 
@@ -137,6 +540,47 @@ func TestOnRecvVSCMaturedPacket(t *testing.T) {
 	require.Equal(t, uint64(0), providerKeeper.GetThrottledPacketDataSize(ctx, "chain-2"))
 }
 
+// TestOnRecvGenesisAcceptedPacket tests that the hash of the genesis accepted by a consumer chain
+// is recorded and that a mismatch with the consumer genesis created by the provider emits an event.
+func TestOnRecvGenesisAcceptedPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
+	gen := *consumertypes.DefaultGenesisState()
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "chain-1", gen))
+	expectedHash, err := gen.CanonicalHash()
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket([]byte{}, 1, ccv.ConsumerPortID, "channel-0", ccv.ProviderPortID, "channel-1",
+		clienttypes.NewHeight(1, 0), 0)
+
+	// an invalid genesis hash is rejected
+	ack := providerKeeper.OnRecvGenesisAcceptedPacket(ctx, packet, *ccv.NewGenesisAcceptedPacketData([]byte{0x01}))
+	require.Equal(t, ccv.NewCcvErrorAcknowledgement(ccv.CcvAckErrorInvalidPacketData), ack)
+	_, found := providerKeeper.GetConsumerAcceptedGenesisHash(ctx, "chain-1")
+	require.False(t, found)
+
+	// matching genesis hash
+	ack = providerKeeper.OnRecvGenesisAcceptedPacket(ctx, packet, *ccv.NewGenesisAcceptedPacketData(expectedHash))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
+	acceptedHash, found := providerKeeper.GetConsumerAcceptedGenesisHash(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, expectedHash, acceptedHash)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// mismatching genesis hash
+	otherHash := make([]byte, len(expectedHash))
+	ack = providerKeeper.OnRecvGenesisAcceptedPacket(ctx, packet, *ccv.NewGenesisAcceptedPacketData(otherHash))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), ack)
+	acceptedHash, found = providerKeeper.GetConsumerAcceptedGenesisHash(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, otherHash, acceptedHash)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeConsumerGenesisMismatch, events[0].Type)
+}
+
 func TestHandleLeadingVSCMaturedPackets(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -349,41 +793,49 @@ func TestValidateSlashPacket(t *testing.T) {
 		name       string
 		packetData ccv.SlashPacketData
 		expectErr  bool
+		expErr     error
 	}{
 		{
 			"no block height found for given vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 61},
 			true,
+			providertypes.ErrUnknownInfractionHeight,
 		},
 		{
 			"non-set infraction type",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID},
 			true,
+			providertypes.ErrInvalidInfractionType,
 		},
 		{
 			"invalid infraction type",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.MaxMonikerLength},
 			true,
+			providertypes.ErrInvalidInfractionType,
 		},
 		{
 			"valid double sign packet with non-zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.DoubleSign},
 			false,
+			nil,
 		},
 		{
 			"valid downtime packet with non-zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.Downtime},
 			false,
+			nil,
 		},
 		{
 			"valid double sign packet with zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 0, Infraction: stakingtypes.DoubleSign},
 			false,
+			nil,
 		},
 		{
 			"valid downtime packet with zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: 0, Infraction: stakingtypes.Downtime},
 			false,
+			nil,
 		},
 	}
 
@@ -406,7 +858,7 @@ func TestValidateSlashPacket(t *testing.T) {
 		// Test error behavior as specified in tc.
 		err := providerKeeper.ValidateSlashPacket(ctx, "consumer-chain-id", packet, tc.packetData)
 		if tc.expectErr {
-			require.Error(t, err, "expected error in case: '%s'", tc.name)
+			require.ErrorIs(t, err, tc.expErr, "expected error in case: '%s'", tc.name)
 		} else {
 			require.NoError(t, err, "unexpected error in case: '%s'", tc.name)
 		}
@@ -427,6 +879,8 @@ func TestHandleSlashPacket(t *testing.T) {
 		// The mocks that we expect to be called for the specified packet data.
 		expectedCalls        func(sdk.Context, testkeeper.MockedKeepers, ccv.SlashPacketData) []*gomock.Call
 		expectedSlashAcksLen int
+		// Whether the consumer chain opted into slashing for downtime
+		slashEnabled bool
 	}{
 		{
 			"unfound validator",
@@ -448,6 +902,7 @@ func TestHandleSlashPacket(t *testing.T) {
 				}
 			},
 			0,
+			false,
 		},
 		{
 			"found, but tombstoned validator",
@@ -470,6 +925,7 @@ func TestHandleSlashPacket(t *testing.T) {
 				}
 			},
 			0,
+			false,
 		},
 		{
 			"drop packet when infraction height not found",
@@ -493,6 +949,7 @@ func TestHandleSlashPacket(t *testing.T) {
 				}
 			},
 			0,
+			false,
 		},
 		{
 			"full downtime packet handling, uses init chain height and non-jailed validator",
@@ -507,9 +964,11 @@ func TestHandleSlashPacket(t *testing.T) {
 					ctx, mocks,
 					providerConsAddr,                      // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
 					stakingtypes.Validator{Jailed: false}, // staking keeper val to return
-					true)                                  // expectJailing = true
+					true,                                  // expectJailing = true
+					false)                                 // expectSlashing = false
 			},
 			1,
+			false,
 		},
 		{
 			"full downtime packet handling, uses valid vscID and jailed validator",
@@ -524,9 +983,49 @@ func TestHandleSlashPacket(t *testing.T) {
 					ctx, mocks,
 					providerConsAddr,                     // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
 					stakingtypes.Validator{Jailed: true}, // staking keeper val to return
-					false)                                // expectJailing = false, validator is already jailed.
+					false,                                // expectJailing = false, validator is already jailed.
+					false)                                // expectSlashing = false
+			},
+			1,
+			false,
+		},
+		{
+			"full downtime packet handling, slashing enabled for consumer chain",
+			*ccv.NewSlashPacketData(
+				abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+				validVscID,
+				stakingtypes.Downtime),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers,
+				expectedPacketData ccv.SlashPacketData,
+			) []*gomock.Call {
+				return testkeeper.GetMocksForHandleSlashPacket(
+					ctx, mocks,
+					providerConsAddr,                      // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
+					stakingtypes.Validator{Jailed: false}, // staking keeper val to return
+					true,                                  // expectJailing = true
+					true)                                  // expectSlashing = true
+			},
+			1,
+			true,
+		},
+		{
+			"full downtime packet handling, slashing enabled for consumer chain and jailed validator",
+			*ccv.NewSlashPacketData(
+				abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+				validVscID,
+				stakingtypes.Downtime),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers,
+				expectedPacketData ccv.SlashPacketData,
+			) []*gomock.Call {
+				return testkeeper.GetMocksForHandleSlashPacket(
+					ctx, mocks,
+					providerConsAddr,                     // expected provider cons addr returned from GetProviderAddrFromConsumerAddr
+					stakingtypes.Validator{Jailed: true}, // staking keeper val to return
+					false,                                // expectJailing = false, validator is already jailed.
+					false)                                // expectSlashing = false, validator is already jailed.
 			},
 			1,
+			true,
 		},
 		// Note: double-sign slash packet handling should not occur, see OnRecvSlashPacket.
 	}
@@ -547,6 +1046,8 @@ func TestHandleSlashPacket(t *testing.T) {
 		require.NotEmpty(t, tc.packetData.Validator.Address)
 		providerKeeper.SetValidatorByConsumerAddr(ctx, chainId, consumerConsAddr, providerConsAddr)
 
+		providerKeeper.SetSlashEnabled(ctx, chainId, tc.slashEnabled)
+
 		// Execute method and assert expected mock calls.
 		providerKeeper.HandleSlashPacket(ctx, chainId, tc.packetData)
 
@@ -563,6 +1064,167 @@ func TestHandleSlashPacket(t *testing.T) {
 	}
 }
 
+// TestHandleSlashPacketUnbondingWindow tests that slash packets for infractions
+// older than the unbonding period are dropped, including at the exact boundary.
+func TestHandleSlashPacketUnbondingWindow(t *testing.T) {
+	chainId := "consumer-id"
+	vscID := uint64(234)
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	unbondingTime := 21 * 24 * time.Hour
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		// the block time mapped to the vscID, if any
+		infractionTime *time.Time
+		expectHandled  bool
+	}{
+		{"no block time mapped to vscID", nil, true},
+		{"infraction well within the unbonding window", timePtr(now.Add(-time.Hour)), true},
+		{"infraction one nanosecond inside the unbonding window", timePtr(now.Add(-unbondingTime).Add(time.Nanosecond)), true},
+		{"infraction exactly at the unbonding window boundary", timePtr(now.Add(-unbondingTime)), false},
+		{"infraction one nanosecond outside the unbonding window", timePtr(now.Add(-unbondingTime).Add(-time.Nanosecond)), false},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+			t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithBlockTime(now)
+
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+			ctx, providerConsAddr.ToSdkConsAddr()).Return(stakingtypes.Validator{}, true).Times(1)
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx,
+			providerConsAddr.ToSdkConsAddr()).Return(false).Times(1)
+		if tc.infractionTime != nil {
+			mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingTime).Times(1)
+			providerKeeper.SetValsetUpdateBlockTime(ctx, vscID, *tc.infractionTime)
+		}
+		if tc.expectHandled {
+			mocks.MockStakingKeeper.EXPECT().Jail(ctx, providerConsAddr.ToSdkConsAddr()).Times(1)
+			mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Hour).Times(1)
+			mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), gomock.Any()).Times(1)
+		}
+
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 99)
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainId, consumerConsAddr, providerConsAddr)
+
+		providerKeeper.HandleSlashPacket(ctx, chainId, *ccv.NewSlashPacketData(
+			abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+			vscID,
+			stakingtypes.Downtime,
+		))
+
+		// the slash ack is appended in both cases
+		require.Equal(t, []string{consumerConsAddr.String()}, providerKeeper.GetSlashAcks(ctx, chainId), tc.name)
+
+		dropped := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == ccv.EventTypeDropStaleSlashPacket {
+				dropped = true
+			}
+		}
+		require.Equal(t, !tc.expectHandled, dropped, tc.name)
+		require.Equal(t, tc.expectHandled, providerKeeper.JailedByConsumer(ctx, chainId, providerConsAddr), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestWithinUnbondingWindow tests whether infractions that reference vscIDs
+// with and without mapped block times are within the unbonding window
+func TestWithinUnbondingWindow(t *testing.T) {
+	unbondingTime := 21 * 24 * time.Hour
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		// the block times mapped to vscIDs
+		blockTimes map[uint64]time.Time
+		vscID      uint64
+		expWithin  bool
+	}{
+		{"vscID 0 of the initial validator set", map[uint64]time.Time{5: now.Add(-2 * unbondingTime)}, 0, true},
+		{"no block time mapped to any vscID", nil, 5, true},
+		{"mapped vscID within the unbonding window", map[uint64]time.Time{5: now.Add(-time.Hour)}, 5, true},
+		{"mapped vscID outside the unbonding window", map[uint64]time.Time{5: now.Add(-unbondingTime)}, 5, false},
+		{
+			"unmapped vscID lower than the earliest mapped one, which is within the unbonding window",
+			map[uint64]time.Time{5: now.Add(-time.Hour)}, 4, true,
+		},
+		{
+			"unmapped vscID lower than the earliest mapped one, which is outside the unbonding window",
+			map[uint64]time.Time{5: now.Add(-unbondingTime), 6: now}, 4, false,
+		},
+		{
+			"unmapped vscID greater than the earliest mapped one",
+			map[uint64]time.Time{5: now.Add(-unbondingTime)}, 6, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+			t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithBlockTime(now)
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(unbondingTime).AnyTimes()
+
+		for vscID, blockTime := range tc.blockTimes {
+			providerKeeper.SetValsetUpdateBlockTime(ctx, vscID, blockTime)
+		}
+
+		require.Equal(t, tc.expWithin, providerKeeper.WithinUnbondingWindow(ctx, tc.vscID), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+// TestHandleSlashPacketConsumerDowntimeJailDuration tests that validators are jailed for the
+// downtime jail duration of the consumer chain, if set, and for the provider one otherwise
+func TestHandleSlashPacketConsumerDowntimeJailDuration(t *testing.T) {
+	chainId := "consumer-id"
+	vscID := uint64(234)
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, consumerJailDuration := range []time.Duration{0, 10 * time.Minute} {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+			t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithBlockTime(now)
+
+		expectedJailTime := now.Add(time.Hour)
+		if consumerJailDuration != 0 {
+			providerKeeper.SetConsumerDowntimeJailDuration(ctx, chainId, consumerJailDuration)
+			expectedJailTime = now.Add(consumerJailDuration)
+		}
+
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(
+			ctx, providerConsAddr.ToSdkConsAddr()).Return(stakingtypes.Validator{}, true).Times(1)
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx,
+			providerConsAddr.ToSdkConsAddr()).Return(false).Times(1)
+		mocks.MockStakingKeeper.EXPECT().Jail(ctx, providerConsAddr.ToSdkConsAddr()).Times(1)
+		if consumerJailDuration == 0 {
+			mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Hour).Times(1)
+		}
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), expectedJailTime).Times(1)
+
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscID, 99)
+		providerKeeper.SetValidatorByConsumerAddr(ctx, chainId, consumerConsAddr, providerConsAddr)
+
+		providerKeeper.HandleSlashPacket(ctx, chainId, *ccv.NewSlashPacketData(
+			abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+			vscID,
+			stakingtypes.Downtime,
+		))
+
+		ctrl.Finish()
+	}
+}
+
 // TestHandleVSCMaturedPacket tests the handling of VSCMatured packets.
 // Note that this method also tests the behaviour of AfterUnbondingInitiated.
 func TestHandleVSCMaturedPacket(t *testing.T) {
@@ -676,3 +1338,127 @@ func TestHandleVSCMaturedPacket(t *testing.T) {
 	_, found = pk.GetUnbondingOpIndex(ctx, "chain-1", 3)
 	require.False(t, found)
 }
+
+// TestForceMatureVscPackets tests that force maturing the VSC packets of a consumer chain
+// releases the unbonding operations that are not waiting for any other consumer chain
+func TestForceMatureVscPackets(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.ForceMatureVscPackets(ctx, "chain-1")
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	pk.SetConsumerClientId(ctx, "chain-1", "client-1")
+	pk.SetConsumerClientId(ctx, "chain-2", "client-2")
+	// the first unbonding op only waits for chain-1, the second one waits for both consumer chains
+	pk.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 1, UnbondingConsumerChains: []string{"chain-1"}})
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 1, []uint64{1})
+	pk.SetUnbondingOp(ctx, providertypes.UnbondingOp{Id: 2, UnbondingConsumerChains: []string{"chain-1", "chain-2"}})
+	pk.SetUnbondingOpIndex(ctx, "chain-1", 2, []uint64{2})
+	pk.SetUnbondingOpIndex(ctx, "chain-2", 2, []uint64{2})
+
+	// only the governance account can force mature the VSC packets of a consumer chain
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	_, err = msgServer.ForceMatureVscPackets(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgForceMatureVscPackets("invalid", "chain-1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := msgServer.ForceMatureVscPackets(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgForceMatureVscPackets(pk.GetAuthority(), "chain-1"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.NumReleased)
+	require.Equal(t, []uint64{1}, pk.ConsumeMaturedUnbondingOps(ctx))
+	require.Empty(t, pk.GetAllUnbondingOpIndexes(ctx, "chain-1"))
+	lastMaturedVscID, found := pk.GetLastMaturedVscId(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, uint64(2), lastMaturedVscID)
+
+	// the second unbonding op still waits for chain-2
+	unbondingOp, found := pk.GetUnbondingOp(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, []string{"chain-2"}, unbondingOp.UnbondingConsumerChains)
+
+	// a VSCMatured packet received afterwards does not move the last matured VSC ID back
+	pk.HandleVSCMaturedPacket(ctx, "chain-1", ccv.VSCMaturedPacketData{ValsetUpdateId: 1})
+	lastMaturedVscID, _ = pk.GetLastMaturedVscId(ctx, "chain-1")
+	require.Equal(t, uint64(2), lastMaturedVscID)
+	require.Empty(t, pk.ConsumeMaturedUnbondingOps(ctx))
+}
+
+// TestQueryConsumerNextVscId tests that the next VSC ID of a consumer chain is the current VSC ID
+// and that its last matured VSC ID is updated when a VSCMatured packet is handled
+func TestQueryConsumerNextVscId(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &providertypes.QueryConsumerNextVscIdRequest{ChainId: "chain-1"}
+	_, err := pk.QueryConsumerNextVscId(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	pk.SetConsumerClientId(ctx, "chain-1", "client-1")
+	pk.SetValidatorSetUpdateId(ctx, 5)
+	res, err := pk.QueryConsumerNextVscId(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryConsumerNextVscIdResponse{NextVscId: 5, LastMaturedVscId: 0}, res)
+
+	pk.HandleVSCMaturedPacket(ctx, "chain-1", ccv.VSCMaturedPacketData{ValsetUpdateId: 3})
+	res, err = pk.QueryConsumerNextVscId(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryConsumerNextVscIdResponse{NextVscId: 5, LastMaturedVscId: 3}, res)
+
+	pk.DeleteLastMaturedVscId(ctx, "chain-1")
+	_, found := pk.GetLastMaturedVscId(ctx, "chain-1")
+	require.False(t, found)
+}
+
+// TestEndBlockCHT tests that the latest heights of the consumer clients updated in the block
+// are recorded at the end of the block, and that the latest seen heights never decrease
+func TestEndBlockCHT(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "client-1")
+	providerKeeper.SetConsumerClientId(ctx, "chain-2", "client-2")
+	providerKeeper.SetConsumerClientId(ctx, "chain-3", "client-3")
+
+	// the client of chain-3 is not updated, and the clients of other chains are not flagged
+	providerKeeper.SetConsumerClientUpdated(ctx, "client-1")
+	providerKeeper.SetConsumerClientUpdated(ctx, "client-2")
+	providerKeeper.SetConsumerClientUpdated(ctx, "other-client")
+	require.Equal(t, []string{"client-1", "client-2"}, providerKeeper.GetAllUpdatedConsumerClients(ctx))
+
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "client-1").Return(
+			&ibctmtypes.ClientState{LatestHeight: clienttypes.NewHeight(1, 10)}, true).Times(1),
+		// the client of chain-2 is dangling
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "client-2").Return(nil, false).Times(1),
+	)
+	providerKeeper.EndBlockCHT(ctx)
+	require.Empty(t, providerKeeper.GetAllUpdatedConsumerClients(ctx))
+	_, found := providerKeeper.GetConsumerLatestSeenHeight(ctx, "chain-3")
+	require.False(t, found)
+
+	height, found := providerKeeper.GetConsumerLatestSeenHeight(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, clienttypes.NewHeight(1, 10), height)
+	_, found = providerKeeper.GetConsumerLatestSeenHeight(ctx, "chain-2")
+	require.False(t, found)
+
+	// a lower height, e.g., of a reset client, is ignored
+	providerKeeper.OnConsumerClientUpdate(ctx, "chain-1", clienttypes.NewHeight(1, 5))
+	height, _ = providerKeeper.GetConsumerLatestSeenHeight(ctx, "chain-1")
+	require.Equal(t, clienttypes.NewHeight(1, 10), height)
+
+	providerKeeper.OnConsumerClientUpdate(ctx, "chain-1", clienttypes.NewHeight(2, 1))
+	height, _ = providerKeeper.GetConsumerLatestSeenHeight(ctx, "chain-1")
+	require.Equal(t, clienttypes.NewHeight(2, 1), height)
+
+	res, err := providerKeeper.QueryConsumerLatestSeenHeight(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryConsumerLatestSeenHeightRequest{ChainId: "chain-1"})
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(2, 1), res.LatestSeenHeight)
+
+	_, err = providerKeeper.QueryConsumerLatestSeenHeight(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryConsumerLatestSeenHeightRequest{ChainId: "chain-2"})
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+}
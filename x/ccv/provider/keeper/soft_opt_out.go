@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"math"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// GetConsumerOptedOutValidators returns the soft opt-out threshold of the given consumer chain
+// and the validators that are currently exempt from downtime slashing on it, ordered by ascending power.
+//
+// Note that the threshold is read from the genesis of the consumer chain, i.e., a threshold changed
+// afterwards by the consumer chain, e.g., via governance, is not reflected.
+func (k Keeper) GetConsumerOptedOutValidators(ctx sdk.Context, chainID string) (sdk.Dec, []types.OptedOutValidator, error) {
+	gen, found := k.GetConsumerGenesis(ctx, chainID)
+	if !found {
+		return sdk.Dec{}, nil, sdkerrors.Wrap(types.ErrUnknownConsumerChainId, chainID)
+	}
+	threshold, err := sdk.NewDecFromStr(gen.Params.SoftOptOutThreshold)
+	if err != nil {
+		return sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrInvalidConsumerParams,
+			"soft opt-out threshold %q of consumer chain %s: %s", gen.Params.SoftOptOutThreshold, chainID, err)
+	}
+
+	valSet, err := k.GetConsumerValidatorUpdates(ctx, chainID)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	optedOut := []types.OptedOutValidator{}
+	for _, val := range SoftOptOutValidators(valSet, threshold) {
+		providerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(val.PubKey)
+		if err != nil {
+			return sdk.Dec{}, nil, err
+		}
+		optedOut = append(optedOut, types.OptedOutValidator{
+			ProviderAddress: providerAddr.String(),
+			Power:           val.Power,
+		})
+	}
+	return threshold, optedOut, nil
+}
+
+// GetConsumerValidatorUpdates returns the current validator set (with provider keys) of the given
+// consumer chain, with the powers as sent to the consumer chain, i.e., after applying its power
+// reduction and power multiplier.
+func (k Keeper) GetConsumerValidatorUpdates(ctx sdk.Context, chainID string) ([]abci.ValidatorUpdate, error) {
+	// the stored validator set of consumer chains with a power reduction has the reduced powers
+	var valSet []abci.ValidatorUpdate
+	if _, found := k.GetTrackedValSetTopN(ctx, chainID); found {
+		valSet = k.GetConsumerValSet(ctx, chainID)
+	} else {
+		var err error
+		valSet, err = k.GetTopNValidatorUpdates(ctx, math.MaxUint32)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if powerMultiplier, found := k.GetConsumerPowerMultiplier(ctx, chainID); found {
+		var err error
+		valSet, err = ApplyConsumerPowerMultiplier(valSet, powerMultiplier)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return valSet, nil
+}
+
+// SoftOptOutValidators returns the validators of the given validator set that can soft opt out,
+// ordered by ascending power, i.e., the validators with a lower power than the smallest validator
+// power such that the sum of the power of all validators with a lower or equal power is more than
+// threshold of the total power. This mirrors the computation of the consumer module.
+func SoftOptOutValidators(valSet []abci.ValidatorUpdate, threshold sdk.Dec) []abci.ValidatorUpdate {
+	if threshold.IsZero() || len(valSet) == 0 {
+		return nil
+	}
+
+	sorted := make([]abci.ValidatorUpdate, len(valSet))
+	copy(sorted, valSet)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Power < sorted[j].Power
+	})
+
+	totalPower := sdk.ZeroDec()
+	for _, val := range sorted {
+		totalPower = totalPower.Add(sdk.NewDec(val.Power))
+	}
+	if totalPower.IsZero() {
+		return nil
+	}
+
+	powerSum := sdk.ZeroDec()
+	for i, val := range sorted {
+		powerSum = powerSum.Add(sdk.NewDec(val.Power))
+		if powerSum.Quo(totalPower).GT(threshold) {
+			// the validators with a lower power than the smallest non-opt-out power
+			n := i
+			for n > 0 && sorted[n-1].Power == val.Power {
+				n--
+			}
+			return sorted[:n]
+		}
+	}
+	// the threshold is at least one, i.e., all validators can soft opt out
+	return sorted
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// TestSoftOptOutValidators tests that the validators that can soft opt out are the ones
+// with a lower power than the smallest power that cannot soft opt out on the consumer chain
+func TestSoftOptOutValidators(t *testing.T) {
+	ids := cryptotestutil.GenMultipleCryptoIds(7, 0)
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	testCases := []struct {
+		name      string
+		threshold string
+		valSet    []abci.ValidatorUpdate
+		expected  []abci.ValidatorUpdate
+	}{
+		{
+			name:      "disabled",
+			threshold: "0",
+			valSet:    []abci.ValidatorUpdate{update(0, 1), update(1, 100)},
+			expected:  nil,
+		},
+		{
+			name:      "empty validator set",
+			threshold: "0.05",
+			valSet:    nil,
+			expected:  nil,
+		},
+		{
+			// 107 total power, the validator with power 3 passes the threshold (6 / 107 = 0.056)
+			name:      "bottom validators",
+			threshold: "0.05",
+			valSet:    []abci.ValidatorUpdate{update(0, 3), update(1, 51), update(2, 1), update(3, 49), update(4, 1), update(5, 1)},
+			expected:  []abci.ValidatorUpdate{update(2, 1), update(4, 1), update(5, 1)},
+		},
+		{
+			// 506 total power, the validator with power 500 passes the threshold
+			name:      "all but the largest validator",
+			threshold: "0.05",
+			valSet:    []abci.ValidatorUpdate{update(0, 1), update(1, 1), update(2, 1), update(3, 3), update(4, 500)},
+			expected:  []abci.ValidatorUpdate{update(0, 1), update(1, 1), update(2, 1), update(3, 3)},
+		},
+		{
+			// 30 total power, the second validator with power 10 passes the threshold (20 / 30 = 0.67),
+			// thus, no validator with power 10 can soft opt out
+			name:      "equal powers",
+			threshold: "0.5",
+			valSet:    []abci.ValidatorUpdate{update(0, 10), update(1, 10), update(2, 10)},
+			expected:  []abci.ValidatorUpdate{},
+		},
+	}
+
+	for _, tc := range testCases {
+		valSet := append([]abci.ValidatorUpdate(nil), tc.valSet...)
+		optedOut := providerkeeper.SoftOptOutValidators(tc.valSet, sdk.MustNewDecFromStr(tc.threshold))
+		require.Equal(t, tc.expected, optedOut, tc.name)
+		// the given validator set is not modified
+		require.Equal(t, valSet, tc.valSet, tc.name)
+	}
+}
+
+// TestQueryConsumerOptedOutValidators tests that the validators that soft opted out on a consumer chain
+// are computed from its current validator set and the soft opt-out threshold of its genesis
+func TestQueryConsumerOptedOutValidators(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QueryConsumerOptedOutValidators(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = pk.QueryConsumerOptedOutValidators(sdk.WrapSDKContext(ctx), &types.QueryConsumerOptedOutValidatorsRequest{})
+	require.Error(t, err)
+
+	// the consumer chain is unknown
+	req := &types.QueryConsumerOptedOutValidatorsRequest{ChainId: "chainID"}
+	_, err = pk.QueryConsumerOptedOutValidators(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrUnknownConsumerChainId)
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	params := consumertypes.DefaultParams()
+	params.SoftOptOutThreshold = "0.1"
+	pk.SetConsumerClientId(ctx, "chainID", "clientID")
+	require.NoError(t, pk.SetConsumerGenesis(ctx, "chainID", consumertypes.GenesisState{Params: params}))
+	pk.SetConsumerTopN(ctx, "chainID", 3)
+	pk.SetConsumerValSet(ctx, "chainID", []abci.ValidatorUpdate{
+		{PubKey: ids[0].TMProtoCryptoPublicKey(), Power: 90},
+		{PubKey: ids[1].TMProtoCryptoPublicKey(), Power: 4},
+		{PubKey: ids[2].TMProtoCryptoPublicKey(), Power: 7},
+	})
+
+	// 101 total power, the validator with power 7 passes the threshold (11 / 101 = 0.109)
+	res, err := pk.QueryConsumerOptedOutValidators(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerOptedOutValidatorsResponse{
+		SoftOptOutThreshold: sdk.MustNewDecFromStr("0.1").String(),
+		Validators: []types.OptedOutValidator{
+			{ProviderAddress: ids[1].SDKValConsAddress().String(), Power: 4},
+		},
+	}, res)
+
+	// the powers sent to the consumer chain are scaled by its power multiplier
+	pk.SetConsumerPowerMultiplier(ctx, "chainID", sdk.NewDec(2))
+	res, err = pk.QueryConsumerOptedOutValidators(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Equal(t, []types.OptedOutValidator{
+		{ProviderAddress: ids[1].SDKValConsAddress().String(), Power: 8},
+	}, res.Validators)
+}
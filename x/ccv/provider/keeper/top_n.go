@@ -0,0 +1,679 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/interchain-security/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/x/ccv/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// SetConsumerTopN sets the number of validators, selected by power, that validate the given consumer chain
+func (k Keeper) SetConsumerTopN(ctx sdk.Context, chainID string, topN uint32) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.TopN = topN
+	})
+}
+
+// GetConsumerTopN returns the number of validators, selected by power, that validate the given consumer chain.
+// If not found, all the bonded validators validate the consumer chain.
+func (k Keeper) GetConsumerTopN(ctx sdk.Context, chainID string) (uint32, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.TopN == 0 {
+		return 0, false
+	}
+	return initParams.TopN, true
+}
+
+// DeleteConsumerTopN deletes the top N of the given consumer chain
+func (k Keeper) DeleteConsumerTopN(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.TopN = 0
+	})
+}
+
+// GetProposalTopN returns the number of validators, selected by power, that validate
+// the consumer chain of the given proposal, i.e., the default top N if the proposal does not set it.
+// If neither is set, all the bonded validators validate the consumer chain.
+func (k Keeper) GetProposalTopN(ctx sdk.Context, prop *types.ConsumerAdditionProposal) (uint32, bool) {
+	if prop.TopN != 0 {
+		return prop.TopN, true
+	}
+	if defaultTopN := k.GetDefaultTopN(ctx); defaultTopN != 0 {
+		return defaultTopN, true
+	}
+	return 0, false
+}
+
+// GetTrackedValSetTopN returns the number of validators, selected by power, of the validator set
+// tracked for the given consumer chain, see ComputeConsumerValSetChanges. The validator set is tracked
+// for top N consumer chains, for consumer chains requiring the approval of their validators and for
+// consumer chains with a power reduction, where all the bonded validators are candidates if no top N is set.
+//
+// Note that the consumer powers of consumer chains with a power reduction depend on the tokens
+// of the validators, which may change without changing their power on the provider chain.
+func (k Keeper) GetTrackedValSetTopN(ctx sdk.Context, chainID string) (uint32, bool) {
+	if topN, found := k.GetConsumerTopN(ctx, chainID); found {
+		return topN, true
+	}
+	if k.IsValidatorApprovalRequired(ctx, chainID) {
+		return math.MaxUint32, true
+	}
+	if _, found := k.GetConsumerPowerReduction(ctx, chainID); found {
+		return math.MaxUint32, true
+	}
+	return 0, false
+}
+
+// SetConsumerPowerReduction sets the power reduction used to compute the voting powers sent to the given consumer chain
+func (k Keeper) SetConsumerPowerReduction(ctx sdk.Context, chainID string, powerReduction sdk.Int) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ConsumerPowerReduction = powerReduction.String()
+	})
+}
+
+// GetConsumerPowerReduction returns the power reduction used to compute the voting powers sent to the given consumer chain.
+// If not found, the voting powers on the provider chain are sent to the consumer chain.
+func (k Keeper) GetConsumerPowerReduction(ctx sdk.Context, chainID string) (sdk.Int, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.ConsumerPowerReduction == "" {
+		return sdk.Int{}, false
+	}
+	powerReduction, err := types.ParseConsumerPowerReduction(initParams.ConsumerPowerReduction)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the power reduction is validated before it is stored.
+		panic(fmt.Errorf("failed to parse power reduction: %w", err))
+	}
+	return powerReduction, true
+}
+
+// DeleteConsumerPowerReduction deletes the power reduction of the given consumer chain
+func (k Keeper) DeleteConsumerPowerReduction(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ConsumerPowerReduction = ""
+	})
+}
+
+// ApplyConsumerPowerReduction returns the given validator updates (with provider keys) with the powers
+// recomputed from the tokens of the validators, i.e., tokens / powerReduction. Zero-power updates are kept
+// as they are, while the power of a bonded validator is clamped to at least 1, so that validators with
+// fewer tokens than the power reduction still validate the consumer chain.
+func (k Keeper) ApplyConsumerPowerReduction(
+	ctx sdk.Context,
+	updates []abci.ValidatorUpdate,
+	powerReduction sdk.Int,
+) ([]abci.ValidatorUpdate, error) {
+	reducedUpdates := make([]abci.ValidatorUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.Power == 0 {
+			reducedUpdates = append(reducedUpdates, update)
+			continue
+		}
+
+		providerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(update.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		val, found := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr)
+		if !found {
+			return nil, sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "validator with consensus address %s", providerAddr)
+		}
+
+		power := val.Tokens.Quo(powerReduction)
+		if !power.IsInt64() {
+			return nil, sdkerrors.Wrapf(ccv.ErrInvalidValidatorPower,
+				"power %s of validator %s overflows", power, providerAddr)
+		}
+		reducedUpdate := abci.ValidatorUpdate{PubKey: update.PubKey, Power: power.Int64()}
+		if reducedUpdate.Power < 1 {
+			reducedUpdate.Power = 1
+		}
+		reducedUpdates = append(reducedUpdates, reducedUpdate)
+	}
+	return reducedUpdates, nil
+}
+
+// SetConsumerPowerMultiplier sets the multiplier applied to the voting powers sent to the given consumer chain
+func (k Keeper) SetConsumerPowerMultiplier(ctx sdk.Context, chainID string, powerMultiplier sdk.Dec) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.PowerMultiplier = powerMultiplier.String()
+	})
+}
+
+// GetConsumerPowerMultiplier returns the multiplier applied to the voting powers sent to the given consumer chain.
+// If not found, the voting powers are not scaled.
+func (k Keeper) GetConsumerPowerMultiplier(ctx sdk.Context, chainID string) (sdk.Dec, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.PowerMultiplier == "" {
+		return sdk.Dec{}, false
+	}
+	powerMultiplier, err := types.ParsePowerMultiplier(initParams.PowerMultiplier)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the power multiplier is validated before it is stored.
+		panic(fmt.Errorf("failed to parse power multiplier: %w", err))
+	}
+	return powerMultiplier, true
+}
+
+// DeleteConsumerPowerMultiplier deletes the power multiplier of the given consumer chain
+func (k Keeper) DeleteConsumerPowerMultiplier(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.PowerMultiplier = ""
+	})
+}
+
+// SetConsumerDowntimeJailDuration sets the duration for which validators are jailed
+// for downtime infractions on the given consumer chain
+func (k Keeper) SetConsumerDowntimeJailDuration(ctx sdk.Context, chainID string, duration time.Duration) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ConsumerDowntimeJailDuration = duration
+	})
+}
+
+// GetConsumerDowntimeJailDuration returns the duration for which validators are jailed
+// for downtime infractions on the given consumer chain.
+// If not found, the downtime jail duration of the provider slashing params is used,
+// see GetEffectiveConsumerDowntimeJailDuration.
+func (k Keeper) GetConsumerDowntimeJailDuration(ctx sdk.Context, chainID string) (time.Duration, bool) {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	if initParams.ConsumerDowntimeJailDuration == 0 {
+		return 0, false
+	}
+	return initParams.ConsumerDowntimeJailDuration, true
+}
+
+// GetEffectiveConsumerDowntimeJailDuration returns the duration for which validators are jailed
+// for downtime infractions on the given consumer chain, i.e., the downtime jail duration of the
+// consumer chain if set, and the downtime jail duration of the provider slashing params otherwise.
+//
+// Note that the default is not derived from the signed blocks window of the provider slashing params,
+// as the window is a number of blocks, which has no deterministic conversion into a duration.
+func (k Keeper) GetEffectiveConsumerDowntimeJailDuration(ctx sdk.Context, chainID string) time.Duration {
+	if jailDuration, found := k.GetConsumerDowntimeJailDuration(ctx, chainID); found {
+		return jailDuration
+	}
+	return k.slashingKeeper.DowntimeJailDuration(ctx)
+}
+
+// DeleteConsumerDowntimeJailDuration deletes the downtime jail duration of the given consumer chain
+func (k Keeper) DeleteConsumerDowntimeJailDuration(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ConsumerDowntimeJailDuration = 0
+	})
+}
+
+// ApplyConsumerPowerMultiplier returns the given validator updates with the powers scaled by the given
+// power multiplier of a consumer chain. Zero-power updates are kept as they are, while the scaled power
+// of a validator is clamped to at least 1, so that a multiplier below 1 does not remove validators.
+func ApplyConsumerPowerMultiplier(
+	updates []abci.ValidatorUpdate,
+	powerMultiplier sdk.Dec,
+) ([]abci.ValidatorUpdate, error) {
+	scaledUpdates := make([]abci.ValidatorUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.Power == 0 {
+			scaledUpdates = append(scaledUpdates, update)
+			continue
+		}
+
+		power := powerMultiplier.MulInt64(update.Power).TruncateInt()
+		if !power.IsInt64() || power.Int64() > tmtypes.MaxTotalVotingPower {
+			return nil, sdkerrors.Wrapf(ccv.ErrInvalidValidatorPower,
+				"scaled power %s of validator %s exceeds the maximum of %d", power, update.PubKey.String(), tmtypes.MaxTotalVotingPower)
+		}
+		scaledUpdate := abci.ValidatorUpdate{PubKey: update.PubKey, Power: power.Int64()}
+		if scaledUpdate.Power < 1 {
+			scaledUpdate.Power = 1
+		}
+		scaledUpdates = append(scaledUpdates, scaledUpdate)
+	}
+	return scaledUpdates, nil
+}
+
+// SetConsumerValSet replaces the validator set (with provider keys) last sent to the given consumer chain
+func (k Keeper) SetConsumerValSet(ctx sdk.Context, chainID string, valSet []abci.ValidatorUpdate) {
+	k.DeleteConsumerValSet(ctx, chainID)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, val := range valSet {
+		providerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(val.PubKey)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the validator set is obtained from the staking module
+			panic(fmt.Errorf("cannot get provider address from pub key: %w", err))
+		}
+		bz, err := val.Marshal()
+		if err != nil {
+			// An error here would indicate something is very wrong
+			panic(fmt.Errorf("failed to marshal validator update: %w", err))
+		}
+		store.Set(types.ConsumerValSetKey(chainID, types.NewProviderConsAddress(providerAddr)), bz)
+	}
+}
+
+// GetConsumerValSet returns the validator set (with provider keys) last sent to the given consumer chain.
+//
+// Note that the validators are ordered by their provider consensus address.
+func (k Keeper) GetConsumerValSet(ctx sdk.Context, chainID string) (valSet []abci.ValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerValSetBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val abci.ValidatorUpdate
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the validator update is assumed to be correctly serialized in SetConsumerValSet.
+			panic(fmt.Errorf("failed to unmarshal validator update: %w", err))
+		}
+		valSet = append(valSet, val)
+	}
+
+	return valSet
+}
+
+// DeleteConsumerValSet deletes the validator set last sent to the given consumer chain
+func (k Keeper) DeleteConsumerValSet(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ConsumerValSetBytePrefix, chainID))
+
+	keysToDel := [][]byte{}
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetConsumerValidatorPower returns the power of a validator in the validator set
+// of the given consumer chain, i.e., zero if the validator does not validate the consumer chain.
+//
+// Note that for consumer chains whose validator set is not tracked, see GetTrackedValSetTopN,
+// the validator's last power on the provider is returned.
+func (k Keeper) GetConsumerValidatorPower(ctx sdk.Context, chainID string, validator stakingtypes.Validator) (int64, error) {
+	if _, found := k.GetTrackedValSetTopN(ctx, chainID); !found {
+		return k.stakingKeeper.GetLastValidatorPower(ctx, validator.GetOperator()), nil
+	}
+	providerAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return 0, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerValSetKey(chainID, types.NewProviderConsAddress(providerAddr)))
+	if bz == nil {
+		return 0, nil
+	}
+	var val abci.ValidatorUpdate
+	if err := val.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the validator update is assumed to be correctly serialized in SetConsumerValSet.
+		panic(fmt.Errorf("failed to unmarshal validator update: %w", err))
+	}
+	return val.Power, nil
+}
+
+// GetValidatorConsumerChains returns the chain IDs of the consumer chains whose current validator set
+// the given validator is in, i.e., the consumer chains the validator has a non-zero power on.
+func (k Keeper) GetValidatorConsumerChains(ctx sdk.Context, validator stakingtypes.Validator) ([]string, error) {
+	chainIDs := []string{}
+	for _, chain := range k.GetAllConsumerChains(ctx) {
+		power, err := k.GetConsumerValidatorPower(ctx, chain.ChainId, validator)
+		if err != nil {
+			return nil, err
+		}
+		if power > 0 {
+			chainIDs = append(chainIDs, chain.ChainId)
+		}
+	}
+	return chainIDs, nil
+}
+
+// GetReplicatedPower returns the total provider voting power of the bonded validators
+// that validate at least one consumer chain.
+func (k Keeper) GetReplicatedPower(ctx sdk.Context) (int64, error) {
+	replicatedPower := int64(0)
+	for _, validator := range k.stakingKeeper.GetLastValidators(ctx) {
+		chainIDs, err := k.GetValidatorConsumerChains(ctx, validator)
+		if err != nil {
+			return 0, err
+		}
+		if len(chainIDs) > 0 {
+			replicatedPower += k.stakingKeeper.GetLastValidatorPower(ctx, validator.GetOperator())
+		}
+	}
+	return replicatedPower, nil
+}
+
+// GetTopNValidatorUpdates returns the top N bonded validators by power (with provider keys).
+// Validators with equal power are ordered by their operator address.
+func (k Keeper) GetTopNValidatorUpdates(ctx sdk.Context, topN uint32) ([]abci.ValidatorUpdate, error) {
+	var lastPowers []stakingtypes.LastValidatorPower
+
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
+		lastPowers = append(lastPowers, stakingtypes.LastValidatorPower{Address: addr.String(), Power: power})
+		return false
+	})
+
+	validators := make([]stakingtypes.Validator, 0, len(lastPowers))
+	powers := make(map[string]int64, len(lastPowers))
+	for _, p := range lastPowers {
+		addr, err := sdk.ValAddressFromBech32(p.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		val, found := k.stakingKeeper.GetValidator(ctx, addr)
+		if !found {
+			return nil, sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "error getting validator from LastValidatorPowers: %s", p.Address)
+		}
+		validators = append(validators, val)
+		powers[val.OperatorAddress] = p.Power
+	}
+
+	return topNValidatorUpdates(validators, powers, topN)
+}
+
+// GetHistoricalTopNValidatorUpdates returns the top N validators by power (with provider keys)
+// of the validator set at the given provider block height, as retained in the historical info
+// of the staking module. Validators with equal power are ordered by their operator address.
+func (k Keeper) GetHistoricalTopNValidatorUpdates(ctx sdk.Context, height int64, topN uint32) ([]abci.ValidatorUpdate, error) {
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownHistoricalValSet, "height %d", height)
+	}
+
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	validators := make([]stakingtypes.Validator, 0, len(histInfo.Valset))
+	powers := make(map[string]int64, len(histInfo.Valset))
+	for _, val := range histInfo.Valset {
+		power := val.ConsensusPower(powerReduction)
+		if power <= 0 {
+			continue
+		}
+		validators = append(validators, val)
+		powers[val.OperatorAddress] = power
+	}
+
+	return topNValidatorUpdates(validators, powers, topN)
+}
+
+// topNValidatorUpdates returns the validator updates of the top N of the given validators by power
+func topNValidatorUpdates(validators []stakingtypes.Validator, powers map[string]int64, topN uint32) ([]abci.ValidatorUpdate, error) {
+	sort.SliceStable(validators, func(i, j int) bool {
+		powerI, powerJ := powers[validators[i].OperatorAddress], powers[validators[j].OperatorAddress]
+		if powerI != powerJ {
+			return powerI > powerJ
+		}
+		return bytes.Compare(validators[i].GetOperator(), validators[j].GetOperator()) < 0
+	})
+	if uint32(len(validators)) > topN {
+		validators = validators[:topN]
+	}
+
+	updates := make([]abci.ValidatorUpdate, 0, len(validators))
+	for _, val := range validators {
+		tmProtoPk, err := val.TmConsPublicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, abci.ValidatorUpdate{
+			PubKey: tmProtoPk,
+			Power:  powers[val.OperatorAddress],
+		})
+	}
+
+	return updates, nil
+}
+
+// ComputeConsumerValSetChanges returns the changes (with provider keys) between the validator set
+// last sent to the given consumer chain and the current top N bonded validators,
+// and stores the current top N validators as the validator set of the consumer chain.
+// Note that this only applies to the consumer chains whose validator set is tracked, see GetTrackedValSetTopN.
+//
+// Validators leaving the top N get a zero-power update. If the consumer chain requires
+// the approval of its validators, validators joining the top N that were not approved yet
+// are left out and recorded as pending approval. If the consumer chain has a power reduction,
+// the powers are recomputed from the tokens of the validators before comparing them with the
+// stored validator set, so that token changes are sent even if the provider powers are unchanged.
+func (k Keeper) ComputeConsumerValSetChanges(ctx sdk.Context, chainID string, topN uint32) ([]abci.ValidatorUpdate, error) {
+	nextValSet, err := k.GetTopNValidatorUpdates(ctx, topN)
+	if err != nil {
+		return nil, err
+	}
+
+	prevValSet := k.GetConsumerValSet(ctx, chainID)
+	prevPowers := map[string]int64{}
+	for _, val := range prevValSet {
+		prevPowers[val.PubKey.String()] = val.Power
+	}
+
+	if k.IsValidatorApprovalRequired(ctx, chainID) {
+		nextValSet, err = k.filterUnapprovedValidators(ctx, chainID, nextValSet, prevPowers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if powerReduction, found := k.GetConsumerPowerReduction(ctx, chainID); found {
+		nextValSet, err = k.ApplyConsumerPowerReduction(ctx, nextValSet, powerReduction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := ComputeValidatorUpdates(prevValSet, nextValSet)
+
+	k.SetConsumerValSet(ctx, chainID, nextValSet)
+
+	return changes, nil
+}
+
+// ComputeValidatorUpdates returns the validator updates that turn the validator set old
+// into the validator set new, where validators are identified by their public keys:
+//   - validators of new that are not in old, or whose power changed, are returned with their new power,
+//     in the order of new;
+//   - validators of old that are not in new are returned with a zero power, in the order of old;
+//   - validators whose power is unchanged are omitted, where validators missing from old have a zero power.
+//
+// Neither old nor new is modified.
+func ComputeValidatorUpdates(old, new []abci.ValidatorUpdate) []abci.ValidatorUpdate {
+	oldPowers := map[string]int64{}
+	for _, val := range old {
+		oldPowers[val.PubKey.String()] = val.Power
+	}
+
+	updates := []abci.ValidatorUpdate{}
+	for _, val := range new {
+		if oldPowers[val.PubKey.String()] != val.Power {
+			updates = append(updates, val)
+		}
+		delete(oldPowers, val.PubKey.String())
+	}
+	// iterate over the old validator set to remove the validators
+	// that are not in the new validator set in a deterministic order
+	for _, val := range old {
+		if power, found := oldPowers[val.PubKey.String()]; found {
+			if power != 0 {
+				updates = append(updates, abci.ValidatorUpdate{PubKey: val.PubKey, Power: 0})
+			}
+			delete(oldPowers, val.PubKey.String())
+		}
+	}
+	return updates
+}
+
+// filterUnapprovedValidators removes from the given validator updates the validators that are
+// not part of the previous validator set of the consumer chain and were not approved yet.
+// The removed validators replace the validators pending approval for the consumer chain.
+func (k Keeper) filterUnapprovedValidators(
+	ctx sdk.Context,
+	chainID string,
+	valSet []abci.ValidatorUpdate,
+	prevPowers map[string]int64,
+) ([]abci.ValidatorUpdate, error) {
+	k.DeleteAllPendingValidatorApprovals(ctx, chainID)
+
+	approved := []abci.ValidatorUpdate{}
+	for _, val := range valSet {
+		if _, found := prevPowers[val.PubKey.String()]; found {
+			approved = append(approved, val)
+			continue
+		}
+		consAddr, err := ccv.TMCryptoPublicKeyToConsAddr(val.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		if k.IsApprovedValidator(ctx, chainID, providerAddr) {
+			approved = append(approved, val)
+			continue
+		}
+		k.SetPendingValidatorApproval(ctx, chainID, providerAddr)
+	}
+
+	return approved, nil
+}
+
+// SetValidatorApprovalRequired records that the validators joining the validator set
+// of the given consumer chain must be approved first
+func (k Keeper) SetValidatorApprovalRequired(ctx sdk.Context, chainID string) {
+	k.setConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ValidatorApprovalRequired = true
+	})
+}
+
+// IsValidatorApprovalRequired returns whether the validators joining the validator set
+// of the given consumer chain must be approved first
+func (k Keeper) IsValidatorApprovalRequired(ctx sdk.Context, chainID string) bool {
+	initParams, _ := k.GetConsumerInitParams(ctx, chainID)
+	return initParams.ValidatorApprovalRequired
+}
+
+// DeleteValidatorApprovalRequired deletes the validator approval requirement of the given consumer chain
+func (k Keeper) DeleteValidatorApprovalRequired(ctx sdk.Context, chainID string) {
+	k.clearConsumerInitParam(ctx, chainID, func(initParams *types.ConsumerInitParams) {
+		initParams.ValidatorApprovalRequired = false
+	})
+}
+
+// SetApprovedValidator records that the given provider validator is approved
+// to join the validator set of the given consumer chain
+func (k Keeper) SetApprovedValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ApprovedValidatorKey(chainID, providerAddr), []byte{})
+}
+
+// IsApprovedValidator returns whether the given provider validator is approved
+// to join the validator set of the given consumer chain
+func (k Keeper) IsApprovedValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ApprovedValidatorKey(chainID, providerAddr))
+}
+
+// DeleteApprovedValidator deletes the approval of the given provider validator for the given consumer chain
+func (k Keeper) DeleteApprovedValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ApprovedValidatorKey(chainID, providerAddr))
+}
+
+// GetAllApprovedValidators returns the provider addresses of all the validators
+// approved to join the validator set of the given consumer chain
+//
+// Note that the validators are ordered by their provider consensus address.
+func (k Keeper) GetAllApprovedValidators(ctx sdk.Context, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.ApprovedValidatorBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, providerAddr, err := types.ParseChainIdAndConsAddrKey(types.ApprovedValidatorBytePrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetApprovedValidator.
+			panic(fmt.Errorf("failed to parse ApprovedValidatorKey: %w", err))
+		}
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(providerAddr))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllApprovedValidators deletes the approvals of all the validators for the given consumer chain
+func (k Keeper) DeleteAllApprovedValidators(ctx sdk.Context, chainID string) {
+	for _, providerAddr := range k.GetAllApprovedValidators(ctx, chainID) {
+		k.DeleteApprovedValidator(ctx, chainID, providerAddr)
+	}
+}
+
+// SetPendingValidatorApproval records that the given provider validator is waiting
+// for an approval to join the validator set of the given consumer chain
+func (k Keeper) SetPendingValidatorApproval(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingValidatorApprovalKey(chainID, providerAddr), []byte{})
+}
+
+// DeletePendingValidatorApproval deletes the pending approval of the given provider validator
+// for the given consumer chain
+func (k Keeper) DeletePendingValidatorApproval(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingValidatorApprovalKey(chainID, providerAddr))
+}
+
+// GetAllPendingValidatorApprovals returns the provider addresses of all the validators
+// waiting for an approval to join the validator set of the given consumer chain
+//
+// Note that the validators are ordered by their provider consensus address.
+func (k Keeper) GetAllPendingValidatorApprovals(ctx sdk.Context, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ChainIdWithLenKey(types.PendingValidatorApprovalBytePrefix, chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, providerAddr, err := types.ParseChainIdAndConsAddrKey(types.PendingValidatorApprovalBytePrefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetPendingValidatorApproval.
+			panic(fmt.Errorf("failed to parse PendingValidatorApprovalKey: %w", err))
+		}
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(providerAddr))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllPendingValidatorApprovals deletes the pending approvals of all the validators
+// for the given consumer chain
+func (k Keeper) DeleteAllPendingValidatorApprovals(ctx sdk.Context, chainID string) {
+	for _, providerAddr := range k.GetAllPendingValidatorApprovals(ctx, chainID) {
+		k.DeletePendingValidatorApproval(ctx, chainID, providerAddr)
+	}
+}
+
+// ApproveConsumerValidator approves the given provider validator to join the validator set
+// of the given consumer chain. The validator is added with the next validator set change.
+func (k Keeper) ApproveConsumerValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) error {
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		return sdkerrors.Wrapf(types.ErrUnknownConsumerChainId, "chain id: %s", chainID)
+	}
+	if !k.IsValidatorApprovalRequired(ctx, chainID) {
+		return sdkerrors.Wrapf(types.ErrValidatorApprovalNotRequired, "chain id: %s", chainID)
+	}
+
+	k.SetApprovedValidator(ctx, chainID, providerAddr)
+	k.DeletePendingValidatorApproval(ctx, chainID, providerAddr)
+
+	return nil
+}
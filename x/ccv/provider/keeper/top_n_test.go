@@ -0,0 +1,546 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerTopN(ctx, "chainID")
+	require.False(t, found)
+
+	providerKeeper.SetConsumerTopN(ctx, "chainID", 10)
+	topN, found := providerKeeper.GetConsumerTopN(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, uint32(10), topN)
+
+	providerKeeper.DeleteConsumerTopN(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerTopN(ctx, "chainID")
+	require.False(t, found)
+}
+
+func TestGetProposalTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	providerKeeper.SetParams(ctx, params)
+
+	// without a top N nor a default top N, all the bonded validators validate the consumer chain
+	prop := providertypes.ConsumerAdditionProposal{ChainId: "chainID"}
+	_, found := providerKeeper.GetProposalTopN(ctx, &prop)
+	require.False(t, found)
+
+	// the default top N applies to proposals that do not set a top N
+	params.DefaultTopN = 50
+	providerKeeper.SetParams(ctx, params)
+	topN, found := providerKeeper.GetProposalTopN(ctx, &prop)
+	require.True(t, found)
+	require.Equal(t, uint32(50), topN)
+
+	// the top N of the proposal overrides the default top N
+	prop.TopN = 10
+	topN, found = providerKeeper.GetProposalTopN(ctx, &prop)
+	require.True(t, found)
+	require.Equal(t, uint32(10), topN)
+}
+
+func TestConsumerPowerReduction(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerPowerReduction(ctx, "chainID")
+	require.False(t, found)
+
+	providerKeeper.SetConsumerPowerReduction(ctx, "chainID", sdk.NewInt(1000))
+	powerReduction, found := providerKeeper.GetConsumerPowerReduction(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000), powerReduction)
+
+	providerKeeper.DeleteConsumerPowerReduction(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerPowerReduction(ctx, "chainID")
+	require.False(t, found)
+}
+
+// TestApplyConsumerPowerReduction tests that the powers sent to a consumer chain are recomputed
+// from the tokens of the validators, clamped to at least 1, while zero-power updates are kept.
+func TestApplyConsumerPowerReduction(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	tokens := []int64{5500, 999}
+	for i, id := range ids[:2] {
+		val := id.SDKStakingValidator()
+		val.Tokens = sdk.NewInt(tokens[i])
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, id.SDKValConsAddress()).Return(val, true).Times(1)
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	updates, err := providerKeeper.ApplyConsumerPowerReduction(ctx,
+		[]abci.ValidatorUpdate{update(0, 5), update(1, 1), update(2, 0)}, sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 5), update(1, 1), update(2, 0)}, updates)
+
+	// the validator with 5500 tokens gets 550 power with a power reduction of 10
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, ids[0].SDKValConsAddress()).Return(
+		stakingtypes.Validator{Tokens: sdk.NewInt(5500)}, true).Times(1)
+	updates, err = providerKeeper.ApplyConsumerPowerReduction(ctx, []abci.ValidatorUpdate{update(0, 5)}, sdk.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 550)}, updates)
+
+	// unknown validators result in an error
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, ids[0].SDKValConsAddress()).Return(
+		stakingtypes.Validator{}, false).Times(1)
+	_, err = providerKeeper.ApplyConsumerPowerReduction(ctx, []abci.ValidatorUpdate{update(0, 5)}, sdk.NewInt(10))
+	require.Error(t, err)
+}
+
+func TestConsumerPowerMultiplier(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerPowerMultiplier(ctx, "chainID")
+	require.False(t, found)
+
+	providerKeeper.SetConsumerPowerMultiplier(ctx, "chainID", sdk.MustNewDecFromStr("1.5"))
+	powerMultiplier, found := providerKeeper.GetConsumerPowerMultiplier(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), powerMultiplier)
+
+	providerKeeper.DeleteConsumerPowerMultiplier(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerPowerMultiplier(ctx, "chainID")
+	require.False(t, found)
+}
+
+// TestApplyConsumerPowerMultiplier tests that the powers sent to a consumer chain are scaled
+// by the power multiplier, clamped to at least 1, while zero-power updates are kept.
+func TestApplyConsumerPowerMultiplier(t *testing.T) {
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	updates, err := providerkeeper.ApplyConsumerPowerMultiplier(
+		[]abci.ValidatorUpdate{update(0, 5), update(1, 3), update(2, 0)}, sdk.MustNewDecFromStr("1.5"))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 7), update(1, 4), update(2, 0)}, updates)
+
+	// scaled powers are clamped to at least 1
+	updates, err = providerkeeper.ApplyConsumerPowerMultiplier(
+		[]abci.ValidatorUpdate{update(0, 5), update(1, 1)}, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 1), update(1, 1)}, updates)
+
+	// scaled powers above the maximum total voting power result in an error
+	_, err = providerkeeper.ApplyConsumerPowerMultiplier(
+		[]abci.ValidatorUpdate{update(0, tmtypes.MaxTotalVotingPower)}, sdk.NewDec(2))
+	require.Error(t, err)
+}
+
+// TestComputeConsumerValSetChanges tests that only the changes to the top N validators
+// are sent to a top N consumer chain, including zero-power updates for validators leaving the top N.
+func TestComputeConsumerValSetChanges(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(4, 0)
+	mockPowers := func(powers ...int64) {
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for i, id := range ids {
+					if cb(id.SDKValOpAddress(), powers[i]) {
+						return
+					}
+				}
+			}).Times(1)
+		for _, id := range ids {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, id.SDKValOpAddress()).Return(
+				id.SDKStakingValidator(), true).Times(1)
+		}
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	// the initial validator set consists of the top 2 validators
+	mockPowers(1, 4, 3, 2)
+	initialValSet, err := providerKeeper.GetTopNValidatorUpdates(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 4), update(2, 3)}, initialValSet)
+	providerKeeper.SetConsumerValSet(ctx, "chainID", initialValSet)
+
+	// the third validator leaves the top 2 and the fourth validator joins the top 2
+	mockPowers(1, 5, 2, 3)
+	changes, err := providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", 2)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 5), update(3, 3), update(2, 0)}, changes)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{update(1, 5), update(3, 3)},
+		providerKeeper.GetConsumerValSet(ctx, "chainID"))
+
+	// no changes to the top 2 validators, only to the validators outside the top 2
+	mockPowers(2, 5, 1, 3)
+	changes, err = providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", 2)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// the validator set is removed when the consumer chain is stopped
+	providerKeeper.DeleteConsumerValSet(ctx, "chainID")
+	require.Empty(t, providerKeeper.GetConsumerValSet(ctx, "chainID"))
+}
+
+// TestComputeValidatorUpdates tests the validator updates between two validator sets
+func TestComputeValidatorUpdates(t *testing.T) {
+	ids := cryptotestutil.GenMultipleCryptoIds(4, 0)
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	testCases := []struct {
+		name    string
+		old     []abci.ValidatorUpdate
+		new     []abci.ValidatorUpdate
+		updates []abci.ValidatorUpdate
+	}{
+		{
+			"both validator sets are empty",
+			nil,
+			nil,
+			[]abci.ValidatorUpdate{},
+		},
+		{
+			"all validators are added",
+			nil,
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 2)},
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 2)},
+		},
+		{
+			"all validators are removed",
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 2)},
+			nil,
+			[]abci.ValidatorUpdate{update(0, 0), update(1, 0)},
+		},
+		{
+			"unchanged validators are omitted",
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 2)},
+			[]abci.ValidatorUpdate{update(1, 2), update(0, 1)},
+			[]abci.ValidatorUpdate{},
+		},
+		{
+			"validators are added, updated and removed",
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 2), update(2, 3)},
+			[]abci.ValidatorUpdate{update(3, 4), update(1, 5), update(2, 3)},
+			[]abci.ValidatorUpdate{update(3, 4), update(1, 5), update(0, 0)},
+		},
+		{
+			"new validators without power are omitted",
+			[]abci.ValidatorUpdate{update(0, 1)},
+			[]abci.ValidatorUpdate{update(0, 1), update(1, 0)},
+			[]abci.ValidatorUpdate{},
+		},
+		{
+			"validators are removed once",
+			[]abci.ValidatorUpdate{update(0, 1), update(0, 1), update(1, 0)},
+			nil,
+			[]abci.ValidatorUpdate{update(0, 0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		old := append([]abci.ValidatorUpdate{}, tc.old...)
+		new := append([]abci.ValidatorUpdate{}, tc.new...)
+		updates := providerkeeper.ComputeValidatorUpdates(tc.old, tc.new)
+		require.Equal(t, tc.updates, updates, tc.name)
+		// the validator sets are not modified
+		require.Equal(t, old, append([]abci.ValidatorUpdate{}, tc.old...), tc.name)
+		require.Equal(t, new, append([]abci.ValidatorUpdate{}, tc.new...), tc.name)
+	}
+}
+
+// TestGetHistoricalTopNValidatorUpdates tests that the top N validators are taken from
+// the validator set retained in the historical info of the given height
+func TestGetHistoricalTopNValidatorUpdates(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(4, 0)
+	valset := []stakingtypes.Validator{}
+	for i, power := range []int64{1, 4, 3, 0} {
+		val := ids[i].SDKStakingValidator()
+		val.Status = stakingtypes.Bonded
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		valset = append(valset, val)
+	}
+
+	mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(ctx, int64(5)).Return(
+		stakingtypes.HistoricalInfo{Valset: valset}, true).Times(2)
+	mocks.MockStakingKeeper.EXPECT().GetHistoricalInfo(ctx, int64(6)).Return(
+		stakingtypes.HistoricalInfo{}, false).Times(1)
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	// the validators are ordered by power and limited to the top N
+	updates, err := providerKeeper.GetHistoricalTopNValidatorUpdates(ctx, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 4), update(2, 3)}, updates)
+
+	// validators without power are left out
+	updates, err = providerKeeper.GetHistoricalTopNValidatorUpdates(ctx, 5, 4)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 4), update(2, 3), update(0, 1)}, updates)
+
+	// the historical info of the height is not retained
+	_, err = providerKeeper.GetHistoricalTopNValidatorUpdates(ctx, 6, 2)
+	require.ErrorIs(t, err, providertypes.ErrUnknownHistoricalValSet)
+}
+
+// TestComputeConsumerValSetChangesValidatorApproval tests that the validators joining the top N
+// of a consumer chain requiring validator approval are only added once approved
+func TestComputeConsumerValSetChangesValidatorApproval(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	mockPowers := func(powers ...int64) {
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for i, id := range ids {
+					if cb(id.SDKValOpAddress(), powers[i]) {
+						return
+					}
+				}
+			}).Times(1)
+		for _, id := range ids {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, id.SDKValOpAddress()).Return(
+				id.SDKStakingValidator(), true).Times(1)
+		}
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetValidatorApprovalRequired(ctx, "chainID")
+	providerKeeper.SetConsumerValSet(ctx, "chainID", []abci.ValidatorUpdate{update(0, 3)})
+
+	// the second validator joins the top 2 but is pending approval,
+	// while the power of the existing validator is still updated
+	mockPowers(4, 2, 1)
+	changes, err := providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", 2)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 4)}, changes)
+	require.Equal(t, []providertypes.ProviderConsAddress{ids[1].ProviderConsAddress()},
+		providerKeeper.GetAllPendingValidatorApprovals(ctx, "chainID"))
+
+	res, err := providerKeeper.QueryPendingValidatorApprovals(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryPendingValidatorApprovalsRequest{ChainId: "chainID"})
+	require.NoError(t, err)
+	require.Equal(t, []string{ids[1].SDKValConsAddress().String()}, res.ProviderAddrs)
+
+	// only the governance account can approve the validators of a consumer chain
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	_, err = msgServer.ApproveConsumerValidator(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgApproveConsumerValidator("invalid", "chainID", ids[1].SDKValConsAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ApproveConsumerValidator(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgApproveConsumerValidator(providerKeeper.GetAuthority(), "unknownChainID", ids[1].SDKValConsAddress()))
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	_, err = msgServer.ApproveConsumerValidator(sdk.WrapSDKContext(ctx),
+		providertypes.NewMsgApproveConsumerValidator(providerKeeper.GetAuthority(), "chainID", ids[1].SDKValConsAddress()))
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsApprovedValidator(ctx, "chainID", ids[1].ProviderConsAddress()))
+	require.Empty(t, providerKeeper.GetAllPendingValidatorApprovals(ctx, "chainID"))
+
+	// the approved validator is added, and the first validator leaves the top 1 as usual
+	mockPowers(1, 2, 0)
+	changes, err = providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", 1)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 2), update(0, 0)}, changes)
+	require.Empty(t, providerKeeper.GetAllPendingValidatorApprovals(ctx, "chainID"))
+
+	// approvals are rejected for consumer chains not requiring them
+	providerKeeper.DeleteValidatorApprovalRequired(ctx, "chainID")
+	err = providerKeeper.ApproveConsumerValidator(ctx, "chainID", ids[2].ProviderConsAddress())
+	require.ErrorIs(t, err, providertypes.ErrValidatorApprovalNotRequired)
+}
+
+// TestComputeConsumerValSetChangesPowerReduction tests that the powers of a consumer chain with a power
+// reduction are recomputed every block, i.e., also when the powers on the provider chain are unchanged
+func TestComputeConsumerValSetChangesPowerReduction(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	// the provider powers are unchanged, while the tokens of the validators may change
+	mockTokens := func(tokens ...int64) {
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(ctx, gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for i, id := range ids {
+					if cb(id.SDKValOpAddress(), int64(2-i)) {
+						return
+					}
+				}
+			}).Times(1)
+		for i, id := range ids {
+			val := id.SDKStakingValidator()
+			val.Tokens = sdk.NewInt(tokens[i])
+			mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, id.SDKValOpAddress()).Return(val, true).Times(1)
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, id.SDKValConsAddress()).Return(val, true).Times(1)
+		}
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	providerKeeper.SetConsumerPowerReduction(ctx, "chainID", sdk.NewInt(10))
+	providerKeeper.SetConsumerValSet(ctx, "chainID", []abci.ValidatorUpdate{update(0, 25), update(1, 15)})
+	// the validator set of consumer chains with a power reduction is tracked
+	topN, found := providerKeeper.GetTrackedValSetTopN(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, uint32(math.MaxUint32), topN)
+
+	// no changes are sent if the tokens are unchanged
+	mockTokens(250, 150)
+	changes, err := providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", topN)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// the tokens of the first validator change without changing its provider power
+	mockTokens(290, 150)
+	changes, err = providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", topN)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 29)}, changes)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 29), update(1, 15)}, providerKeeper.GetConsumerValSet(ctx, "chainID"))
+
+	// the power reduction changes while the provider powers are unchanged
+	providerKeeper.SetConsumerPowerReduction(ctx, "chainID", sdk.NewInt(100))
+	mockTokens(290, 150)
+	changes, err = providerKeeper.ComputeConsumerValSetChanges(ctx, "chainID", topN)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 2), update(1, 1)}, changes)
+
+	power, err := providerKeeper.GetConsumerValidatorPower(ctx, "chainID", ids[0].SDKStakingValidator())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), power)
+}
+
+// TestQueueVSCPacketsValidatorApprovalWithoutTopN tests that the validators joining the validator set
+// of a consumer chain requiring validator approval, but without a top N, are only added once approved
+func TestQueueVSCPacketsValidatorApprovalWithoutTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	// the validator updates are computed in a cached context
+	mockPowers := func(powers ...int64) {
+		mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, cb func(sdk.ValAddress, int64) bool) {
+				for i, id := range ids {
+					if cb(id.SDKValOpAddress(), powers[i]) {
+						return
+					}
+				}
+			}).Times(1)
+		for _, id := range ids {
+			mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), id.SDKValOpAddress()).Return(
+				id.SDKStakingValidator(), true).Times(1)
+		}
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: ids[i].TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	providerKeeper.SetValidatorApprovalRequired(ctx, "chainID")
+	providerKeeper.SetConsumerValSet(ctx, "chainID", []abci.ValidatorUpdate{update(0, 3)})
+	_, found := providerKeeper.GetConsumerTopN(ctx, "chainID")
+	require.False(t, found)
+	topN, found := providerKeeper.GetTrackedValSetTopN(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, uint32(math.MaxUint32), topN)
+
+	// the second validator bonds but is pending approval,
+	// while the power of the existing validator is still updated
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return(
+		[]abci.ValidatorUpdate{update(0, 4), update(1, 2)}).Times(1)
+	mockPowers(4, 2)
+	providerKeeper.QueueVSCPackets(ctx)
+	pending := providerKeeper.GetPendingVSCPackets(ctx, "chainID")
+	require.Len(t, pending, 1)
+	require.Equal(t, []abci.ValidatorUpdate{update(0, 4)}, pending[0].ValidatorUpdates)
+	require.Equal(t, []providertypes.ProviderConsAddress{ids[1].ProviderConsAddress()},
+		providerKeeper.GetAllPendingValidatorApprovals(ctx, "chainID"))
+	power, err := providerKeeper.GetConsumerValidatorPower(ctx, "chainID", ids[1].SDKStakingValidator())
+	require.NoError(t, err)
+	require.Zero(t, power)
+
+	// the approved validator is added with the next validator set change
+	require.NoError(t, providerKeeper.ApproveConsumerValidator(ctx, "chainID", ids[1].ProviderConsAddress()))
+	mocks.MockStakingKeeper.EXPECT().GetValidatorUpdates(ctx).Return([]abci.ValidatorUpdate{}).Times(1)
+	mockPowers(4, 2)
+	providerKeeper.QueueVSCPackets(ctx)
+	pending = providerKeeper.GetPendingVSCPackets(ctx, "chainID")
+	require.Len(t, pending, 2)
+	require.Equal(t, []abci.ValidatorUpdate{update(1, 2)}, pending[1].ValidatorUpdates)
+	require.Empty(t, providerKeeper.GetAllPendingValidatorApprovals(ctx, "chainID"))
+}
+
+// TestQueryValidatorConsumerChains tests that the consumer chains of a validator are the consumer chains
+// without a top N and the top N consumer chains whose validator set the validator is in
+func TestQueryValidatorConsumerChains(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	validator := ids[0].SDKStakingValidator()
+
+	providerKeeper.SetConsumerClientId(ctx, "chain-a", "client-a")
+	providerKeeper.SetConsumerClientId(ctx, "chain-b", "client-b")
+	providerKeeper.SetConsumerTopN(ctx, "chain-b", 1)
+	providerKeeper.SetConsumerValSet(ctx, "chain-b", []abci.ValidatorUpdate{{PubKey: ids[0].TMProtoCryptoPublicKey(), Power: 1}})
+	providerKeeper.SetConsumerClientId(ctx, "chain-c", "client-c")
+	providerKeeper.SetConsumerTopN(ctx, "chain-c", 1)
+	providerKeeper.SetConsumerValSet(ctx, "chain-c", []abci.ValidatorUpdate{{PubKey: ids[1].TMProtoCryptoPublicKey(), Power: 1}})
+
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, ids[0].SDKValConsAddress()).Return(validator, true).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, validator.GetOperator()).Return(int64(1)).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, ids[1].SDKValConsAddress()).Return(stakingtypes.Validator{}, false).Times(1),
+	)
+
+	res, err := providerKeeper.QueryValidatorConsumerChains(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryValidatorConsumerChainsRequest{ProviderAddress: ids[0].SDKValConsAddress().String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"chain-a", "chain-b"}, res.ChainIds)
+
+	// unknown validator
+	_, err = providerKeeper.QueryValidatorConsumerChains(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryValidatorConsumerChainsRequest{ProviderAddress: ids[1].SDKValConsAddress().String()})
+	require.Error(t, err)
+
+	// invalid provider address
+	_, err = providerKeeper.QueryValidatorConsumerChains(sdk.WrapSDKContext(ctx),
+		&providertypes.QueryValidatorConsumerChainsRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+}
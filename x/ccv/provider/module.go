@@ -99,8 +99,8 @@ func NewAppModule(k *keeper.Keeper) AppModule {
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements the AppModule interface
@@ -122,6 +122,11 @@ func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	providertypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	providertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register migration of %s to version 2: %v", providertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the provider module. It returns no validator updates.
@@ -144,14 +149,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	// Warn about the pending consumer chains whose client is altered by a change of the provider unbonding time
+	am.keeper.BeginBlockUnbondingTimeCheck(ctx)
 	// Create clients to consumer chains that are due to be spawned via pending consumer addition proposals
 	am.keeper.BeginBlockInit(ctx)
+	// Prune the expired idempotency tokens of consumer addition proposals
+	am.keeper.PruneExpiredIdempotencyTokens(ctx)
 	// Stop and remove state for any consumer chains that are due to be stopped via pending consumer removal proposals
 	am.keeper.BeginBlockCCR(ctx)
+	// Distribute the rewards received from consumer chains
+	am.keeper.BeginBlockRD(ctx)
 }
 
 // EndBlock implements the AppModule interface
@@ -163,6 +174,10 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	am.keeper.EndBlockCCR(ctx)
 	// EndBlock logic needed for the Validator Set Update sub-protocol
 	am.keeper.EndBlockVSU(ctx)
+	// EndBlock logic reconciling the consumer client and channel mappings with the IBC state
+	am.keeper.EndBlockReconcile(ctx)
+	// EndBlock logic needed for tracking the heights of the consumer chains
+	am.keeper.EndBlockCHT(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -9,7 +9,7 @@ import (
 )
 
 // NewProviderProposalHandler defines the handler for consumer addition,
-// consumer removal and equivocation proposals.
+// consumer removal, equivocation and reset consumer client proposals.
 // Passed proposals are executed during EndBlock.
 func NewProviderProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
@@ -20,6 +20,8 @@ func NewProviderProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.HandleConsumerRemovalProposal(ctx, c)
 		case *types.EquivocationProposal:
 			return k.HandleEquivocationProposal(ctx, c)
+		case *types.ResetConsumerClientProposal:
+			return k.HandleResetConsumerClientProposal(ctx, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ccv proposal content type: %T", c)
 		}
@@ -52,7 +52,7 @@ func TestProviderProposalHandler(t *testing.T) {
 		{
 			name: "valid consumer removal proposal",
 			content: providertypes.NewConsumerRemovalProposal(
-				"title", "description", "chainID", now),
+				"title", "description", "chainID", now, false, 0),
 			blockTime:               hourFromNow,
 			expValidConsumerRemoval: true,
 		},
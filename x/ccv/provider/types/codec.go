@@ -26,11 +26,24 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgAssignConsumerKey{},
+		&MsgRequeueConsumerAdditionProposal{},
+		&MsgPurgeConsumerState{},
+		&MsgPurgeAllPendingClients{},
+		&MsgUpdateParams{},
+		&MsgUpdateRewardDenomAllowlist{},
+		&MsgForceSpawnPendingClient{},
+		&MsgForceMatureVscPackets{},
+		&MsgApproveConsumerValidator{},
+		&MsgRenameConsumerChain{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&EquivocationProposal{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ResetConsumerClientProposal{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -1,10 +1,24 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consumertypes "github.com/cosmos/interchain-security/x/ccv/consumer/types"
 	ccv "github.com/cosmos/interchain-security/x/ccv/types"
 )
 
+// ConsumerGenesisVersion1 is the schema version prepended to the consumer genesis states
+// stored on the provider, so that they remain readable as the consumer genesis evolves
+const ConsumerGenesisVersion1 byte = 0x01
+
 func NewConsumerStates(
 	chainID,
 	clientID,
@@ -26,3 +40,202 @@ func NewConsumerStates(
 		SlashDowntimeAck:     slashDowntimeAck,
 	}
 }
+
+// legalConsumerPhaseTransitions maps every consumer phase to the phases it can transition to
+var legalConsumerPhaseTransitions = map[ConsumerPhase][]ConsumerPhase{
+	ConsumerPhaseUnspecified:        {ConsumerPhasePending, ConsumerPhaseClientCreated},
+	ConsumerPhasePending:            {ConsumerPhasePending, ConsumerPhaseClientCreated, ConsumerPhaseStopped},
+	ConsumerPhaseClientCreated:      {ConsumerPhaseChannelEstablished, ConsumerPhaseStopping, ConsumerPhaseStopped},
+	ConsumerPhaseChannelEstablished: {ConsumerPhaseActive, ConsumerPhaseStopping, ConsumerPhaseStopped},
+	ConsumerPhaseActive:             {ConsumerPhaseStopping, ConsumerPhaseStopped},
+	// a stopping consumer chain is stopped once the cooldown of its consumer removal proposal elapsed
+	ConsumerPhaseStopping: {ConsumerPhaseStopped},
+	// a stopped consumer chain can be added again via a new consumer addition proposal
+	ConsumerPhaseStopped: {ConsumerPhasePending, ConsumerPhaseClientCreated},
+}
+
+// CanTransitionTo returns true if a consumer chain in phase p can transition to phase next
+func (p ConsumerPhase) CanTransitionTo(next ConsumerPhase) bool {
+	for _, phase := range legalConsumerPhaseTransitions[p] {
+		if phase == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAdditionalGenesisState parses the genesis states of other modules of a consumer chain,
+// i.e., a JSON object mapping module names to their genesis states. The genesis state must be
+// at most MaxAdditionalGenesisStateLength bytes and must not contain the consumer CCV module genesis.
+// An empty genesis state is valid.
+func ParseAdditionalGenesisState(genesisState string) (map[string]json.RawMessage, error) {
+	if genesisState == "" {
+		return nil, nil
+	}
+	if len(genesisState) > MaxAdditionalGenesisStateLength {
+		return nil, fmt.Errorf("additional genesis state is too long; got: %d, max: %d",
+			len(genesisState), MaxAdditionalGenesisStateLength)
+	}
+
+	var modules map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(genesisState), &modules); err != nil {
+		return nil, fmt.Errorf("additional genesis state is not a JSON object: %w", err)
+	}
+	for module := range modules {
+		if strings.TrimSpace(module) == "" {
+			return nil, fmt.Errorf("additional genesis state contains a blank module name")
+		}
+		if module == consumertypes.ModuleName {
+			return nil, fmt.Errorf("additional genesis state cannot override the %s module genesis", consumertypes.ModuleName)
+		}
+	}
+	return modules, nil
+}
+
+// ValidateAdditionalGenesisState validates the genesis states of other modules of a consumer chain,
+// see ParseAdditionalGenesisState
+func ValidateAdditionalGenesisState(genesisState string) error {
+	_, err := ParseAdditionalGenesisState(genesisState)
+	return err
+}
+
+// ValidateInitialBalances validates the accounts pre-funded in the consumer genesis.
+// Since the addresses are addresses on the consumer chain, any bech32 prefix is accepted.
+// There can be at most MaxInitialBalances accounts, every account must be funded with
+// valid non-empty coins, and the total supply of every denom must fit into an sdk.Int.
+func ValidateInitialBalances(balances []banktypes.Balance) error {
+	if len(balances) > MaxInitialBalances {
+		return fmt.Errorf("too many initial balances; got: %d, max: %d", len(balances), MaxInitialBalances)
+	}
+
+	seen := map[string]bool{}
+	for _, balance := range balances {
+		_, bz, err := bech32.DecodeAndConvert(balance.Address)
+		if err != nil {
+			return fmt.Errorf("invalid initial balance address %s: %w", balance.Address, err)
+		}
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return fmt.Errorf("invalid initial balance address %s: %w", balance.Address, err)
+		}
+		if seen[string(bz)] {
+			return fmt.Errorf("duplicate initial balance address %s", balance.Address)
+		}
+		seen[string(bz)] = true
+
+		if balance.Coins.Empty() {
+			return fmt.Errorf("initial balance of %s is empty", balance.Address)
+		}
+		if err := balance.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid initial balance of %s: %w", balance.Address, err)
+		}
+	}
+
+	_, err := InitialBalancesSupply(balances)
+	return err
+}
+
+// InitialBalancesSupply returns the total supply of the given balances,
+// or an error if the total supply of a denom overflows an sdk.Int
+func InitialBalancesSupply(balances []banktypes.Balance) (sdk.Coins, error) {
+	// the maximum bit length of an sdk.Int
+	const maxBitLen = 256
+
+	totals := map[string]*big.Int{}
+	for _, balance := range balances {
+		for _, coin := range balance.Coins {
+			total, ok := totals[coin.Denom]
+			if !ok {
+				total = new(big.Int)
+				totals[coin.Denom] = total
+			}
+			total.Add(total, coin.Amount.BigInt())
+			if total.BitLen() > maxBitLen {
+				return nil, fmt.Errorf("total supply of %s overflows", coin.Denom)
+			}
+		}
+	}
+
+	supply := sdk.Coins{}
+	for denom, total := range totals {
+		supply = append(supply, sdk.NewCoin(denom, sdk.NewIntFromBigInt(total)))
+	}
+	return supply.Sort(), nil
+}
+
+// MergeConsumerAppState merges the genesis states of other modules of a consumer chain
+// into the given app_state of the consumer genesis. The initial balances are then added to
+// the bank module genesis, see MergeInitialBalances. The consumer CCV module genesis is set last,
+// i.e., it is always authoritative.
+func MergeConsumerAppState(
+	appState map[string]json.RawMessage,
+	additionalGenesisState string,
+	initialBalances []banktypes.Balance,
+	ccvGenesis json.RawMessage,
+) (map[string]json.RawMessage, error) {
+	modules, err := ParseAdditionalGenesisState(additionalGenesisState)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]json.RawMessage, len(appState)+len(modules)+1)
+	for module, genesis := range appState {
+		merged[module] = genesis
+	}
+	for module, genesis := range modules {
+		merged[module] = genesis
+	}
+	if len(initialBalances) > 0 {
+		bankGenesis, err := MergeInitialBalances(merged[banktypes.ModuleName], initialBalances)
+		if err != nil {
+			return nil, err
+		}
+		merged[banktypes.ModuleName] = bankGenesis
+	}
+	merged[consumertypes.ModuleName] = ccvGenesis
+	return merged, nil
+}
+
+// MergeInitialBalances adds the initial balances to the given bank module genesis of a consumer chain,
+// or to the default bank module genesis if none is given. The accounts of the initial balances must not
+// be funded already. If the given genesis sets a total supply, it must be consistent with its balances.
+// The total supply of the merged genesis is the sum of all balances.
+func MergeInitialBalances(genesis json.RawMessage, initialBalances []banktypes.Balance) (json.RawMessage, error) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	if genesis != nil {
+		if err := cdc.UnmarshalJSON(genesis, bankGenesis); err != nil {
+			return nil, fmt.Errorf("invalid %s module genesis: %w", banktypes.ModuleName, err)
+		}
+	}
+
+	if !bankGenesis.Supply.Empty() {
+		supply, err := InitialBalancesSupply(bankGenesis.Balances)
+		if err != nil {
+			return nil, err
+		}
+		if !bankGenesis.Supply.IsEqual(supply) {
+			return nil, fmt.Errorf("total supply %s of the %s module genesis does not match its balances %s",
+				bankGenesis.Supply, banktypes.ModuleName, supply)
+		}
+	}
+
+	funded := map[string]bool{}
+	for _, balance := range bankGenesis.Balances {
+		funded[balance.Address] = true
+	}
+	for _, balance := range initialBalances {
+		if funded[balance.Address] {
+			return nil, fmt.Errorf("account %s is already funded in the %s module genesis", balance.Address, banktypes.ModuleName)
+		}
+	}
+
+	bankGenesis.Balances = append(bankGenesis.Balances, initialBalances...)
+	supply, err := InitialBalancesSupply(bankGenesis.Balances)
+	if err != nil {
+		return nil, err
+	}
+	bankGenesis.Supply = supply
+
+	return cdc.MarshalJSON(bankGenesis)
+}
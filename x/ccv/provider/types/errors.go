@@ -6,17 +6,34 @@ import (
 
 // Provider sentinel errors
 var (
-	ErrInvalidConsumerAdditionProposal  = sdkerrors.Register(ModuleName, 1, "invalid consumer addition proposal")
-	ErrInvalidConsumerRemovalProp       = sdkerrors.Register(ModuleName, 2, "invalid consumer removal proposal")
-	ErrUnknownConsumerChainId           = sdkerrors.Register(ModuleName, 3, "no consumer chain with this chain id")
-	ErrUnknownConsumerChannelId         = sdkerrors.Register(ModuleName, 4, "no consumer chain with this channel id")
-	ErrInvalidConsumerConsensusPubKey   = sdkerrors.Register(ModuleName, 5, "empty consumer consensus public key")
-	ErrBlankConsumerChainID             = sdkerrors.Register(ModuleName, 6, "consumer chain id must not be blank")
-	ErrConsumerKeyNotFound              = sdkerrors.Register(ModuleName, 7, "consumer key not found")
-	ErrNoValidatorConsumerAddress       = sdkerrors.Register(ModuleName, 8, "error getting validator consumer address")
-	ErrNoValidatorProviderAddress       = sdkerrors.Register(ModuleName, 9, "error getting validator provider address")
-	ErrConsumerKeyInUse                 = sdkerrors.Register(ModuleName, 10, "consumer key is already in use by a validator")
-	ErrCannotAssignDefaultKeyAssignment = sdkerrors.Register(ModuleName, 11, "cannot re-assign default key assignment")
-	ErrInvalidConsumerParams            = sdkerrors.Register(ModuleName, 12, "invalid consumer params")
-	ErrInvalidProviderAddress           = sdkerrors.Register(ModuleName, 13, "invalid provider address")
+	ErrInvalidConsumerAdditionProposal    = sdkerrors.Register(ModuleName, 1, "invalid consumer addition proposal")
+	ErrInvalidConsumerRemovalProp         = sdkerrors.Register(ModuleName, 2, "invalid consumer removal proposal")
+	ErrUnknownConsumerChainId             = sdkerrors.Register(ModuleName, 3, "no consumer chain with this chain id")
+	ErrUnknownConsumerChannelId           = sdkerrors.Register(ModuleName, 4, "no consumer chain with this channel id")
+	ErrInvalidConsumerConsensusPubKey     = sdkerrors.Register(ModuleName, 5, "empty consumer consensus public key")
+	ErrBlankConsumerChainID               = sdkerrors.Register(ModuleName, 6, "consumer chain id must not be blank")
+	ErrConsumerKeyNotFound                = sdkerrors.Register(ModuleName, 7, "consumer key not found")
+	ErrNoValidatorConsumerAddress         = sdkerrors.Register(ModuleName, 8, "error getting validator consumer address")
+	ErrNoValidatorProviderAddress         = sdkerrors.Register(ModuleName, 9, "error getting validator provider address")
+	ErrConsumerKeyInUse                   = sdkerrors.Register(ModuleName, 10, "consumer key is already in use by a validator")
+	ErrCannotAssignDefaultKeyAssignment   = sdkerrors.Register(ModuleName, 11, "cannot re-assign default key assignment")
+	ErrInvalidConsumerParams              = sdkerrors.Register(ModuleName, 12, "invalid consumer params")
+	ErrInvalidProviderAddress             = sdkerrors.Register(ModuleName, 13, "invalid provider address")
+	ErrInvalidConsumerPhaseTransition     = sdkerrors.Register(ModuleName, 14, "invalid consumer phase transition")
+	ErrUnknownFailedConsumerAdditionProp  = sdkerrors.Register(ModuleName, 15, "no failed consumer addition proposal with this chain id")
+	ErrEmptyValidatorSet                  = sdkerrors.Register(ModuleName, 16, "empty validator set")
+	ErrDuplicateIdempotencyToken          = sdkerrors.Register(ModuleName, 17, "duplicate idempotency token")
+	ErrConsumerStateNotPreserved          = sdkerrors.Register(ModuleName, 18, "no preserved state for this consumer chain")
+	ErrInvalidConsumerGenesis             = sdkerrors.Register(ModuleName, 19, "invalid consumer genesis")
+	ErrInsufficientProviderPower          = sdkerrors.Register(ModuleName, 20, "insufficient provider power")
+	ErrInvalidResetConsumerClientProp     = sdkerrors.Register(ModuleName, 21, "invalid reset consumer client proposal")
+	ErrInvalidParams                      = sdkerrors.Register(ModuleName, 22, "invalid provider params")
+	ErrRewardDenomNotAllowed              = sdkerrors.Register(ModuleName, 23, "reward denom not allowed")
+	ErrUnknownPendingConsumerAdditionProp = sdkerrors.Register(ModuleName, 24, "no pending consumer addition proposal with this chain id")
+	ErrValidatorApprovalNotRequired       = sdkerrors.Register(ModuleName, 25, "consumer chain does not require validator approval")
+	ErrInvalidConsumerChainRename         = sdkerrors.Register(ModuleName, 26, "invalid consumer chain rename")
+	ErrUnknownHistoricalValSet            = sdkerrors.Register(ModuleName, 27, "no historical validator set retained for this height")
+	ErrUnknownInfractionHeight            = sdkerrors.Register(ModuleName, 28, "no infraction height for this valset update id")
+	ErrInvalidInfractionType              = sdkerrors.Register(ModuleName, 29, "invalid infraction type")
+	ErrInvalidInitialHeight               = sdkerrors.Register(ModuleName, 30, "invalid initial height")
 )
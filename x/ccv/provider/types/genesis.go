@@ -1,7 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -92,6 +94,64 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	for _, failedProp := range gs.FailedConsumerAdditionProposals {
+		if err := failedProp.Proposal.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if failedProp.FailureCount == 0 {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis,
+				fmt.Sprintf("failed consumer addition proposal without failures for consumer chain id: %s", failedProp.Proposal.ChainId))
+		}
+	}
+
+	for _, token := range gs.IdempotencyTokens {
+		if err := token.Validate(); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	historyChainIDs := map[string]bool{}
+	for _, history := range gs.ConsumerClientHistories {
+		if err := history.Validate(); err != nil {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("%s: for consumer chain id: %s", err, history.ChainId))
+		}
+		if historyChainIDs[history.ChainId] {
+			return sdkerrors.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("duplicate client history for consumer chain id: %s", history.ChainId))
+		}
+		historyChainIDs[history.ChainId] = true
+	}
+
+	return nil
+}
+
+// Validate performs an idempotency token validation returning an error upon any failure
+func (t IdempotencyToken) Validate() error {
+	if strings.TrimSpace(t.ChainId) == "" {
+		return fmt.Errorf("idempotency token chain id cannot be blank")
+	}
+	if t.Token == "" || len(t.Token) > MaxIdempotencyTokenLength {
+		return fmt.Errorf("idempotency token must be between 1 and %d characters", MaxIdempotencyTokenLength)
+	}
+	if t.Expiry.IsZero() {
+		return fmt.Errorf("idempotency token expiry time cannot be zero")
+	}
+	return nil
+}
+
+// Validate performs a client history validation returning an error upon any failure.
+// It ensures that the history has at most MaxConsumerClientHistory records with valid client ids.
+func (h ConsumerClientHistory) Validate() error {
+	if strings.TrimSpace(h.ChainId) == "" {
+		return fmt.Errorf("client history chain id cannot be blank")
+	}
+	if len(h.Records) > MaxConsumerClientHistory {
+		return fmt.Errorf("client history cannot have more than %d records", MaxConsumerClientHistory)
+	}
+	for _, record := range h.Records {
+		if err := host.ClientIdentifierValidator(record.ClientId); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -147,10 +207,82 @@ func (cs ConsumerState) Validate() error {
 
 	// validate optional fields
 
+	switch cs.Phase {
+	case ConsumerPhaseUnspecified:
+	case ConsumerPhaseClientCreated:
+		if cs.ChannelId != "" {
+			return fmt.Errorf("consumer chain in phase %s cannot have a CCV channel", cs.Phase)
+		}
+	case ConsumerPhaseChannelEstablished, ConsumerPhaseActive:
+		if cs.ChannelId == "" {
+			return fmt.Errorf("consumer chain in phase %s must have a CCV channel", cs.Phase)
+		}
+	case ConsumerPhaseStopping:
+	default:
+		return fmt.Errorf("invalid consumer chain phase: %s", cs.Phase)
+	}
+
 	if err := validateSlashAcksAddress(cs.SlashDowntimeAck); err != nil {
 		return err
 	}
 
+	// the validator set is only tracked for top N consumer chains, i.e., with a non-zero top N,
+	// for consumer chains requiring validator approval and for consumer chains with a power reduction
+	tracksAllValidators := cs.ValidatorApprovalRequired || cs.PowerReduction != ""
+	if (cs.TopN != 0 || !tracksAllValidators) && uint32(len(cs.ValidatorSet)) > cs.TopN {
+		return fmt.Errorf("validator set of consumer chain cannot have more than %d validators", cs.TopN)
+	}
+	if err := ccv.ValidateValidatorUpdatesPower(cs.ValidatorSet, false); err != nil {
+		return err
+	}
+
+	if len(cs.AcceptedGenesisHash) != 0 && len(cs.AcceptedGenesisHash) != sha256.Size {
+		return fmt.Errorf("accepted genesis hash must be %d bytes long", sha256.Size)
+	}
+
+	if cs.PowerReduction != "" {
+		if _, err := ParseConsumerPowerReduction(cs.PowerReduction); err != nil {
+			return err
+		}
+	}
+
+	if cs.PowerMultiplier != "" {
+		if _, err := ParsePowerMultiplier(cs.PowerMultiplier); err != nil {
+			return err
+		}
+	}
+
+	if cs.CandidateClientId != "" {
+		if err := host.ClientIdentifierValidator(cs.CandidateClientId); err != nil {
+			return fmt.Errorf("invalid candidate client id: %s", err)
+		}
+		if cs.CandidateClientId == cs.ClientId {
+			return fmt.Errorf("candidate client cannot be the client of the consumer chain")
+		}
+	}
+
+	if cs.DowntimeJailDuration < 0 {
+		return fmt.Errorf("downtime jail duration cannot be negative")
+	}
+
+	if cs.VscPacketTimeoutPeriod < 0 {
+		return fmt.Errorf("VSC packet timeout period cannot be negative")
+	}
+
+	if err := ValidateRewardDenomAllowlist(cs.RewardDenomAllowlist); err != nil {
+		return err
+	}
+
+	if _, err := ccv.ParseRelayerAllowlist(cs.RelayerAllowlist); err != nil {
+		return err
+	}
+
+	for _, addr := range cs.ApprovedValidators {
+		if _, err := sdk.ConsAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid approved validator address %s: %s", addr, err)
+		}
+	}
+
 	for _, pVSC := range cs.PendingValsetChanges {
 		if pVSC.ValsetUpdateId == 0 {
 			return fmt.Errorf("valset update ID cannot be equal to zero")
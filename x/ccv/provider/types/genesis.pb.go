@@ -9,16 +9,22 @@ import (
 	types "github.com/cosmos/interchain-security/x/ccv/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types2 "github.com/tendermint/tendermint/abci/types"
 	_ "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -49,6 +55,12 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPrune []ConsumerAddrsToPrune `protobuf:"bytes,11,rep,name=consumer_addrs_to_prune,json=consumerAddrsToPrune,proto3" json:"consumer_addrs_to_prune"`
+	// empty for a new chain
+	FailedConsumerAdditionProposals []FailedConsumerAdditionProposal `protobuf:"bytes,12,rep,name=failed_consumer_addition_proposals,json=failedConsumerAdditionProposals,proto3" json:"failed_consumer_addition_proposals"`
+	// empty for a new chain
+	IdempotencyTokens []IdempotencyToken `protobuf:"bytes,13,rep,name=idempotency_tokens,json=idempotencyTokens,proto3" json:"idempotency_tokens"`
+	// empty for a new chain
+	ConsumerClientHistories []ConsumerClientHistory `protobuf:"bytes,14,rep,name=consumer_client_histories,json=consumerClientHistories,proto3" json:"consumer_client_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -162,6 +174,27 @@ func (m *GenesisState) GetConsumerAddrsToPrune() []ConsumerAddrsToPrune {
 }
 
 // consumer chain
+func (m *GenesisState) GetFailedConsumerAdditionProposals() []FailedConsumerAdditionProposal {
+	if m != nil {
+		return m.FailedConsumerAdditionProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIdempotencyTokens() []IdempotencyToken {
+	if m != nil {
+		return m.IdempotencyTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerClientHistories() []ConsumerClientHistory {
+	if m != nil {
+		return m.ConsumerClientHistories
+	}
+	return nil
+}
+
 type ConsumerState struct {
 	// ChainID defines the chain ID for the consumer chain
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -178,6 +211,51 @@ type ConsumerState struct {
 	SlashDowntimeAck     []string                             `protobuf:"bytes,7,rep,name=slash_downtime_ack,json=slashDowntimeAck,proto3" json:"slash_downtime_ack,omitempty"`
 	// UnbondingOpsIndex defines the unbonding operations waiting on this consumer chain
 	UnbondingOpsIndex []VscUnbondingOps `protobuf:"bytes,8,rep,name=unbonding_ops_index,json=unbondingOpsIndex,proto3" json:"unbonding_ops_index"`
+	// SlashEnabled defines whether downtime infractions on the consumer chain are slashed
+	SlashEnabled bool `protobuf:"varint,9,opt,name=slash_enabled,json=slashEnabled,proto3" json:"slash_enabled,omitempty"`
+	// Phase defines the current phase of the consumer chain lifecycle
+	Phase ConsumerPhase `protobuf:"varint,10,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// TopN defines the number of validators, selected by power, that validate the consumer chain.
+	// If zero, all the bonded validators validate the consumer chain.
+	TopN uint32 `protobuf:"varint,11,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// ValidatorSet defines the validator set (with provider keys) last sent to a top N consumer chain
+	ValidatorSet []types2.ValidatorUpdate `protobuf:"bytes,12,rep,name=validator_set,json=validatorSet,proto3" json:"validator_set"`
+	// AcceptedGenesisHash defines the hash of the genesis state the consumer chain
+	// confirmed it started with, i.e., empty until a GenesisAccepted packet is received
+	AcceptedGenesisHash []byte `protobuf:"bytes,13,opt,name=accepted_genesis_hash,json=acceptedGenesisHash,proto3" json:"accepted_genesis_hash,omitempty"`
+	// PowerReduction defines the power reduction used to compute the voting powers sent
+	// to the consumer chain, i.e., empty if the voting powers on the provider chain are sent
+	PowerReduction string `protobuf:"bytes,14,opt,name=power_reduction,json=powerReduction,proto3" json:"power_reduction,omitempty"`
+	// PowerMultiplier defines the multiplier applied to the voting powers sent
+	// to the consumer chain, i.e., empty if the voting powers are not scaled
+	PowerMultiplier string `protobuf:"bytes,15,opt,name=power_multiplier,json=powerMultiplier,proto3" json:"power_multiplier,omitempty"`
+	// RewardDenomAllowlist defines the denoms the consumer chain may send as rewards,
+	// i.e., empty if all denoms are accepted
+	RewardDenomAllowlist []string `protobuf:"bytes,16,rep,name=reward_denom_allowlist,json=rewardDenomAllowlist,proto3" json:"reward_denom_allowlist,omitempty"`
+	// RelayerAllowlist defines the relayers the consumer chain accepts CCV packets from,
+	// i.e., empty if CCV packets relayed by any address are accepted
+	RelayerAllowlist []string `protobuf:"bytes,17,rep,name=relayer_allowlist,json=relayerAllowlist,proto3" json:"relayer_allowlist,omitempty"`
+	// DowntimeJailDuration defines the duration for which validators are jailed for downtime
+	// infractions on the consumer chain, i.e., zero if the provider slashing param is used
+	DowntimeJailDuration time.Duration `protobuf:"bytes,18,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// InitParams defines the initialization parameters the consumer chain was spawned with,
+	// i.e., not set if the consumer chain was spawned before they were retained
+	InitParams *ConsumerInitParams `protobuf:"bytes,19,opt,name=init_params,json=initParams,proto3" json:"init_params,omitempty"`
+	// SpawnHeight defines the provider block height at which the consumer client was created,
+	// i.e., zero if the consumer chain was spawned before it was retained
+	SpawnHeight uint64 `protobuf:"varint,20,opt,name=spawn_height,json=spawnHeight,proto3" json:"spawn_height,omitempty"`
+	// ValidatorApprovalRequired defines whether the validators joining the validator set
+	// of the consumer chain must be approved
+	ValidatorApprovalRequired bool `protobuf:"varint,21,opt,name=validator_approval_required,json=validatorApprovalRequired,proto3" json:"validator_approval_required,omitempty"`
+	// ApprovedValidators defines the provider consensus addresses of the validators
+	// approved to join the validator set of the consumer chain
+	ApprovedValidators []string `protobuf:"bytes,22,rep,name=approved_validators,json=approvedValidators,proto3" json:"approved_validators,omitempty"`
+	// VscPacketTimeoutPeriod defines the timeout period of the VSC packets sent to the consumer chain,
+	// i.e., zero if the ccv_timeout_period of the provider params is used
+	VscPacketTimeoutPeriod time.Duration `protobuf:"bytes,23,opt,name=vsc_packet_timeout_period,json=vscPacketTimeoutPeriod,proto3,stdduration" json:"vsc_packet_timeout_period"`
+	// CandidateClientId defines the client that replaces the client of the consumer chain once promoted,
+	// i.e., empty if there is no candidate client
+	CandidateClientId string `protobuf:"bytes,24,opt,name=candidate_client_id,json=candidateClientId,proto3" json:"candidate_client_id,omitempty"`
 }
 
 func (m *ConsumerState) Reset()         { *m = ConsumerState{} }
@@ -269,11 +347,126 @@ func (m *ConsumerState) GetUnbondingOpsIndex() []VscUnbondingOps {
 	return nil
 }
 
+func (m *ConsumerState) GetSlashEnabled() bool {
+	if m != nil {
+		return m.SlashEnabled
+	}
+	return false
+}
+
+func (m *ConsumerState) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ConsumerPhaseUnspecified
+}
+
+func (m *ConsumerState) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetValidatorSet() []types2.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetAcceptedGenesisHash() []byte {
+	if m != nil {
+		return m.AcceptedGenesisHash
+	}
+	return nil
+}
+
 // ValsetUpdateIdToHeight defines the genesis information for the mapping
 // of each valset udpate id to a block height
+func (m *ConsumerState) GetPowerReduction() string {
+	if m != nil {
+		return m.PowerReduction
+	}
+	return ""
+}
+
+func (m *ConsumerState) GetPowerMultiplier() string {
+	if m != nil {
+		return m.PowerMultiplier
+	}
+	return ""
+}
+
+func (m *ConsumerState) GetRewardDenomAllowlist() []string {
+	if m != nil {
+		return m.RewardDenomAllowlist
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetRelayerAllowlist() []string {
+	if m != nil {
+		return m.RelayerAllowlist
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetInitParams() *ConsumerInitParams {
+	if m != nil {
+		return m.InitParams
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetSpawnHeight() uint64 {
+	if m != nil {
+		return m.SpawnHeight
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetValidatorApprovalRequired() bool {
+	if m != nil {
+		return m.ValidatorApprovalRequired
+	}
+	return false
+}
+
+func (m *ConsumerState) GetApprovedValidators() []string {
+	if m != nil {
+		return m.ApprovedValidators
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetVscPacketTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.VscPacketTimeoutPeriod
+	}
+	return 0
+}
+
+func (m *ConsumerState) GetCandidateClientId() string {
+	if m != nil {
+		return m.CandidateClientId
+	}
+	return ""
+}
+
 type ValsetUpdateIdToHeight struct {
 	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
 	Height         uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time of the block that mapped the valset update id;
+	// zero if unknown
+	BlockTime time.Time `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
 }
 
 func (m *ValsetUpdateIdToHeight) Reset()         { *m = ValsetUpdateIdToHeight{} }
@@ -323,10 +516,134 @@ func (m *ValsetUpdateIdToHeight) GetHeight() uint64 {
 	return 0
 }
 
+func (m *ValsetUpdateIdToHeight) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// IdempotencyToken is an idempotency token of a consumer chain together with its expiry time,
+// i.e., the time until which proposals for the consumer chain with the same token are rejected
+type IdempotencyToken struct {
+	ChainId string    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Token   string    `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Expiry  time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *IdempotencyToken) Reset()         { *m = IdempotencyToken{} }
+func (m *IdempotencyToken) String() string { return proto.CompactTextString(m) }
+func (*IdempotencyToken) ProtoMessage()    {}
+func (*IdempotencyToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{3}
+}
+func (m *IdempotencyToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdempotencyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdempotencyToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdempotencyToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdempotencyToken.Merge(m, src)
+}
+func (m *IdempotencyToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdempotencyToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdempotencyToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdempotencyToken proto.InternalMessageInfo
+
+func (m *IdempotencyToken) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *IdempotencyToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *IdempotencyToken) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// ConsumerClientHistory is the client history of a consumer chain, ordered from the oldest client
+type ConsumerClientHistory struct {
+	ChainId string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Records []ConsumerClientRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ConsumerClientHistory) Reset()         { *m = ConsumerClientHistory{} }
+func (m *ConsumerClientHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsumerClientHistory) ProtoMessage()    {}
+func (*ConsumerClientHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{4}
+}
+func (m *ConsumerClientHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerClientHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerClientHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerClientHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerClientHistory.Merge(m, src)
+}
+func (m *ConsumerClientHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerClientHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerClientHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerClientHistory proto.InternalMessageInfo
+
+func (m *ConsumerClientHistory) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerClientHistory) GetRecords() []ConsumerClientRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchain_security.ccv.provider.v1.GenesisState")
 	proto.RegisterType((*ConsumerState)(nil), "interchain_security.ccv.provider.v1.ConsumerState")
 	proto.RegisterType((*ValsetUpdateIdToHeight)(nil), "interchain_security.ccv.provider.v1.ValsetUpdateIdToHeight")
+	proto.RegisterType((*IdempotencyToken)(nil), "interchain_security.ccv.provider.v1.IdempotencyToken")
+	proto.RegisterType((*ConsumerClientHistory)(nil), "interchain_security.ccv.provider.v1.ConsumerClientHistory")
 }
 
 func init() {
@@ -334,61 +651,100 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0xef, 0xa6, 0xdb, 0xcd, 0x6c, 0x77, 0x59, 0x86, 0x55, 0x70, 0xb3, 0x90, 0xae, 0x02,
-	0x48, 0x91, 0x00, 0x1b, 0x07, 0x0e, 0x50, 0xe0, 0xd0, 0xb4, 0x12, 0x44, 0x08, 0x11, 0xa5, 0xdb,
-	0x1e, 0xca, 0xc1, 0x9a, 0x8c, 0x47, 0xc9, 0x10, 0x7b, 0xc6, 0x9a, 0x19, 0x9b, 0x5a, 0x08, 0x89,
-	0x8a, 0x3f, 0xc0, 0xbf, 0xa2, 0xc7, 0x1e, 0x39, 0x55, 0x68, 0xf7, 0x1f, 0xf0, 0x0b, 0x90, 0xc7,
-	0x63, 0xd7, 0x5e, 0x12, 0x48, 0xb8, 0xc5, 0xef, 0x9b, 0xf7, 0x7d, 0xef, 0xcd, 0x9b, 0xf9, 0x26,
-	0xc0, 0xa3, 0x4c, 0x11, 0x81, 0x17, 0x88, 0x32, 0x5f, 0x12, 0x9c, 0x08, 0xaa, 0x32, 0x17, 0xe3,
-	0xd4, 0x8d, 0x05, 0x4f, 0x69, 0x40, 0x84, 0x9b, 0x7a, 0xee, 0x9c, 0x30, 0x22, 0xa9, 0x74, 0x62,
-	0xc1, 0x15, 0x87, 0xef, 0xac, 0x48, 0x71, 0x30, 0x4e, 0x9d, 0x32, 0xc5, 0x49, 0xbd, 0xee, 0xe9,
-	0x9c, 0xcf, 0xb9, 0x5e, 0xef, 0xe6, 0xbf, 0x8a, 0xd4, 0xee, 0xbb, 0xeb, 0xd4, 0x52, 0xcf, 0x35,
-	0x0c, 0x8a, 0x77, 0x87, 0x9b, 0xd4, 0x54, 0x89, 0xfd, 0x47, 0x0e, 0xe6, 0x4c, 0x26, 0x51, 0x91,
-	0x53, 0xfe, 0x36, 0x39, 0xde, 0x26, 0x39, 0x8d, 0xde, 0xbb, 0x6f, 0x29, 0xc2, 0x02, 0x22, 0x22,
-	0xca, 0x94, 0x8b, 0x45, 0x16, 0x2b, 0xee, 0x2e, 0x49, 0x66, 0xd0, 0xfe, 0xef, 0x6d, 0x70, 0xeb,
-	0xab, 0x62, 0xfd, 0x43, 0x85, 0x14, 0x81, 0x03, 0x70, 0x92, 0xa2, 0x50, 0x12, 0xe5, 0x27, 0x71,
-	0x80, 0x14, 0xf1, 0x69, 0x60, 0x5b, 0xe7, 0xd6, 0xa0, 0x35, 0x3d, 0x2e, 0xe2, 0x8f, 0x74, 0x78,
-	0x1c, 0xc0, 0x9f, 0xc0, 0x6b, 0xa5, 0xaa, 0x2f, 0xf3, 0x5c, 0x69, 0xef, 0x9e, 0xef, 0x0d, 0x0e,
-	0x87, 0x43, 0x67, 0x83, 0xed, 0x76, 0xee, 0x9b, 0x5c, 0x2d, 0x3b, 0xea, 0x3d, 0x7f, 0x79, 0x67,
-	0xe7, 0xaf, 0x97, 0x77, 0x3a, 0x19, 0x8a, 0xc2, 0xbb, 0xfd, 0x6b, 0xc4, 0xfd, 0xe9, 0x31, 0xae,
-	0x2f, 0x97, 0xf0, 0x7b, 0x70, 0x94, 0xb0, 0x19, 0x67, 0x01, 0x65, 0x73, 0x9f, 0xc7, 0xd2, 0xde,
-	0xd3, 0xd2, 0x1f, 0x6d, 0x24, 0xfd, 0xa8, 0xcc, 0xfc, 0x2e, 0x1e, 0xb5, 0x72, 0xe1, 0xe9, 0xad,
-	0xe4, 0x55, 0x48, 0x42, 0x04, 0x4e, 0x23, 0xa4, 0x12, 0x41, 0xfc, 0xa6, 0x46, 0xeb, 0xdc, 0x1a,
-	0x1c, 0x0e, 0xdd, 0xb5, 0x1a, 0xa9, 0xe7, 0x7c, 0xab, 0xf3, 0x82, 0x9a, 0x82, 0x9c, 0xc2, 0x82,
-	0xac, 0x1e, 0x83, 0x3f, 0x83, 0xee, 0xf5, 0x6d, 0xf6, 0x15, 0xf7, 0x17, 0x84, 0xce, 0x17, 0xca,
-	0xbe, 0xa1, 0x9b, 0xf9, 0x7c, 0xa3, 0x66, 0x1e, 0x37, 0xa6, 0x72, 0xc1, 0xbf, 0xd6, 0x14, 0xa6,
-	0xaf, 0x4e, 0xba, 0x12, 0x85, 0xbf, 0x5a, 0xe0, 0xac, 0xda, 0x63, 0x14, 0x04, 0x54, 0x51, 0xce,
-	0xfc, 0x58, 0xf0, 0x98, 0x4b, 0x14, 0x4a, 0x7b, 0x5f, 0x17, 0xf0, 0xe5, 0x56, 0x83, 0xbc, 0x67,
-	0x68, 0x26, 0x86, 0xc5, 0x94, 0x70, 0x1b, 0xaf, 0xc1, 0x25, 0xfc, 0xc5, 0x02, 0xdd, 0xaa, 0x0a,
-	0x41, 0x22, 0x9e, 0xa2, 0xb0, 0x56, 0xc4, 0x4d, 0x5d, 0xc4, 0x17, 0x5b, 0x15, 0x31, 0x2d, 0x58,
-	0xae, 0xd5, 0x60, 0xe3, 0xd5, 0xb0, 0x84, 0x63, 0xb0, 0x1f, 0x23, 0x81, 0x22, 0x69, 0x1f, 0xe8,
-	0xe1, 0xbe, 0xbf, 0x91, 0xda, 0x44, 0xa7, 0x18, 0x72, 0x43, 0xa0, 0xbb, 0x49, 0x51, 0x48, 0x03,
-	0xa4, 0xb8, 0xf0, 0xab, 0xbe, 0xe2, 0x64, 0x96, 0xdf, 0x37, 0xbb, 0xbd, 0x45, 0x37, 0x8f, 0x4b,
-	0x9a, 0xb2, 0xad, 0x49, 0x32, 0xfb, 0x86, 0x64, 0x65, 0x37, 0xe9, 0x0a, 0x38, 0xd7, 0x80, 0xcf,
-	0x2c, 0x70, 0x56, 0x81, 0xd2, 0x9f, 0x65, 0x7e, 0x7d, 0xc8, 0xc2, 0x06, 0xff, 0xa7, 0x86, 0x51,
-	0x56, 0x9b, 0xb0, 0xf8, 0x47, 0x0d, 0xb2, 0x89, 0xc3, 0x14, 0xbc, 0xd9, 0x10, 0x95, 0xf9, 0xb9,
-	0x8e, 0x45, 0xc2, 0x88, 0x7d, 0xa8, 0xe5, 0x3f, 0xdb, 0xf6, 0x54, 0x09, 0x79, 0xc1, 0x27, 0x39,
-	0x81, 0xd1, 0x3e, 0xc5, 0x2b, 0xb0, 0xfe, 0xb3, 0x16, 0x38, 0x6a, 0x78, 0x0a, 0xbc, 0x0d, 0x0e,
-	0x0a, 0x11, 0x63, 0x61, 0xed, 0xe9, 0x4d, 0xfd, 0x3d, 0x0e, 0xe0, 0xdb, 0x00, 0xe0, 0x05, 0x62,
-	0x8c, 0x84, 0x39, 0xb8, 0xab, 0xc1, 0xb6, 0x89, 0x8c, 0x03, 0x78, 0x06, 0xda, 0x38, 0xa4, 0x84,
-	0xa9, 0x1c, 0xdd, 0xd3, 0xe8, 0x41, 0x11, 0x18, 0x07, 0xf0, 0x3d, 0x70, 0x4c, 0x19, 0x55, 0x14,
-	0x85, 0xe5, 0x75, 0x6d, 0x69, 0x7f, 0x3c, 0x32, 0x51, 0x73, 0xc5, 0x66, 0xe0, 0xa4, 0xda, 0x07,
-	0xe3, 0xc8, 0xf6, 0x0d, 0x7d, 0xc6, 0xbc, 0xb5, 0x1b, 0x50, 0xb9, 0x7d, 0xea, 0x39, 0x75, 0x57,
-	0x36, 0x8d, 0x57, 0x7e, 0x6b, 0x30, 0xa8, 0x40, 0x27, 0x26, 0x85, 0x3f, 0x19, 0x37, 0xc9, 0x7b,
-	0x98, 0x93, 0xf2, 0x02, 0x7f, 0xfa, 0x6f, 0x56, 0x55, 0x0d, 0xf8, 0x21, 0x51, 0xf7, 0x75, 0xda,
-	0x04, 0xe1, 0x25, 0x51, 0x0f, 0x90, 0x42, 0xe5, 0x4e, 0x1b, 0xf6, 0xc2, 0x63, 0x8a, 0x45, 0x12,
-	0x7e, 0x00, 0xa0, 0x0c, 0x91, 0x5c, 0xf8, 0x01, 0xff, 0x91, 0x29, 0x1a, 0x11, 0x1f, 0xe1, 0xa5,
-	0xbe, 0xad, 0xed, 0xe9, 0x89, 0x46, 0x1e, 0x18, 0xe0, 0x1e, 0x5e, 0xc2, 0x1f, 0xc0, 0x1b, 0x0d,
-	0x17, 0xf5, 0x29, 0x0b, 0xc8, 0x53, 0xfb, 0x40, 0x17, 0xf8, 0xc9, 0x66, 0x47, 0x51, 0xe2, 0xba,
-	0x79, 0x9a, 0xe2, 0x5e, 0xaf, 0x7b, 0xf6, 0x38, 0x27, 0xed, 0x3f, 0x01, 0x9d, 0xd5, 0x76, 0xb8,
-	0xc5, 0xb3, 0xd6, 0x01, 0xfb, 0x66, 0xac, 0xbb, 0x1a, 0x37, 0x5f, 0xa3, 0x8b, 0xe7, 0x97, 0x3d,
-	0xeb, 0xc5, 0x65, 0xcf, 0xfa, 0xf3, 0xb2, 0x67, 0xfd, 0x76, 0xd5, 0xdb, 0x79, 0x71, 0xd5, 0xdb,
-	0xf9, 0xe3, 0xaa, 0xb7, 0xf3, 0xe4, 0xee, 0x9c, 0xaa, 0x45, 0x32, 0x73, 0x30, 0x8f, 0x5c, 0xcc,
-	0x65, 0xc4, 0xa5, 0xfb, 0xaa, 0xab, 0x0f, 0xab, 0x67, 0xfa, 0x69, 0xf3, 0x0f, 0x81, 0xca, 0x62,
-	0x22, 0x67, 0xfb, 0xfa, 0x19, 0xfe, 0xf8, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x19, 0x84,
-	0x4a, 0xd5, 0x08, 0x00, 0x00,
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xcf, 0xb6, 0x49, 0x9a, 0x8c, 0xe3, 0x34, 0x99, 0xa4, 0xee, 0x26, 0xb9, 0xd7, 0xf1, 0x75,
+	0x2f, 0xc2, 0xa8, 0xe0, 0x25, 0xa1, 0x08, 0x5a, 0x0a, 0x52, 0xfe, 0x00, 0x31, 0x55, 0x21, 0xda,
+	0xa6, 0x15, 0x05, 0x89, 0xd1, 0x78, 0x76, 0x6a, 0x4f, 0xb3, 0xde, 0x59, 0x76, 0x66, 0x37, 0xb5,
+	0x00, 0x89, 0x8a, 0x67, 0xa4, 0x3e, 0x55, 0xbc, 0xf1, 0x75, 0x2a, 0x9e, 0xfa, 0xc8, 0x53, 0x41,
+	0xed, 0x37, 0xe0, 0x13, 0xa0, 0x9d, 0x9d, 0x59, 0xdb, 0xa9, 0x93, 0xda, 0x7d, 0xb3, 0xcf, 0xef,
+	0x9c, 0xdf, 0xf9, 0x33, 0x67, 0xce, 0x99, 0x05, 0x1b, 0x2c, 0x90, 0x34, 0x22, 0x6d, 0xcc, 0x02,
+	0x24, 0x28, 0x89, 0x23, 0x26, 0xbb, 0x0e, 0x21, 0x89, 0x13, 0x46, 0x3c, 0x61, 0x1e, 0x8d, 0x9c,
+	0x64, 0xc3, 0x69, 0xd1, 0x80, 0x0a, 0x26, 0xea, 0x61, 0xc4, 0x25, 0x87, 0x97, 0x86, 0x98, 0xd4,
+	0x09, 0x49, 0xea, 0xc6, 0xa4, 0x9e, 0x6c, 0xac, 0x2e, 0xb7, 0x78, 0x8b, 0x2b, 0x7d, 0x27, 0xfd,
+	0x95, 0x99, 0xae, 0xfe, 0xff, 0x24, 0x6f, 0xc9, 0x86, 0xa3, 0x19, 0x24, 0x5f, 0xdd, 0x1c, 0x25,
+	0xa6, 0xdc, 0xd9, 0x2b, 0x6c, 0x08, 0x0f, 0x44, 0xdc, 0xc9, 0x6c, 0xcc, 0x6f, 0x6d, 0xb3, 0x31,
+	0x8a, 0xcd, 0x40, 0xee, 0xab, 0xff, 0x91, 0x34, 0xf0, 0x68, 0xd4, 0x61, 0x81, 0x74, 0x48, 0xd4,
+	0x0d, 0x25, 0x77, 0x0e, 0x69, 0xd7, 0xa0, 0x6b, 0x7d, 0x28, 0x6e, 0x12, 0xe6, 0xc8, 0x6e, 0x48,
+	0x0d, 0xb8, 0xde, 0xe2, 0xbc, 0xe5, 0x53, 0x47, 0xfd, 0x6b, 0xc6, 0xf7, 0x1c, 0xc9, 0x3a, 0x54,
+	0x48, 0xdc, 0x09, 0xb5, 0x42, 0xf9, 0xb8, 0x82, 0x17, 0x47, 0x58, 0x32, 0x1e, 0x64, 0x78, 0xf5,
+	0x8f, 0x39, 0x30, 0xf7, 0x79, 0x16, 0xcd, 0x2d, 0x89, 0x25, 0x85, 0x35, 0xb0, 0x90, 0x60, 0x5f,
+	0x50, 0x89, 0xe2, 0xd0, 0xc3, 0x92, 0x22, 0xe6, 0xd9, 0x56, 0xc5, 0xaa, 0x4d, 0xba, 0xf3, 0x99,
+	0xfc, 0xb6, 0x12, 0x37, 0x3c, 0xf8, 0x03, 0x38, 0x6f, 0x72, 0x42, 0x22, 0xb5, 0x15, 0xf6, 0x99,
+	0xca, 0xd9, 0x5a, 0x61, 0x73, 0xb3, 0x3e, 0xc2, 0x61, 0xd6, 0x77, 0xb4, 0xad, 0x72, 0xbb, 0x5d,
+	0x7e, 0xf2, 0x6c, 0x7d, 0xe2, 0x9f, 0x67, 0xeb, 0xa5, 0x2e, 0xee, 0xf8, 0xd7, 0xaa, 0xc7, 0x88,
+	0xab, 0xee, 0x3c, 0xe9, 0x57, 0x17, 0xf0, 0x5b, 0x50, 0x8c, 0x83, 0x26, 0x0f, 0x3c, 0x16, 0xb4,
+	0x10, 0x0f, 0x85, 0x7d, 0x56, 0xb9, 0x7e, 0x77, 0x24, 0xd7, 0xb7, 0x8d, 0xe5, 0x57, 0xe1, 0xf6,
+	0x64, 0xea, 0xd8, 0x9d, 0x8b, 0x7b, 0x22, 0x01, 0x31, 0x58, 0xee, 0x60, 0x19, 0x47, 0x14, 0x0d,
+	0xfa, 0x98, 0xac, 0x58, 0xb5, 0xc2, 0xa6, 0x73, 0xa2, 0x8f, 0x64, 0xa3, 0x7e, 0x53, 0xd9, 0x79,
+	0x7d, 0x1e, 0x84, 0x0b, 0x33, 0xb2, 0x7e, 0x19, 0xfc, 0x09, 0xac, 0x1e, 0x2f, 0x33, 0x92, 0x1c,
+	0xb5, 0x29, 0x6b, 0xb5, 0xa5, 0x3d, 0xa5, 0x92, 0xf9, 0x68, 0xa4, 0x64, 0xee, 0x0c, 0x9c, 0xca,
+	0x01, 0xdf, 0x53, 0x14, 0x3a, 0xaf, 0x52, 0x32, 0x14, 0x85, 0xbf, 0x58, 0x60, 0x2d, 0xaf, 0x31,
+	0xf6, 0x3c, 0x96, 0xb6, 0x04, 0x0a, 0x23, 0x1e, 0x72, 0x81, 0x7d, 0x61, 0x4f, 0xab, 0x00, 0x3e,
+	0x1e, 0xeb, 0x20, 0xb7, 0x34, 0xcd, 0xbe, 0x66, 0xd1, 0x21, 0xac, 0x90, 0x13, 0x70, 0x01, 0x7f,
+	0xb6, 0xc0, 0x6a, 0x1e, 0x45, 0x44, 0x3b, 0x3c, 0xc1, 0x7e, 0x5f, 0x10, 0xe7, 0x54, 0x10, 0xd7,
+	0xc7, 0x0a, 0xc2, 0xcd, 0x58, 0x8e, 0xc5, 0x60, 0x93, 0xe1, 0xb0, 0x80, 0x0d, 0x30, 0x1d, 0xe2,
+	0x08, 0x77, 0x84, 0x3d, 0xa3, 0x0e, 0xf7, 0xf2, 0x48, 0xde, 0xf6, 0x95, 0x89, 0x26, 0xd7, 0x04,
+	0x2a, 0x9b, 0x04, 0xfb, 0xcc, 0xc3, 0x92, 0x47, 0x28, 0xcf, 0x2b, 0x8c, 0x9b, 0xe9, 0x6d, 0xb6,
+	0x67, 0xc7, 0xc8, 0xe6, 0x8e, 0xa1, 0x31, 0x69, 0xed, 0xc7, 0xcd, 0x1b, 0xb4, 0x6b, 0xb2, 0x49,
+	0x86, 0xc0, 0xa9, 0x0f, 0xf8, 0xd0, 0x02, 0x6b, 0x39, 0x28, 0x50, 0xb3, 0x8b, 0xfa, 0x0f, 0x39,
+	0xb2, 0xc1, 0xeb, 0xc4, 0xb0, 0xdd, 0xed, 0x3b, 0xe1, 0xe8, 0xa5, 0x18, 0xc4, 0x20, 0x0e, 0x13,
+	0x70, 0x71, 0xc0, 0xa9, 0x48, 0xfb, 0x3a, 0x8c, 0xe2, 0x80, 0xda, 0x05, 0xe5, 0xfe, 0xea, 0xb8,
+	0x5d, 0x15, 0x89, 0x03, 0xbe, 0x9f, 0x12, 0x68, 0xdf, 0xcb, 0x64, 0x08, 0x06, 0x1f, 0x5b, 0xa0,
+	0x7a, 0x0f, 0x33, 0x9f, 0x7a, 0xe8, 0xb4, 0xce, 0x9e, 0x53, 0x31, 0xec, 0x8c, 0x14, 0xc3, 0x67,
+	0x8a, 0xee, 0x15, 0xfd, 0xbd, 0x7e, 0xef, 0x54, 0x2d, 0x01, 0xef, 0x03, 0xc8, 0x3c, 0xda, 0x09,
+	0xb9, 0xa4, 0x01, 0xe9, 0x22, 0xc9, 0x0f, 0x69, 0x20, 0xec, 0xa2, 0x8a, 0xe3, 0xfd, 0x91, 0xe2,
+	0x68, 0xf4, 0xcc, 0x0f, 0x52, 0x6b, 0xed, 0x79, 0x91, 0x1d, 0x93, 0x0b, 0xf8, 0x23, 0xc8, 0xaf,
+	0x1b, 0x22, 0x3e, 0xa3, 0x81, 0x44, 0x6d, 0x26, 0x24, 0x8f, 0x18, 0x15, 0xf6, 0xbc, 0x72, 0x79,
+	0x6d, 0xac, 0xf2, 0xef, 0x28, 0x92, 0x3d, 0xc5, 0x61, 0xfa, 0xef, 0x22, 0x19, 0x02, 0x32, 0x2a,
+	0xaa, 0x8f, 0x0b, 0xa0, 0x38, 0x30, 0xd6, 0xe1, 0x0a, 0x98, 0xc9, 0x1c, 0xe9, 0x2d, 0x32, 0xeb,
+	0x9e, 0x53, 0xff, 0x1b, 0x1e, 0xfc, 0x2f, 0x00, 0xa4, 0x8d, 0x83, 0x80, 0xfa, 0x29, 0x78, 0x46,
+	0x81, 0xb3, 0x5a, 0xd2, 0xf0, 0xe0, 0x1a, 0x98, 0xd5, 0x09, 0x30, 0xcf, 0x3e, 0xab, 0xd0, 0x99,
+	0x4c, 0xd0, 0xf0, 0xe0, 0x1b, 0x60, 0x9e, 0x05, 0x4c, 0x32, 0xec, 0x9b, 0x89, 0x39, 0xa9, 0x56,
+	0x54, 0x51, 0x4b, 0xf5, 0x94, 0x6b, 0x82, 0x85, 0xbc, 0x1a, 0x7a, 0xe5, 0xda, 0x53, 0xea, 0x9a,
+	0x6f, 0x9c, 0x58, 0x04, 0x63, 0x90, 0x16, 0xa1, 0x7f, 0x31, 0xea, 0xdc, 0xf3, 0x95, 0xa7, 0x31,
+	0x28, 0x41, 0x29, 0xa4, 0xd9, 0x8a, 0xd0, 0x03, 0x3d, 0xcd, 0xa1, 0x45, 0xcd, 0x0c, 0xfd, 0xf0,
+	0xb4, 0x6d, 0x91, 0xdf, 0xb1, 0x5b, 0x54, 0xee, 0x28, 0xb3, 0x7d, 0x4c, 0x0e, 0xa9, 0xdc, 0xc5,
+	0x12, 0x9b, 0x66, 0xd7, 0xec, 0xd9, 0x98, 0xcf, 0x94, 0x04, 0x7c, 0x1b, 0x40, 0xe1, 0x63, 0xd1,
+	0x46, 0x1e, 0x3f, 0x0a, 0xd2, 0x9d, 0x8f, 0x30, 0x39, 0x54, 0x03, 0x73, 0xd6, 0x5d, 0x50, 0xc8,
+	0xae, 0x06, 0xb6, 0xc8, 0x21, 0xbc, 0x0f, 0x96, 0x06, 0x16, 0x19, 0x62, 0x81, 0x47, 0x1f, 0xd8,
+	0x33, 0x2a, 0xc0, 0x2b, 0xa3, 0x4d, 0x03, 0x41, 0xfa, 0xf7, 0x97, 0xe9, 0xc0, 0xfe, 0xb5, 0xd9,
+	0x48, 0x49, 0xe1, 0x25, 0x50, 0xcc, 0x22, 0xa3, 0x01, 0x6e, 0xfa, 0xd4, 0xb3, 0x67, 0x2b, 0x56,
+	0x6d, 0xc6, 0x9d, 0x53, 0xc2, 0x4f, 0x33, 0x19, 0xdc, 0x03, 0x53, 0x61, 0x1b, 0x0b, 0x6a, 0x83,
+	0x8a, 0x55, 0x9b, 0x1f, 0xf3, 0xc1, 0xb0, 0x9f, 0x5a, 0xba, 0x19, 0x01, 0x5c, 0x02, 0x53, 0x92,
+	0x87, 0x28, 0xb0, 0x0b, 0x15, 0xab, 0x56, 0x74, 0x27, 0x25, 0x0f, 0xbf, 0x84, 0x37, 0x40, 0xb1,
+	0x37, 0x88, 0x05, 0x95, 0xfa, 0xd2, 0x57, 0xea, 0xbd, 0xa7, 0x54, 0x3d, 0x7d, 0x4a, 0xf5, 0xea,
+	0x9f, 0x2d, 0x48, 0xf3, 0x18, 0x48, 0xfa, 0x8e, 0x05, 0x6e, 0x82, 0x0b, 0x98, 0x10, 0x1a, 0x4a,
+	0xea, 0x99, 0x26, 0x42, 0x6d, 0x2c, 0xda, 0x76, 0xb1, 0x62, 0xd5, 0xe6, 0xdc, 0x25, 0x03, 0xea,
+	0x86, 0xd8, 0xc3, 0xa2, 0x0d, 0xdf, 0x04, 0xe7, 0x43, 0x7e, 0xa4, 0x96, 0x9a, 0x17, 0x13, 0xc9,
+	0x78, 0x60, 0xcf, 0xab, 0x16, 0x9e, 0x57, 0x62, 0xd7, 0x48, 0xe1, 0x5b, 0x60, 0x21, 0x53, 0xec,
+	0xc4, 0xbe, 0x64, 0xa1, 0xcf, 0x68, 0x64, 0x9f, 0x57, 0x9a, 0x19, 0xc1, 0xcd, 0x5c, 0x0c, 0xaf,
+	0x80, 0x52, 0x44, 0x8f, 0x70, 0xe4, 0x21, 0x8f, 0x06, 0xbc, 0x83, 0xb0, 0xef, 0xf3, 0x23, 0x9f,
+	0x09, 0x69, 0x2f, 0xa8, 0x63, 0x5f, 0xce, 0xd0, 0xdd, 0x14, 0xdc, 0x32, 0x18, 0xbc, 0x0c, 0x16,
+	0x23, 0xea, 0xe3, 0x6e, 0x3a, 0x0c, 0x73, 0x83, 0xc5, 0xac, 0x4f, 0x34, 0xd0, 0x53, 0xbe, 0x0b,
+	0x4a, 0x79, 0x3f, 0xdd, 0xc7, 0xcc, 0x47, 0xe6, 0xb1, 0x68, 0x43, 0x75, 0x6b, 0x56, 0xea, 0xd9,
+	0x6b, 0xb2, 0x6e, 0x5e, 0x93, 0xf5, 0x5d, 0xad, 0xb0, 0x3d, 0x93, 0x56, 0xee, 0xb7, 0xbf, 0xd6,
+	0x2d, 0x77, 0xd9, 0x50, 0x7c, 0x81, 0x99, 0x6f, 0x70, 0xf8, 0x35, 0x28, 0xa4, 0x77, 0x13, 0xe9,
+	0x65, 0xbb, 0xa4, 0xf8, 0x3e, 0x18, 0xeb, 0xdc, 0x1b, 0x01, 0x93, 0xd9, 0xe2, 0x75, 0x01, 0xcb,
+	0x7f, 0xc3, 0xff, 0x81, 0x39, 0x11, 0xe2, 0xa3, 0xc0, 0x4c, 0x82, 0x65, 0x35, 0x09, 0x0a, 0x4a,
+	0xa6, 0xe7, 0xc0, 0x27, 0x7d, 0x5b, 0x11, 0xe1, 0x30, 0x25, 0xc7, 0x3e, 0x8a, 0xe8, 0xf7, 0x31,
+	0x8b, 0xa8, 0x67, 0x5f, 0x50, 0x1d, 0xba, 0x92, 0xab, 0x6c, 0x69, 0x0d, 0x57, 0x2b, 0x40, 0x07,
+	0x2c, 0x65, 0x56, 0xd4, 0x43, 0xb9, 0x96, 0xb0, 0x4b, 0xaa, 0x8c, 0xd0, 0x40, 0x79, 0x33, 0x09,
+	0xf8, 0x1d, 0x58, 0x49, 0x04, 0x41, 0xa1, 0xba, 0xcc, 0x28, 0x2d, 0x06, 0x8f, 0x25, 0x0a, 0x69,
+	0xc4, 0xb8, 0x67, 0x5f, 0x1c, 0xbd, 0x96, 0xa5, 0x44, 0x90, 0x6c, 0x22, 0x1c, 0x64, 0x1c, 0xfb,
+	0x8a, 0x02, 0xd6, 0xc1, 0x12, 0xc1, 0x81, 0xc7, 0xd4, 0xcb, 0xb1, 0x37, 0x26, 0x6d, 0xd5, 0x39,
+	0x8b, 0x39, 0xb4, 0xa3, 0xe7, 0x65, 0xf5, 0x77, 0x0b, 0x94, 0x86, 0xbf, 0x13, 0xc7, 0x78, 0xef,
+	0x97, 0xc0, 0xb4, 0x2e, 0xf1, 0x19, 0x85, 0xeb, 0x7f, 0x70, 0x07, 0x80, 0xa6, 0xcf, 0xc9, 0xa1,
+	0xca, 0x53, 0x8d, 0xea, 0xc2, 0xe6, 0xea, 0x4b, 0xd9, 0x1d, 0x98, 0x0f, 0x93, 0x2c, 0xbd, 0x47,
+	0x69, 0x7a, 0xb3, 0xca, 0x2e, 0x45, 0xaa, 0x0f, 0x2d, 0xb0, 0x70, 0x7c, 0xcd, 0x9d, 0xb6, 0x3d,
+	0x96, 0xd3, 0x7b, 0x7f, 0x48, 0x03, 0xbd, 0x38, 0xb2, 0x3f, 0xf0, 0x3a, 0x98, 0xa6, 0x0f, 0x42,
+	0x16, 0x75, 0xc7, 0x0a, 0x43, 0xdb, 0x54, 0x7f, 0xb5, 0xc0, 0x85, 0xa1, 0x7b, 0xef, 0xb4, 0x40,
+	0xee, 0x82, 0x73, 0x11, 0x25, 0x3c, 0xf2, 0xcc, 0xd7, 0xcf, 0xd5, 0xd7, 0xd8, 0xaf, 0xae, 0x62,
+	0xd0, 0xe3, 0xc7, 0xf0, 0x6d, 0x1f, 0x7c, 0x73, 0xad, 0xc5, 0x64, 0x3b, 0x6e, 0xd6, 0x09, 0xef,
+	0x38, 0x84, 0x8b, 0x0e, 0x17, 0x4e, 0x8f, 0xfc, 0x9d, 0xfc, 0xf3, 0xf2, 0xc1, 0xe0, 0x87, 0xac,
+	0xfa, 0x40, 0x7c, 0xf2, 0xbc, 0x6c, 0x3d, 0x7d, 0x5e, 0xb6, 0xfe, 0x7e, 0x5e, 0xb6, 0x1e, 0xbd,
+	0x28, 0x4f, 0x3c, 0x7d, 0x51, 0x9e, 0xf8, 0xf3, 0x45, 0x79, 0xa2, 0x39, 0xad, 0x6a, 0xf1, 0xde,
+	0xbf, 0x03, 0x00, 0xb7, 0xd2, 0x52, 0x61, 0xa5, 0x0f, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -411,6 +767,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerClientHistories) > 0 {
+		for iNdEx := len(m.ConsumerClientHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerClientHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.IdempotencyTokens) > 0 {
+		for iNdEx := len(m.IdempotencyTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IdempotencyTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FailedConsumerAdditionProposals) > 0 {
+		for iNdEx := len(m.FailedConsumerAdditionProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedConsumerAdditionProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ConsumerAddrsToPrune) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPrune) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -573,33 +971,126 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbondingOpsIndex) > 0 {
-		for iNdEx := len(m.UnbondingOpsIndex) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbondingOpsIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.CandidateClientId) > 0 {
+		i -= len(m.CandidateClientId)
+		copy(dAtA[i:], m.CandidateClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CandidateClientId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VscPacketTimeoutPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscPacketTimeoutPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.ApprovedValidators) > 0 {
+		for iNdEx := len(m.ApprovedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedValidators[iNdEx])
+			copy(dAtA[i:], m.ApprovedValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ApprovedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.ValidatorApprovalRequired {
+		i--
+		if m.ValidatorApprovalRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.SpawnHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SpawnHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.InitParams != nil {
+		{
+			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.RelayerAllowlist) > 0 {
+		for iNdEx := len(m.RelayerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RelayerAllowlist[iNdEx])
+			copy(dAtA[i:], m.RelayerAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RelayerAllowlist[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.SlashDowntimeAck) > 0 {
-		for iNdEx := len(m.SlashDowntimeAck) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashDowntimeAck[iNdEx])
-			copy(dAtA[i:], m.SlashDowntimeAck[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashDowntimeAck[iNdEx])))
+	if len(m.RewardDenomAllowlist) > 0 {
+		for iNdEx := len(m.RewardDenomAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardDenomAllowlist[iNdEx])
+			copy(dAtA[i:], m.RewardDenomAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardDenomAllowlist[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.PendingValsetChanges) > 0 {
-		for iNdEx := len(m.PendingValsetChanges) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PowerMultiplier) > 0 {
+		i -= len(m.PowerMultiplier)
+		copy(dAtA[i:], m.PowerMultiplier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PowerMultiplier)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.PowerReduction) > 0 {
+		i -= len(m.PowerReduction)
+		copy(dAtA[i:], m.PowerReduction)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PowerReduction)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AcceptedGenesisHash) > 0 {
+		i -= len(m.AcceptedGenesisHash)
+		copy(dAtA[i:], m.AcceptedGenesisHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AcceptedGenesisHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingValsetChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -607,10 +1098,67 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x62
 		}
 	}
-	{
+	if m.TopN != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Phase != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SlashEnabled {
+		i--
+		if m.SlashEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnbondingOpsIndex) > 0 {
+		for iNdEx := len(m.UnbondingOpsIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingOpsIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SlashDowntimeAck) > 0 {
+		for iNdEx := len(m.SlashDowntimeAck) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashDowntimeAck[iNdEx])
+			copy(dAtA[i:], m.SlashDowntimeAck[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashDowntimeAck[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingValsetChanges) > 0 {
+		for iNdEx := len(m.PendingValsetChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValsetChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
 		size, err := m.ConsumerGenesis.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -669,6 +1217,14 @@ func (m *ValsetUpdateIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
 	if m.Height != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
 		i--
@@ -682,6 +1238,95 @@ func (m *ValsetUpdateIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *IdempotencyToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdempotencyToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdempotencyToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerClientHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerClientHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerClientHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -756,6 +1401,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FailedConsumerAdditionProposals) > 0 {
+		for _, e := range m.FailedConsumerAdditionProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IdempotencyTokens) > 0 {
+		for _, e := range m.IdempotencyTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerClientHistories) > 0 {
+		for _, e := range m.ConsumerClientHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -800,6 +1463,69 @@ func (m *ConsumerState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SlashEnabled {
+		n += 2
+	}
+	if m.Phase != 0 {
+		n += 1 + sovGenesis(uint64(m.Phase))
+	}
+	if m.TopN != 0 {
+		n += 1 + sovGenesis(uint64(m.TopN))
+	}
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.AcceptedGenesisHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PowerReduction)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PowerMultiplier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RewardDenomAllowlist) > 0 {
+		for _, s := range m.RewardDenomAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RelayerAllowlist) > 0 {
+		for _, s := range m.RelayerAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.InitParams != nil {
+		l = m.InitParams.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.SpawnHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.SpawnHeight))
+	}
+	if m.ValidatorApprovalRequired {
+		n += 3
+	}
+	if len(m.ApprovedValidators) > 0 {
+		for _, s := range m.ApprovedValidators {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VscPacketTimeoutPeriod)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = len(m.CandidateClientId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -815,6 +1541,46 @@ func (m *ValsetUpdateIdToHeight) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovGenesis(uint64(m.Height))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IdempotencyToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ConsumerClientHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1213,61 +1979,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedConsumerAdditionProposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1277,27 +1993,757 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FailedConsumerAdditionProposals = append(m.FailedConsumerAdditionProposals, FailedConsumerAdditionProposal{})
+			if err := m.FailedConsumerAdditionProposals[len(m.FailedConsumerAdditionProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyTokens = append(m.IdempotencyTokens, IdempotencyToken{})
+			if err := m.IdempotencyTokens[len(m.IdempotencyTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerClientHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerClientHistories = append(m.ConsumerClientHistories, ConsumerClientHistory{})
+			if err := m.ConsumerClientHistories[len(m.ConsumerClientHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			m.InitialHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGenesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValsetChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingValsetChanges = append(m.PendingValsetChanges, types.ValidatorSetChangePacketData{})
+			if err := m.PendingValsetChanges[len(m.PendingValsetChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDowntimeAck", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDowntimeAck = append(m.SlashDowntimeAck, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpsIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingOpsIndex = append(m.UnbondingOpsIndex, VscUnbondingOps{})
+			if err := m.UnbondingOpsIndex[len(m.UnbondingOpsIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashEnabled = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, types2.ValidatorUpdate{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedGenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedGenesisHash = append(m.AcceptedGenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AcceptedGenesisHash == nil {
+				m.AcceptedGenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerReduction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenomAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenomAllowlist = append(m.RewardDenomAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAllowlist = append(m.RelayerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitParams == nil {
+				m.InitParams = &ConsumerInitParams{}
+			}
+			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnHeight", wireType)
+			}
+			m.SpawnHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpawnHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorApprovalRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorApprovalRequired = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedValidators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,11 +2771,44 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ApprovedValidators = append(m.ApprovedValidators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VscPacketTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VscPacketTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1357,13 +2836,63 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.CandidateClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetUpdateIdToHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetUpdateIdToHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
 			}
-			m.InitialHeight = 0
+			m.ValsetUpdateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1373,14 +2902,33 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialHeight |= uint64(b&0x7F) << shift
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGenesis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1407,15 +2955,65 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerGenesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdempotencyToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdempotencyToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdempotencyToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingValsetChanges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1425,29 +3023,27 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingValsetChanges = append(m.PendingValsetChanges, types.ValidatorSetChangePacketData{})
-			if err := m.PendingValsetChanges[len(m.PendingValsetChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashDowntimeAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1475,11 +3071,11 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashDowntimeAck = append(m.SlashDowntimeAck, string(dAtA[iNdEx:postIndex]))
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOpsIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,8 +3102,7 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingOpsIndex = append(m.UnbondingOpsIndex, VscUnbondingOps{})
-			if err := m.UnbondingOpsIndex[len(m.UnbondingOpsIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1532,7 +3127,7 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
+func (m *ConsumerClientHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,17 +3150,17 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValsetUpdateIdToHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerClientHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValsetUpdateIdToHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerClientHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.ValsetUpdateId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1575,16 +3170,29 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1594,11 +3202,26 @@ func (m *ValsetUpdateIdToHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ConsumerClientRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
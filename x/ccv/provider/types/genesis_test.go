@@ -40,6 +40,257 @@ func TestValidateGenesisState(t *testing.T) {
 			),
 			true,
 		},
+		{
+			"valid provider genesis with active consumer chain",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", Phase: types.ConsumerPhaseActive, ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			true,
+		},
+		{
+			"invalid active consumer chain without channel",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "", ClientId: "client-id", Phase: types.ConsumerPhaseActive, ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain phase",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", Phase: types.ConsumerPhaseStopped, ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain validator set larger than top N",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					TopN: 1, ValidatorSet: []abci.ValidatorUpdate{{Power: 1}, {Power: 2}},
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"valid consumer chain validator set without top N requiring validator approval",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					ValidatorApprovalRequired: true, ValidatorSet: []abci.ValidatorUpdate{{Power: 1}, {Power: 2}},
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			true,
+		},
+		{
+			"invalid consumer chain accepted genesis hash",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					AcceptedGenesisHash: []byte{0x01},
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain power reduction",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					PowerReduction: "0",
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain power multiplier",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					PowerMultiplier: "0",
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain reward denom allowlist",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					RewardDenomAllowlist: []string{"ufoo", "ufoo"},
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain relayer allowlist",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					RelayerAllowlist: []string{"cosmos1invalid"},
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain downtime jail duration",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					DowntimeJailDuration: -time.Minute,
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain VSC packet timeout period",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					VscPacketTimeoutPeriod: -time.Minute,
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid consumer chain candidate client id",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1"),
+					CandidateClientId: "client-id",
+				}},
+				nil,
+				nil,
+				nil,
+				nil,
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
 		{
 			"valid multiple provider genesis with multiple consumer chains",
 			types.NewGenesisState(
@@ -74,7 +325,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -93,7 +344,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -112,7 +363,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -131,7 +382,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, 30*time.Minute, time.Hour, "0.1", 400, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -156,7 +407,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -181,7 +434,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -206,7 +461,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -231,7 +488,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -256,7 +515,9 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 vsc timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -281,7 +542,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -306,7 +569,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					types.DefaultMaxThrottledPackets),
+					types.DefaultMaxThrottledPackets,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -331,7 +596,9 @@ func TestValidateGenesisState(t *testing.T) {
 					types.DefaultVscTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					-1),
+					-1,
+					"0",
+					types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling),
 				nil,
 				nil,
 				nil,
@@ -658,6 +925,117 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+// TestValidateGenesisStateFailedPropsTokensAndHistories tests the validation of the failed
+// consumer addition proposals, the idempotency tokens and the client histories of a provider genesis
+func TestValidateGenesisStateFailedPropsTokensAndHistories(t *testing.T) {
+	prop := types.NewConsumerAdditionProposal("title", "description", "chainID", clienttypes.NewHeight(2, 3),
+		[]byte("gen_hash"), []byte("bin_hash"), time.Now(), "0.75", 10, 10000, 100000000000, 100000000000, 100000000000,
+	).(*types.ConsumerAdditionProposal)
+	record := types.ConsumerClientRecord{ClientId: "07-tendermint-0", BlockHeight: 1, Timestamp: time.Now()}
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expPass  bool
+	}{
+		{
+			"valid failed proposal, idempotency token and client history",
+			func(gs *types.GenesisState) {
+				gs.FailedConsumerAdditionProposals = []types.FailedConsumerAdditionProposal{{Proposal: *prop, Error: "error", FailureCount: 1}}
+				gs.IdempotencyTokens = []types.IdempotencyToken{{ChainId: "chainID", Token: "token", Expiry: time.Now()}}
+				gs.ConsumerClientHistories = []types.ConsumerClientHistory{{ChainId: "chainID", Records: []types.ConsumerClientRecord{record}}}
+			},
+			true,
+		},
+		{
+			"invalid failed proposal",
+			func(gs *types.GenesisState) {
+				invalidProp := *prop
+				invalidProp.ChainId = ""
+				gs.FailedConsumerAdditionProposals = []types.FailedConsumerAdditionProposal{{Proposal: invalidProp, Error: "error", FailureCount: 1}}
+			},
+			false,
+		},
+		{
+			"invalid failed proposal without failures",
+			func(gs *types.GenesisState) {
+				gs.FailedConsumerAdditionProposals = []types.FailedConsumerAdditionProposal{{Proposal: *prop, Error: "error"}}
+			},
+			false,
+		},
+		{
+			"invalid idempotency token chain id",
+			func(gs *types.GenesisState) {
+				gs.IdempotencyTokens = []types.IdempotencyToken{{ChainId: " ", Token: "token", Expiry: time.Now()}}
+			},
+			false,
+		},
+		{
+			"invalid empty idempotency token",
+			func(gs *types.GenesisState) {
+				gs.IdempotencyTokens = []types.IdempotencyToken{{ChainId: "chainID", Expiry: time.Now()}}
+			},
+			false,
+		},
+		{
+			"invalid idempotency token expiry time",
+			func(gs *types.GenesisState) {
+				gs.IdempotencyTokens = []types.IdempotencyToken{{ChainId: "chainID", Token: "token"}}
+			},
+			false,
+		},
+		{
+			"invalid client history chain id",
+			func(gs *types.GenesisState) {
+				gs.ConsumerClientHistories = []types.ConsumerClientHistory{{ChainId: "", Records: []types.ConsumerClientRecord{record}}}
+			},
+			false,
+		},
+		{
+			"invalid client history client id",
+			func(gs *types.GenesisState) {
+				gs.ConsumerClientHistories = []types.ConsumerClientHistory{{ChainId: "chainID", Records: []types.ConsumerClientRecord{{ClientId: "c"}}}}
+			},
+			false,
+		},
+		{
+			"invalid client history exceeding the retained records",
+			func(gs *types.GenesisState) {
+				records := make([]types.ConsumerClientRecord, types.MaxConsumerClientHistory+1)
+				for i := range records {
+					records[i] = record
+				}
+				gs.ConsumerClientHistories = []types.ConsumerClientHistory{{ChainId: "chainID", Records: records}}
+			},
+			false,
+		},
+		{
+			"invalid duplicate client history",
+			func(gs *types.GenesisState) {
+				gs.ConsumerClientHistories = []types.ConsumerClientHistory{
+					{ChainId: "chainID", Records: []types.ConsumerClientRecord{record}},
+					{ChainId: "chainID", Records: []types.ConsumerClientRecord{record}},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			tc.malleate(genState)
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err, "test case: %s must pass", tc.name)
+			} else {
+				require.Error(t, err, "test case: %s must fail", tc.name)
+			}
+		})
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string) consumertypes.GenesisState {
 	t.Helper()
 	// generate validator public key
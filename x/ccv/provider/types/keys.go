@@ -28,6 +28,14 @@ const (
 
 	// Default validator set update ID
 	DefaultValsetUpdateID = 1
+
+	// ConsumerRewardsPoolName is the name of the module account that receives
+	// the rewards sent by consumer chains
+	ConsumerRewardsPoolName = "consumer_rewards_pool"
+
+	// MaxConsumerClientHistory is the maximum number of clients retained in the client
+	// history of a consumer chain; older clients are pruned
+	MaxConsumerClientHistory = 20
 )
 
 // Iota generated keys/byte prefixes (as a byte), supports 256 possible values
@@ -128,6 +136,142 @@ const (
 	// denoting whether the provider address has committed any double signign infractions
 	SlashLogBytePrefix
 
+	// PendingCAPSpawnTimeBytePrefix is the byte prefix for storing the spawn time
+	// of the pending consumer addition proposal for a given consumer chainID
+	PendingCAPSpawnTimeBytePrefix
+
+	// ConsumerRewardsTotalsBytePrefix is the byte prefix for storing the accumulated rewards
+	// received from a consumer chain
+	ConsumerRewardsTotalsBytePrefix
+
+	// ChainToCandidateClientBytePrefix is the byte prefix for storing the candidate client ID
+	// of a consumer chain, i.e., the client that replaces the consumer client once promoted
+	ChainToCandidateClientBytePrefix
+
+	// ConsumerPhaseBytePrefix is the byte prefix for storing the lifecycle phase of a consumer chain
+	ConsumerPhaseBytePrefix
+
+	// ConsumerValSetBytePrefix is the byte prefix for storing the validator set (with provider keys)
+	// last sent to a top N consumer chain
+	ConsumerValSetBytePrefix
+
+	// ConsumerChainCountByteKey is the byte key for storing the number of registered consumer chains
+	ConsumerChainCountByteKey
+
+	// FailedCAPBytePrefix is the byte prefix for storing the consumer addition proposals
+	// for which the consumer client could not be created at spawn time
+	FailedCAPBytePrefix
+
+	// ConsumerAcceptedGenesisHashBytePrefix is the byte prefix for storing the hash
+	// of the genesis state a consumer chain confirmed it started with
+	ConsumerAcceptedGenesisHashBytePrefix
+
+	// ValsetUpdateBlockTimeBytePrefix is the byte prefix that will store the mapping from vscIDs to block times
+	ValsetUpdateBlockTimeBytePrefix
+
+	// VscMaturityTimeBytePrefix is the byte prefix for storing the maturity times
+	// of the VSCPackets sent to a given consumer chainID
+	VscMaturityTimeBytePrefix
+
+	// ConsumerJailedValidatorsBytePrefix is the byte prefix for storing the provider validators
+	// that were jailed due to an infraction committed on a given consumer chainID
+	ConsumerJailedValidatorsBytePrefix
+
+	// IdempotencyTokenBytePrefix is the byte prefix for storing the expiry times
+	// of the idempotency tokens of handled consumer addition proposals
+	IdempotencyTokenBytePrefix
+
+	// ConsumerCreationUnbondingTimeBytePrefix is the byte prefix for storing the provider
+	// unbonding time the client of a given consumer chainID was created with
+	ConsumerCreationUnbondingTimeBytePrefix
+
+	// ConsumerStatePreservedBytePrefix is the byte prefix for flagging the stopped consumer chainIDs
+	// whose state was preserved, i.e., the state that is not yet purged via MsgPurgeConsumerState
+	ConsumerStatePreservedBytePrefix
+
+	// VscSendFailuresBytePrefix is the byte prefix for storing the number of consecutive
+	// failures to send the pending VSC packets to a given consumer chainID
+	VscSendFailuresBytePrefix
+
+	// VscSendRetryHeightBytePrefix is the byte prefix for storing the block height
+	// from which sending the pending VSC packets to a given consumer chainID is retried
+	VscSendRetryHeightBytePrefix
+
+	// RecentSpawnBytePrefix is the byte prefix for storing the log of recent consumer chain spawns,
+	// i.e., the spawn records indexed by a sequence number
+	RecentSpawnBytePrefix
+
+	// CommittedGenesisHashBytePrefix is the byte prefix for storing the hash of the consumer genesis
+	// committed to when the consumer addition proposal of a given consumer chainID is handled
+	CommittedGenesisHashBytePrefix
+
+	// RewardDenomAllowlistBytePrefix is the byte prefix for storing the denoms
+	// a given consumer chainID may send as rewards
+	RewardDenomAllowlistBytePrefix
+
+	// ClientToChainBytePrefix is the byte prefix for storing the mapping
+	// from the client ID of a consumer chain to its chain ID
+	ClientToChainBytePrefix
+
+	// LastMaturedVscIdBytePrefix is the byte prefix for storing the highest ID
+	// of the VSC packets matured on a given consumer chainID
+	LastMaturedVscIdBytePrefix
+
+	// ConsumerInitParamsBytePrefix is the byte prefix for storing the initialization parameters
+	// a consumer chain was spawned with
+	ConsumerInitParamsBytePrefix
+
+	// ConsumerSpawnHeightBytePrefix is the byte prefix for storing the provider block height
+	// at which the client of a consumer chain was created
+	ConsumerSpawnHeightBytePrefix
+
+	// ApprovedValidatorBytePrefix is the byte prefix for storing the provider validators
+	// approved to join the validator set of a consumer chain
+	ApprovedValidatorBytePrefix
+
+	// PendingValidatorApprovalBytePrefix is the byte prefix for storing the provider validators
+	// that would join the validator set of a consumer chain, but are waiting for approval
+	PendingValidatorApprovalBytePrefix
+
+	// ConsumerSpawnFailureCountBytePrefix is the byte prefix for storing the number of times
+	// the consumer client of a consumer chain could not be created
+	ConsumerSpawnFailureCountBytePrefix
+
+	// ConsumerLatestSeenHeightBytePrefix is the byte prefix for storing the latest height
+	// of a consumer chain seen by the provider via the updates of the consumer client
+	ConsumerLatestSeenHeightBytePrefix
+
+	// VscPendingChunkAcksBytePrefix is the byte prefix for storing the number of chunks of a VSC packet
+	// split across multiple IBC packets that were not yet acknowledged by the consumer chain
+	VscPendingChunkAcksBytePrefix
+
+	// VscMaturityDeferredBytePrefix is the byte prefix for storing the VSC packets that matured
+	// on the consumer chain before all their chunks were acknowledged
+	VscMaturityDeferredBytePrefix
+
+	// LastProviderUnbondingTimeByteKey is the byte key for storing the provider unbonding time
+	// last observed by the provider module, i.e., in the previous block
+	LastProviderUnbondingTimeByteKey
+
+	// ConsumerClientHistoryBytePrefix is the byte prefix for storing the history of the clients of a consumer chain
+	ConsumerClientHistoryBytePrefix
+
+	// PendingConsumerRewardsBytePrefix is the byte prefix for storing the rewards received
+	// from a consumer chain that are not yet distributed
+	PendingConsumerRewardsBytePrefix
+
+	// IdempotencyTokenExpiryBytePrefix is the byte prefix for indexing
+	// the idempotency tokens of handled consumer addition proposals by their expiry times
+	IdempotencyTokenExpiryBytePrefix
+
+	// UpdatedConsumerClientBytePrefix is the byte prefix for flagging the consumer clients
+	// updated in the current block, whose latest heights are recorded at the end of the block
+	UpdatedConsumerClientBytePrefix
+
+	// PendingCAPByChainBytePrefix is the byte prefix for indexing
+	// the pending consumer addition proposals by chain ID and spawn time
+	PendingCAPByChainBytePrefix
+
 	// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO getAllKeyPrefixes() IN keys_test.go
 )
 
@@ -182,6 +326,9 @@ func InitTimeoutTimestampKey(chainID string) []byte {
 
 // PendingCAPKey returns the key under which a pending consumer addition proposal is stored.
 // The key has the following format: PendingCAPBytePrefix | timestamp.UnixNano() | chainID
+//
+// Note that the timestamp has a fixed length of 8 bytes, thus the keys are unique for any chainID,
+// even if it is a prefix of another chainID, and are ordered by timestamp first and by chainID second.
 func PendingCAPKey(timestamp time.Time, chainID string) []byte {
 	ts := uint64(timestamp.UTC().UnixNano())
 	return ccvtypes.AppendMany(
@@ -264,6 +411,12 @@ func VscSendingTimestampKey(chainID string, vscID uint64) []byte {
 	return ChainIdAndUintIdKey(VscSendTimestampBytePrefix, chainID, vscID)
 }
 
+// VscMaturityTimeKey returns the key under which the
+// maturity time of the VSCPacket with vsc ID sent to chainID is stored
+func VscMaturityTimeKey(chainID string, vscID uint64) []byte {
+	return ChainIdAndUintIdKey(VscMaturityTimeBytePrefix, chainID, vscID)
+}
+
 // ParseVscTimeoutTimestampKey returns chain ID and vsc ID
 // for a VscSendingTimestampKey or an error if unparsable
 func ParseVscSendingTimestampKey(bz []byte) (string, uint64, error) {
@@ -362,6 +515,242 @@ func SlashLogKey(providerAddr ProviderConsAddress) []byte {
 	return append([]byte{SlashLogBytePrefix}, providerAddr.ToSdkConsAddr().Bytes()...)
 }
 
+// PendingCAPSpawnTimeKey returns the key under which the spawn time
+// of the pending consumer addition proposal for the given chainID is stored
+func PendingCAPSpawnTimeKey(chainID string) []byte {
+	return append([]byte{PendingCAPSpawnTimeBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerRewardsTotalsKey returns the key under which the accumulated rewards
+// received from the consumer chain with the given chainID are stored
+func ConsumerRewardsTotalsKey(chainID string) []byte {
+	return append([]byte{ConsumerRewardsTotalsBytePrefix}, []byte(chainID)...)
+}
+
+// ChainToCandidateClientKey returns the key under which the candidate clientID for the given chainID is stored
+func ChainToCandidateClientKey(chainID string) []byte {
+	return append([]byte{ChainToCandidateClientBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerPhaseKey returns the key under which the lifecycle phase of the given chainID is stored
+func ConsumerPhaseKey(chainID string) []byte {
+	return append([]byte{ConsumerPhaseBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerChainCountKey returns the key storing the number of registered consumer chains
+func ConsumerChainCountKey() []byte {
+	return []byte{ConsumerChainCountByteKey}
+}
+
+// FailedCAPKey returns the key under which the failed consumer addition proposal for the given chainID is stored
+func FailedCAPKey(chainID string) []byte {
+	return append([]byte{FailedCAPBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerAcceptedGenesisHashKey returns the key under which the hash of
+// the genesis state accepted by the given consumer chain is stored
+func ConsumerAcceptedGenesisHashKey(chainID string) []byte {
+	return append([]byte{ConsumerAcceptedGenesisHashBytePrefix}, []byte(chainID)...)
+}
+
+// ValsetUpdateBlockTimeKey returns the key that storing the mapping from valset update ID to block time
+func ValsetUpdateBlockTimeKey(valsetUpdateId uint64) []byte {
+	vuidBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(vuidBytes, valsetUpdateId)
+	return append([]byte{ValsetUpdateBlockTimeBytePrefix}, vuidBytes...)
+}
+
+// ConsumerValSetKey returns the key under which the power of a validator in the
+// validator set of a top N consumer chain is stored
+func ConsumerValSetKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ConsumerValSetBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerJailedValidatorKey returns the key under which the flag recording
+// that the given provider validator was jailed due to the given consumer chain is stored
+func ConsumerJailedValidatorKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ConsumerJailedValidatorsBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// IdempotencyTokenKey returns the key under which the expiry time
+// of the given idempotency token of a consumer chain is stored
+func IdempotencyTokenKey(chainID, token string) []byte {
+	return append(ChainIdWithLenKey(IdempotencyTokenBytePrefix, chainID), []byte(token)...)
+}
+
+// ConsumerCreationUnbondingTimeKey returns the key under which the provider unbonding time
+// the client of the given consumer chain was created with is stored
+func ConsumerCreationUnbondingTimeKey(chainID string) []byte {
+	return append([]byte{ConsumerCreationUnbondingTimeBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerStatePreservedKey returns the key under which the flag
+// for the preserved state of the given stopped consumer chain is stored
+func ConsumerStatePreservedKey(chainID string) []byte {
+	return append([]byte{ConsumerStatePreservedBytePrefix}, []byte(chainID)...)
+}
+
+// VscSendFailuresKey returns the key under which the number of consecutive
+// failures to send the pending VSC packets to the given consumer chain is stored
+func VscSendFailuresKey(chainID string) []byte {
+	return append([]byte{VscSendFailuresBytePrefix}, []byte(chainID)...)
+}
+
+// VscSendRetryHeightKey returns the key under which the block height from which
+// sending the pending VSC packets to the given consumer chain is retried is stored
+func VscSendRetryHeightKey(chainID string) []byte {
+	return append([]byte{VscSendRetryHeightBytePrefix}, []byte(chainID)...)
+}
+
+// RecentSpawnKey returns the key under which the spawn record
+// with the given sequence number is stored in the log of recent spawns
+func RecentSpawnKey(seq uint64) []byte {
+	return append([]byte{RecentSpawnBytePrefix}, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// CommittedGenesisHashKey returns the key under which the committed genesis hash
+// of the given consumer chain is stored
+func CommittedGenesisHashKey(chainID string) []byte {
+	return append([]byte{CommittedGenesisHashBytePrefix}, []byte(chainID)...)
+}
+
+// RewardDenomAllowlistKey returns the key under which the given denom
+// of the reward denom allowlist of a consumer chain is stored
+func RewardDenomAllowlistKey(chainID, denom string) []byte {
+	return append(ChainIdWithLenKey(RewardDenomAllowlistBytePrefix, chainID), []byte(denom)...)
+}
+
+// ClientToChainKey returns the key under which the chain ID of the consumer chain
+// with the given client ID is stored
+func ClientToChainKey(clientID string) []byte {
+	return append([]byte{ClientToChainBytePrefix}, []byte(clientID)...)
+}
+
+// LastMaturedVscIdKey returns the key under which the highest ID
+// of the VSC packets matured on the given consumer chain is stored
+func LastMaturedVscIdKey(chainID string) []byte {
+	return append([]byte{LastMaturedVscIdBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerInitParamsKey returns the key under which the initialization parameters
+// of the given consumer chain are stored
+func ConsumerInitParamsKey(chainID string) []byte {
+	return append([]byte{ConsumerInitParamsBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerSpawnHeightKey returns the key under which the provider block height
+// at which the given consumer chain was spawned is stored
+func ConsumerSpawnHeightKey(chainID string) []byte {
+	return append([]byte{ConsumerSpawnHeightBytePrefix}, []byte(chainID)...)
+}
+
+// ApprovedValidatorKey returns the key under which the flag recording that the given
+// provider validator is approved to join the validator set of the given consumer chain is stored
+func ApprovedValidatorKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ApprovedValidatorBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// PendingValidatorApprovalKey returns the key under which the flag recording that the given
+// provider validator is waiting for approval to join the validator set of the given consumer chain is stored
+func PendingValidatorApprovalKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(PendingValidatorApprovalBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerSpawnFailureCountKey returns the key under which the number of times
+// the consumer client of the given consumer chain could not be created is stored
+func ConsumerSpawnFailureCountKey(chainID string) []byte {
+	return append([]byte{ConsumerSpawnFailureCountBytePrefix}, []byte(chainID)...)
+}
+
+// ConsumerLatestSeenHeightKey returns the key under which the latest height
+// of the given consumer chain seen by the provider is stored
+func ConsumerLatestSeenHeightKey(chainID string) []byte {
+	return append([]byte{ConsumerLatestSeenHeightBytePrefix}, []byte(chainID)...)
+}
+
+// VscPendingChunkAcksKey returns the key under which the number of unacknowledged chunks
+// of the VSC packet with ID vscID sent to a chain with ID chainID is stored
+func VscPendingChunkAcksKey(chainID string, vscID uint64) []byte {
+	return ChainIdAndUintIdKey(VscPendingChunkAcksBytePrefix, chainID, vscID)
+}
+
+// VscMaturityDeferredKey returns the key under which it is stored that the VSC packet
+// with ID vscID matured on a chain with ID chainID before all its chunks were acknowledged
+func VscMaturityDeferredKey(chainID string, vscID uint64) []byte {
+	return ChainIdAndUintIdKey(VscMaturityDeferredBytePrefix, chainID, vscID)
+}
+
+// LastProviderUnbondingTimeKey returns the key storing the provider unbonding time last observed by the provider module
+func LastProviderUnbondingTimeKey() []byte {
+	return []byte{LastProviderUnbondingTimeByteKey}
+}
+
+// ConsumerClientHistoryKey returns the key under which the client record
+// with sequence number seq of the client history of a chain with ID chainID is stored
+func ConsumerClientHistoryKey(chainID string, seq uint64) []byte {
+	return ChainIdAndUintIdKey(ConsumerClientHistoryBytePrefix, chainID, seq)
+}
+
+// PendingConsumerRewardsKey returns the key under which the amount of the given denom
+// of the undistributed rewards received from a consumer chain is stored
+func PendingConsumerRewardsKey(chainID, denom string) []byte {
+	return append(ChainIdWithLenKey(PendingConsumerRewardsBytePrefix, chainID), []byte(denom)...)
+}
+
+// IdempotencyTokenExpiryKey returns the key under which the given idempotency token
+// of a consumer chain is indexed by its expiry time, with the following format:
+// IdempotencyTokenExpiryBytePrefix | expiry | len(chainID) | chainID | token
+func IdempotencyTokenExpiryKey(expiry time.Time, chainID, token string) []byte {
+	ts := uint64(expiry.UTC().UnixNano())
+	return ccvtypes.AppendMany(
+		// Append the prefix
+		[]byte{IdempotencyTokenExpiryBytePrefix},
+		// Append the time
+		sdk.Uint64ToBigEndian(ts),
+		// Append the chainId length
+		sdk.Uint64ToBigEndian(uint64(len(chainID))),
+		// Append the chainId
+		[]byte(chainID),
+		// Append the token
+		[]byte(token),
+	)
+}
+
+// ParseIdempotencyTokenExpiryKey returns the expiry time, chain ID and idempotency token
+// for a IdempotencyTokenExpiryKey key
+func ParseIdempotencyTokenExpiryKey(bz []byte) (time.Time, string, string, error) {
+	expectedPrefix := []byte{IdempotencyTokenExpiryBytePrefix}
+	prefixL := len(expectedPrefix)
+	if len(bz) < prefixL+16 {
+		return time.Time{}, "", "", fmt.Errorf("invalid key length; expected at least: %d, got: %d", prefixL+16, len(bz))
+	}
+	if prefix := bz[:prefixL]; !bytes.Equal(prefix, expectedPrefix) {
+		return time.Time{}, "", "", fmt.Errorf("invalid prefix; expected: %X, got: %X", expectedPrefix, prefix)
+	}
+	expiry := time.Unix(0, int64(sdk.BigEndianToUint64(bz[prefixL:prefixL+8]))).UTC()
+	chainIdL := sdk.BigEndianToUint64(bz[prefixL+8 : prefixL+16])
+	if uint64(len(bz)) < uint64(prefixL+16)+chainIdL {
+		return time.Time{}, "", "", fmt.Errorf("invalid key length; chain ID length: %d, key length: %d", chainIdL, len(bz))
+	}
+	chainID := string(bz[prefixL+16 : uint64(prefixL+16)+chainIdL])
+	token := string(bz[uint64(prefixL+16)+chainIdL:])
+	return expiry, chainID, token, nil
+}
+
+// UpdatedConsumerClientKey returns the key under which the flag recording
+// that the consumer client with the given clientID was updated in the current block is stored
+func UpdatedConsumerClientKey(clientID string) []byte {
+	return append([]byte{UpdatedConsumerClientBytePrefix}, []byte(clientID)...)
+}
+
+// PendingCAPByChainKey returns the key under which the pending consumer addition proposal
+// for the given chain ID and spawn time is indexed, with the following format:
+// PendingCAPByChainBytePrefix | len(chainID) | chainID | spawnTime
+// Thus, the index entries of a chain ID are ordered by spawn time.
+func PendingCAPByChainKey(chainID string, spawnTime time.Time) []byte {
+	return ChainIdAndTsKey(PendingCAPByChainBytePrefix, chainID, spawnTime)
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
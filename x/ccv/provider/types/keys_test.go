@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"bytes"
+	"sort"
 	"testing"
 	"time"
 
@@ -51,6 +53,41 @@ func getAllKeyPrefixes() []byte {
 		providertypes.KeyAssignmentReplacementsBytePrefix,
 		providertypes.ConsumerAddrsToPruneBytePrefix,
 		providertypes.SlashLogBytePrefix,
+		providertypes.PendingCAPSpawnTimeBytePrefix,
+		providertypes.ConsumerRewardsTotalsBytePrefix,
+		providertypes.ChainToCandidateClientBytePrefix,
+		providertypes.ConsumerPhaseBytePrefix,
+		providertypes.ConsumerValSetBytePrefix,
+		providertypes.ConsumerChainCountByteKey,
+		providertypes.FailedCAPBytePrefix,
+		providertypes.ConsumerAcceptedGenesisHashBytePrefix,
+		providertypes.ValsetUpdateBlockTimeBytePrefix,
+		providertypes.VscMaturityTimeBytePrefix,
+		providertypes.ConsumerJailedValidatorsBytePrefix,
+		providertypes.IdempotencyTokenBytePrefix,
+		providertypes.ConsumerCreationUnbondingTimeBytePrefix,
+		providertypes.ConsumerStatePreservedBytePrefix,
+		providertypes.VscSendFailuresBytePrefix,
+		providertypes.VscSendRetryHeightBytePrefix,
+		providertypes.RecentSpawnBytePrefix,
+		providertypes.CommittedGenesisHashBytePrefix,
+		providertypes.RewardDenomAllowlistBytePrefix,
+		providertypes.ClientToChainBytePrefix,
+		providertypes.LastMaturedVscIdBytePrefix,
+		providertypes.ConsumerInitParamsBytePrefix,
+		providertypes.ConsumerSpawnHeightBytePrefix,
+		providertypes.ApprovedValidatorBytePrefix,
+		providertypes.PendingValidatorApprovalBytePrefix,
+		providertypes.ConsumerSpawnFailureCountBytePrefix,
+		providertypes.ConsumerLatestSeenHeightBytePrefix,
+		providertypes.VscPendingChunkAcksBytePrefix,
+		providertypes.VscMaturityDeferredBytePrefix,
+		providertypes.LastProviderUnbondingTimeByteKey,
+		providertypes.ConsumerClientHistoryBytePrefix,
+		providertypes.PendingConsumerRewardsBytePrefix,
+		providertypes.IdempotencyTokenExpiryBytePrefix,
+		providertypes.UpdatedConsumerClientBytePrefix,
+		providertypes.PendingCAPByChainBytePrefix,
 	}
 }
 
@@ -94,6 +131,41 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.KeyAssignmentReplacementsKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
 		providertypes.ConsumerAddrsToPruneKey("chainID", 88),
 		providertypes.SlashLogKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.PendingCAPSpawnTimeKey("chainID"),
+		providertypes.ConsumerRewardsTotalsKey("chainID"),
+		providertypes.ChainToCandidateClientKey("chainID"),
+		providertypes.ConsumerPhaseKey("chainID"),
+		providertypes.ConsumerChainCountKey(),
+		providertypes.FailedCAPKey("chainID"),
+		providertypes.ConsumerAcceptedGenesisHashKey("chainID"),
+		providertypes.ValsetUpdateBlockTimeKey(7),
+		providertypes.VscMaturityTimeKey("chainID", 8),
+		providertypes.ConsumerJailedValidatorKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.IdempotencyTokenKey("chainID", "token"),
+		providertypes.ConsumerCreationUnbondingTimeKey("chainID"),
+		providertypes.ConsumerStatePreservedKey("chainID"),
+		providertypes.VscSendFailuresKey("chainID"),
+		providertypes.VscSendRetryHeightKey("chainID"),
+		providertypes.RecentSpawnKey(1),
+		providertypes.CommittedGenesisHashKey("chainID"),
+		providertypes.RewardDenomAllowlistKey("chainID", "denom"),
+		providertypes.ClientToChainKey("clientID"),
+		providertypes.LastMaturedVscIdKey("chainID"),
+		providertypes.ConsumerInitParamsKey("chainID"),
+		providertypes.ConsumerSpawnHeightKey("chainID"),
+		providertypes.ApprovedValidatorKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.PendingValidatorApprovalKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerValSetKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerSpawnFailureCountKey("chainID"),
+		providertypes.ConsumerLatestSeenHeightKey("chainID"),
+		providertypes.VscPendingChunkAcksKey("chainID", 1),
+		providertypes.VscMaturityDeferredKey("chainID", 1),
+		providertypes.LastProviderUnbondingTimeKey(),
+		providertypes.ConsumerClientHistoryKey("chainID", 1),
+		providertypes.PendingConsumerRewardsKey("chainID", "denom"),
+		providertypes.IdempotencyTokenExpiryKey(time.Time{}, "chainID", "token"),
+		providertypes.UpdatedConsumerClientKey("clientID"),
+		providertypes.PendingCAPByChainKey("chainID", time.Time{}),
 	}
 }
 
@@ -123,6 +195,82 @@ func TestChainIdAndTsKeyAndParse(t *testing.T) {
 	}
 }
 
+// TestPendingPropKeysAreUniqueAndOrdered tests that the keys of pending consumer addition and removal
+// proposals are unique and ordered by time first and by chain ID second, also for adversarial chain IDs,
+// i.e., chain IDs that are prefixes of each other or that start with the bytes of a timestamp.
+// Since the timestamp has a fixed length, a key cannot collide with the key of a different time and chain ID.
+func TestPendingPropKeysAreUniqueAndOrdered(t *testing.T) {
+	ts := time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC)
+	later := ts.Add(time.Nanosecond)
+	laterBz := string(sdk.Uint64ToBigEndian(uint64(later.UnixNano())))
+
+	type entry struct {
+		timestamp time.Time
+		chainID   string
+	}
+	entries := []entry{
+		{later, "chain-1"},
+		{ts, "chain-1"},
+		{ts, laterBz + "chain"},
+		{later, "chain"},
+		{ts, "chain"},
+		{ts, "chain\x00"},
+		{ts, laterBz},
+		{later, ""},
+		{ts, ""},
+		{ts, "chain/1"},
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].timestamp.Equal(entries[j].timestamp) {
+			return entries[i].timestamp.Before(entries[j].timestamp)
+		}
+		return entries[i].chainID < entries[j].chainID
+	})
+
+	for _, keyFunc := range []func(time.Time, string) []byte{
+		providertypes.PendingCAPKey,
+		providertypes.PendingCRPKey,
+	} {
+		for i := 1; i < len(entries); i++ {
+			prev := keyFunc(entries[i-1].timestamp, entries[i-1].chainID)
+			next := keyFunc(entries[i].timestamp, entries[i].chainID)
+			require.Equal(t, -1, bytes.Compare(prev, next),
+				"keys not strictly ordered: %v, %v", entries[i-1], entries[i])
+		}
+	}
+}
+
+// Tests the construction and parsing of IdempotencyTokenExpiry keys
+func TestIdempotencyTokenExpiryKeyAndParse(t *testing.T) {
+	tests := []struct {
+		expiry  time.Time
+		chainID string
+		token   string
+	}{
+		{expiry: time.Now(), chainID: "1", token: "token"},
+		{expiry: time.Date(2003, 11, 17, 20, 34, 58, 651387237, time.UTC), chainID: "some other ID", token: ""},
+		{expiry: time.Now().Add(5000 * time.Hour), chainID: "", token: "some other token"},
+	}
+
+	for _, test := range tests {
+		key := providertypes.IdempotencyTokenExpiryKey(test.expiry, test.chainID, test.token)
+		require.NotEmpty(t, key)
+		// Expected bytes = prefix + time bytes + chainID length + chainID + token
+		expectedLen := 1 + 8 + 8 + len(test.chainID) + len(test.token)
+		require.Equal(t, expectedLen, len(key))
+		parsedExpiry, parsedID, parsedToken, err := providertypes.ParseIdempotencyTokenExpiryKey(key)
+		require.NoError(t, err)
+		require.Equal(t, test.expiry.UTC(), parsedExpiry)
+		require.Equal(t, test.chainID, parsedID)
+		require.Equal(t, test.token, parsedToken)
+	}
+
+	// keys are ordered by expiry time first
+	earlier := providertypes.IdempotencyTokenExpiryKey(time.Unix(1, 0), "z", "z")
+	later := providertypes.IdempotencyTokenExpiryKey(time.Unix(2, 0), "a", "a")
+	require.Equal(t, -1, bytes.Compare(earlier, later))
+}
+
 // Tests the construction and parsing of ChainIdAndUintId keys
 func TestChainIdAndUintIdAndParse(t *testing.T) {
 	tests := []struct {
@@ -247,6 +395,11 @@ func TestKeysWithPrefixAndId(t *testing.T) {
 		providertypes.SlashAcksKey,
 		providertypes.InitChainHeightKey,
 		providertypes.PendingVSCsKey,
+		providertypes.ConsumerCreationUnbondingTimeKey,
+		providertypes.ConsumerStatePreservedKey,
+		providertypes.VscSendFailuresKey,
+		providertypes.VscSendRetryHeightKey,
+		providertypes.CommittedGenesisHashKey,
 	}
 
 	expectedBytePrefixes := []byte{
@@ -258,6 +411,11 @@ func TestKeysWithPrefixAndId(t *testing.T) {
 		providertypes.SlashAcksBytePrefix,
 		providertypes.InitChainHeightBytePrefix,
 		providertypes.PendingVSCsBytePrefix,
+		providertypes.ConsumerCreationUnbondingTimeBytePrefix,
+		providertypes.ConsumerStatePreservedBytePrefix,
+		providertypes.VscSendFailuresBytePrefix,
+		providertypes.VscSendRetryHeightBytePrefix,
+		providertypes.CommittedGenesisHashBytePrefix,
 	}
 
 	tests := []struct {
@@ -281,11 +439,13 @@ func TestKeysWithUint64Payload(t *testing.T) {
 	funcs := []func(uint64) []byte{
 		providertypes.UnbondingOpKey,
 		providertypes.ValsetUpdateBlockHeightKey,
+		providertypes.ValsetUpdateBlockTimeKey,
 	}
 
 	expectedBytePrefixes := []byte{
 		providertypes.UnbondingOpBytePrefix,
 		providertypes.ValsetUpdateBlockHeightBytePrefix,
+		providertypes.ValsetUpdateBlockTimeBytePrefix,
 	}
 
 	tests := []struct {
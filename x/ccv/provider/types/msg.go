@@ -3,16 +3,39 @@ package types
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 )
 
 // provider message types
 const (
-	TypeMsgAssignConsumerKey = "assign_consumer_key"
+	TypeMsgAssignConsumerKey               = "assign_consumer_key"
+	TypeMsgRequeueConsumerAdditionProposal = "requeue_consumer_addition_proposal"
+	TypeMsgPurgeConsumerState              = "purge_consumer_state"
+	TypeMsgPurgeAllPendingClients          = "purge_all_pending_clients"
+	TypeMsgUpdateParams                    = "update_params"
+	TypeMsgUpdateRewardDenomAllowlist      = "update_reward_denom_allowlist"
+	TypeMsgForceSpawnPendingClient         = "force_spawn_pending_client"
+	TypeMsgForceMatureVscPackets           = "force_mature_vsc_packets"
+	TypeMsgApproveConsumerValidator        = "approve_consumer_validator"
+	TypeMsgRenameConsumerChain             = "rename_consumer_chain"
 )
 
-var _ sdk.Msg = &MsgAssignConsumerKey{}
+var (
+	_ sdk.Msg = &MsgAssignConsumerKey{}
+	_ sdk.Msg = &MsgRequeueConsumerAdditionProposal{}
+	_ sdk.Msg = &MsgPurgeConsumerState{}
+	_ sdk.Msg = &MsgPurgeAllPendingClients{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateRewardDenomAllowlist{}
+	_ sdk.Msg = &MsgForceSpawnPendingClient{}
+	_ sdk.Msg = &MsgForceMatureVscPackets{}
+	_ sdk.Msg = &MsgApproveConsumerValidator{}
+	_ sdk.Msg = &MsgRenameConsumerChain{}
+)
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
 // Delegator address and validator address are the same.
@@ -93,3 +116,408 @@ func ParseConsumerKeyFromJson(jsonStr string) (pkType, key string, err error) {
 	}
 	return pubKey.Type, pubKey.Key, nil
 }
+
+// NewMsgRequeueConsumerAdditionProposal creates a new MsgRequeueConsumerAdditionProposal instance.
+func NewMsgRequeueConsumerAdditionProposal(authority, chainID string, spawnTime time.Time,
+	initialHeight clienttypes.Height,
+) *MsgRequeueConsumerAdditionProposal {
+	return &MsgRequeueConsumerAdditionProposal{
+		Authority:     authority,
+		ChainId:       chainID,
+		SpawnTime:     spawnTime,
+		InitialHeight: initialHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRequeueConsumerAdditionProposal) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRequeueConsumerAdditionProposal) Type() string {
+	return TypeMsgRequeueConsumerAdditionProposal
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgRequeueConsumerAdditionProposal) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRequeueConsumerAdditionProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRequeueConsumerAdditionProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	if msg.SpawnTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "spawn time cannot be zero")
+	}
+	if msg.InitialHeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "initial height cannot be zero")
+	}
+	return nil
+}
+
+// NewMsgPurgeConsumerState creates a new MsgPurgeConsumerState instance.
+func NewMsgPurgeConsumerState(authority, chainID string) *MsgPurgeConsumerState {
+	return &MsgPurgeConsumerState{
+		Authority: authority,
+		ChainId:   chainID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPurgeConsumerState) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgPurgeConsumerState) Type() string {
+	return TypeMsgPurgeConsumerState
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgPurgeConsumerState) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPurgeConsumerState) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPurgeConsumerState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	return nil
+}
+
+// NewMsgPurgeAllPendingClients creates a new MsgPurgeAllPendingClients instance.
+func NewMsgPurgeAllPendingClients(authority string) *MsgPurgeAllPendingClients {
+	return &MsgPurgeAllPendingClients{
+		Authority: authority,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgPurgeAllPendingClients) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgPurgeAllPendingClients) Type() string {
+	return TypeMsgPurgeAllPendingClients
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgPurgeAllPendingClients) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPurgeAllPendingClients) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgPurgeAllPendingClients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// NewMsgUpdateRewardDenomAllowlist creates a new MsgUpdateRewardDenomAllowlist instance.
+func NewMsgUpdateRewardDenomAllowlist(authority, chainID string, denoms []string) *MsgUpdateRewardDenomAllowlist {
+	return &MsgUpdateRewardDenomAllowlist{
+		Authority: authority,
+		ChainId:   chainID,
+		Denoms:    denoms,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateRewardDenomAllowlist) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateRewardDenomAllowlist) Type() string {
+	return TypeMsgUpdateRewardDenomAllowlist
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgUpdateRewardDenomAllowlist) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateRewardDenomAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateRewardDenomAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	if err := ValidateRewardDenomAllowlist(msg.Denoms); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgForceSpawnPendingClient creates a new MsgForceSpawnPendingClient instance.
+func NewMsgForceSpawnPendingClient(authority, chainID string) *MsgForceSpawnPendingClient {
+	return &MsgForceSpawnPendingClient{
+		Authority: authority,
+		ChainId:   chainID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgForceSpawnPendingClient) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgForceSpawnPendingClient) Type() string {
+	return TypeMsgForceSpawnPendingClient
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgForceSpawnPendingClient) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgForceSpawnPendingClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgForceSpawnPendingClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	return nil
+}
+
+// NewMsgForceMatureVscPackets creates a new MsgForceMatureVscPackets instance.
+func NewMsgForceMatureVscPackets(authority, chainID string) *MsgForceMatureVscPackets {
+	return &MsgForceMatureVscPackets{
+		Authority: authority,
+		ChainId:   chainID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgForceMatureVscPackets) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgForceMatureVscPackets) Type() string {
+	return TypeMsgForceMatureVscPackets
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgForceMatureVscPackets) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgForceMatureVscPackets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgForceMatureVscPackets) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	return nil
+}
+
+// NewMsgApproveConsumerValidator creates a new MsgApproveConsumerValidator instance.
+func NewMsgApproveConsumerValidator(authority, chainID string, providerAddr sdk.ConsAddress) *MsgApproveConsumerValidator {
+	return &MsgApproveConsumerValidator{
+		Authority:    authority,
+		ChainId:      chainID,
+		ProviderAddr: providerAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgApproveConsumerValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgApproveConsumerValidator) Type() string {
+	return TypeMsgApproveConsumerValidator
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgApproveConsumerValidator) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgApproveConsumerValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgApproveConsumerValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.ChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	if _, err := sdk.ConsAddressFromBech32(msg.ProviderAddr); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProviderAddress, "%s", err)
+	}
+	return nil
+}
+
+// NewMsgRenameConsumerChain creates a new MsgRenameConsumerChain instance.
+func NewMsgRenameConsumerChain(authority, oldChainID, newChainID string) *MsgRenameConsumerChain {
+	return &MsgRenameConsumerChain{
+		Authority:  authority,
+		OldChainId: oldChainID,
+		NewChainId: newChainID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRenameConsumerChain) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRenameConsumerChain) Type() string {
+	return TypeMsgRenameConsumerChain
+}
+
+// GetSigners implements the sdk.Msg interface. It returns the authority address.
+func (msg MsgRenameConsumerChain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRenameConsumerChain) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRenameConsumerChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if strings.TrimSpace(msg.OldChainId) == "" || strings.TrimSpace(msg.NewChainId) == "" {
+		return ErrBlankConsumerChainID
+	}
+	if msg.OldChainId == msg.NewChainId {
+		return sdkerrors.Wrapf(ErrInvalidConsumerChainRename, "the new chain id is the same as the old chain id: %s", msg.OldChainId)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
@@ -38,17 +39,60 @@ const (
 	// DefaultMaxThrottledPackets defines the default amount of throttled slash or vsc matured packets
 	// that can be queued for a single consumer before the provider chain halts.
 	DefaultMaxThrottledPackets = 100000
+
+	// DefaultConsumerRewardsToCommunityPoolFraction defines the default fraction of the rewards
+	// received from consumer chains that is sent to the community pool.
+	DefaultConsumerRewardsToCommunityPoolFraction = "0"
+
+	// DefaultTopN defines the default number of validators, selected by power,
+	// that validate a consumer chain. Zero means that, by default, all the bonded
+	// validators validate a consumer chain.
+	DefaultTopN = uint32(0)
+
+	// DefaultMaxSpawnTimeLag defines the default maximum lag of the spawn time of a consumer
+	// addition proposal behind the block time at which the proposal is handled. It is well above
+	// the deposit and voting periods of a proposal, so that proposals with a spawn time set
+	// at submission, i.e., meant to spawn the consumer chain immediately, are not rejected.
+	DefaultMaxSpawnTimeLag = 8 * 7 * 24 * time.Hour
+
+	// DefaultMaxVscSendBackoffBlocks defines the default maximum number of blocks the provider
+	// waits before retrying to send the pending VSC packets to a consumer chain after send failures.
+	DefaultMaxVscSendBackoffBlocks = 100
+
+	// DefaultMaxRecentSpawns defines the default maximum number of entries
+	// in the log of recent consumer chain spawns
+	DefaultMaxRecentSpawns = 100
+
+	// DefaultReconciliationInterval defines the default interval, in blocks, at which the provider
+	// reconciles the consumer client and channel mappings with the IBC state
+	DefaultReconciliationInterval = 1000
+
+	// DefaultReconciliationRemoveDangling defines whether the reconciliation stops the consumer chains
+	// with dangling client or channel mappings and removes dangling channel mappings by default
+	DefaultReconciliationRemoveDangling = false
 )
 
+// VscSendFailuresEventThreshold is the number of consecutive failures to send the pending
+// VSC packets to a consumer chain after which the CCV channel is considered persistently
+// broken and an event is emitted on every failure.
+const VscSendFailuresEventThreshold = 5
+
 // Reflection based keys for params subspace
 var (
-	KeyTemplateClient              = []byte("TemplateClient")
-	KeyTrustingPeriodFraction      = []byte("TrustingPeriodFraction")
-	KeyInitTimeoutPeriod           = []byte("InitTimeoutPeriod")
-	KeyVscTimeoutPeriod            = []byte("VscTimeoutPeriod")
-	KeySlashMeterReplenishPeriod   = []byte("SlashMeterReplenishPeriod")
-	KeySlashMeterReplenishFraction = []byte("SlashMeterReplenishFraction")
-	KeyMaxThrottledPackets         = []byte("MaxThrottledPackets")
+	KeyTemplateClient                         = []byte("TemplateClient")
+	KeyTrustingPeriodFraction                 = []byte("TrustingPeriodFraction")
+	KeyInitTimeoutPeriod                      = []byte("InitTimeoutPeriod")
+	KeyVscTimeoutPeriod                       = []byte("VscTimeoutPeriod")
+	KeySlashMeterReplenishPeriod              = []byte("SlashMeterReplenishPeriod")
+	KeySlashMeterReplenishFraction            = []byte("SlashMeterReplenishFraction")
+	KeyMaxThrottledPackets                    = []byte("MaxThrottledPackets")
+	KeyConsumerRewardsToCommunityPoolFraction = []byte("ConsumerRewardsToCommunityPoolFraction")
+	KeyDefaultTopN                            = []byte("DefaultTopN")
+	KeyMaxSpawnTimeLag                        = []byte("MaxSpawnTimeLag")
+	KeyMaxVscSendBackoffBlocks                = []byte("MaxVscSendBackoffBlocks")
+	KeyMaxRecentSpawns                        = []byte("MaxRecentSpawns")
+	KeyReconciliationInterval                 = []byte("ReconciliationInterval")
+	KeyReconciliationRemoveDangling           = []byte("ReconciliationRemoveDangling")
 )
 
 // ParamKeyTable returns a key table with the necessary registered provider params
@@ -66,16 +110,30 @@ func NewParams(
 	slashMeterReplenishPeriod time.Duration,
 	slashMeterReplenishFraction string,
 	maxThrottledPackets int64,
+	consumerRewardsToCommunityPoolFraction string,
+	defaultTopN uint32,
+	maxSpawnTimeLag time.Duration,
+	maxVscSendBackoffBlocks int64,
+	maxRecentSpawns int64,
+	reconciliationInterval int64,
+	reconciliationRemoveDangling bool,
 ) Params {
 	return Params{
-		TemplateClient:              cs,
-		TrustingPeriodFraction:      trustingPeriodFraction,
-		CcvTimeoutPeriod:            ccvTimeoutPeriod,
-		InitTimeoutPeriod:           initTimeoutPeriod,
-		VscTimeoutPeriod:            vscTimeoutPeriod,
-		SlashMeterReplenishPeriod:   slashMeterReplenishPeriod,
-		SlashMeterReplenishFraction: slashMeterReplenishFraction,
-		MaxThrottledPackets:         maxThrottledPackets,
+		TemplateClient:                         cs,
+		TrustingPeriodFraction:                 trustingPeriodFraction,
+		CcvTimeoutPeriod:                       ccvTimeoutPeriod,
+		InitTimeoutPeriod:                      initTimeoutPeriod,
+		VscTimeoutPeriod:                       vscTimeoutPeriod,
+		SlashMeterReplenishPeriod:              slashMeterReplenishPeriod,
+		SlashMeterReplenishFraction:            slashMeterReplenishFraction,
+		MaxThrottledPackets:                    maxThrottledPackets,
+		ConsumerRewardsToCommunityPoolFraction: consumerRewardsToCommunityPoolFraction,
+		DefaultTopN:                            defaultTopN,
+		MaxSpawnTimeLag:                        maxSpawnTimeLag,
+		MaxVscSendBackoffBlocks:                maxVscSendBackoffBlocks,
+		MaxRecentSpawns:                        maxRecentSpawns,
+		ReconciliationInterval:                 reconciliationInterval,
+		ReconciliationRemoveDangling:           reconciliationRemoveDangling,
 	}
 }
 
@@ -103,6 +161,13 @@ func DefaultParams() Params {
 		DefaultSlashMeterReplenishPeriod,
 		DefaultSlashMeterReplenishFraction,
 		DefaultMaxThrottledPackets,
+		DefaultConsumerRewardsToCommunityPoolFraction,
+		DefaultTopN,
+		DefaultMaxSpawnTimeLag,
+		DefaultMaxVscSendBackoffBlocks,
+		DefaultMaxRecentSpawns,
+		DefaultReconciliationInterval,
+		DefaultReconciliationRemoveDangling,
 	)
 }
 
@@ -117,6 +182,10 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidateStringFraction(p.TrustingPeriodFraction); err != nil {
 		return fmt.Errorf("trusting period fraction is invalid: %s", err)
 	}
+	// the trusting period of the provider client must be less than the unbonding period
+	if sdk.MustNewDecFromStr(p.TrustingPeriodFraction).GTE(sdk.OneDec()) {
+		return fmt.Errorf("trusting period fraction is invalid: must be less than 1, got %s", p.TrustingPeriodFraction)
+	}
 	if err := ccvtypes.ValidateDuration(p.CcvTimeoutPeriod); err != nil {
 		return fmt.Errorf("ccv timeout period is invalid: %s", err)
 	}
@@ -135,6 +204,24 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxThrottledPackets); err != nil {
 		return fmt.Errorf("max throttled packets is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidateStringFraction(p.ConsumerRewardsToCommunityPoolFraction); err != nil {
+		return fmt.Errorf("consumer rewards to community pool fraction is invalid: %s", err)
+	}
+	if err := validateTopN(p.DefaultTopN); err != nil {
+		return fmt.Errorf("default top N is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateDuration(p.MaxSpawnTimeLag); err != nil {
+		return fmt.Errorf("max spawn time lag is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidatePositiveInt64(p.MaxVscSendBackoffBlocks); err != nil {
+		return fmt.Errorf("max vsc send backoff blocks is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidatePositiveInt64(p.MaxRecentSpawns); err != nil {
+		return fmt.Errorf("max recent spawns is invalid: %s", err)
+	}
+	if err := validateReconciliationInterval(p.ReconciliationInterval); err != nil {
+		return fmt.Errorf("reconciliation interval is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -149,7 +236,35 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishPeriod, p.SlashMeterReplenishPeriod, ccvtypes.ValidateDuration),
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishFraction, p.SlashMeterReplenishFraction, ccvtypes.ValidateStringFraction),
 		paramtypes.NewParamSetPair(KeyMaxThrottledPackets, p.MaxThrottledPackets, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyConsumerRewardsToCommunityPoolFraction,
+			p.ConsumerRewardsToCommunityPoolFraction, ccvtypes.ValidateStringFraction),
+		paramtypes.NewParamSetPair(KeyDefaultTopN, p.DefaultTopN, validateTopN),
+		paramtypes.NewParamSetPair(KeyMaxSpawnTimeLag, p.MaxSpawnTimeLag, ccvtypes.ValidateDuration),
+		paramtypes.NewParamSetPair(KeyMaxVscSendBackoffBlocks, p.MaxVscSendBackoffBlocks, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyMaxRecentSpawns, p.MaxRecentSpawns, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyReconciliationInterval, p.ReconciliationInterval, validateReconciliationInterval),
+		paramtypes.NewParamSetPair(KeyReconciliationRemoveDangling, p.ReconciliationRemoveDangling, ccvtypes.ValidateBool),
+	}
+}
+
+// validateTopN validates the default top N. Note that any value is valid,
+// as zero means that all the bonded validators validate the consumer chain.
+func validateTopN(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateReconciliationInterval(i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if interval < 0 {
+		return fmt.Errorf("reconciliation interval cannot be negative")
 	}
+	return nil
 }
 
 func validateTemplateClient(i interface{}) error {
@@ -21,35 +21,59 @@ func TestValidateParams(t *testing.T) {
 		{"default params", types.DefaultParams(), true},
 		{"custom valid params", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), true},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), true},
 		{"custom invalid params", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			0, clienttypes.Height{}, nil, []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"blank client", types.NewParams(&ibctmtypes.ClientState{},
-			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
-		{"nil client", types.NewParams(nil, "0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"nil client", types.NewParams(nil, "0.33", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		// Check if "0.00" is valid or if a zero dec TrustFraction needs to return an error
 		{"0 trusting period fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.00", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), true},
+			"0.00", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), true},
+		{"trusting period fraction of 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"1", time.Hour, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"0 ccv timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", 0, time.Hour, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", 0, time.Hour, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"0 init timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, 0, time.Hour, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, 0, time.Hour, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"0 vsc timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 0, 30*time.Minute, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, 0, 30*time.Minute, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"0 slash meter replenish period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, 0, "0.1", 100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, 0, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"slash meter replenish fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "1.5", 100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "1.5", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
 		{"negative max pending slash packets", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
-			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", -100), false},
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", -100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"consumer rewards to community pool fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "1.1", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"0 default top N, i.e., all bonded validators", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", 0, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), true},
+		{"0 max spawn time lag", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", types.DefaultTopN, 0, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"0 max vsc send backoff blocks", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, 0, types.DefaultMaxRecentSpawns, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"0 max recent spawns", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, 0, types.DefaultReconciliationInterval, types.DefaultReconciliationRemoveDangling), false},
+		{"0 reconciliation interval", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, 0, true), true},
+		{"negative reconciliation interval", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}, true, false),
+			"0.33", time.Hour, time.Hour, 24*time.Hour, time.Hour, "0.1", 100, "0", types.DefaultTopN, types.DefaultMaxSpawnTimeLag, types.DefaultMaxVscSendBackoffBlocks, types.DefaultMaxRecentSpawns, -1, false), false},
 	}
 
 	for _, tc := range testCases {
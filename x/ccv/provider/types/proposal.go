@@ -3,35 +3,74 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	time "time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	ccvtypes "github.com/cosmos/interchain-security/x/ccv/types"
+	"github.com/tendermint/tendermint/light"
 )
 
 const (
-	ProposalTypeConsumerAddition = "ConsumerAddition"
-	ProposalTypeConsumerRemoval  = "ConsumerRemoval"
-	ProposalTypeEquivocation     = "Equivocation"
+	ProposalTypeConsumerAddition    = "ConsumerAddition"
+	ProposalTypeConsumerRemoval     = "ConsumerRemoval"
+	ProposalTypeEquivocation        = "Equivocation"
+	ProposalTypeResetConsumerClient = "ResetConsumerClient"
+)
+
+const (
+	// MaxGenesisTimeOffset is the maximum offset between the spawn block time
+	// and the genesis time of a consumer chain
+	MaxGenesisTimeOffset = 24 * time.Hour
+
+	// MaxIdempotencyTokenLength is the maximum length of the idempotency token of a consumer addition proposal
+	MaxIdempotencyTokenLength = 128
+
+	// MaxPowerMultiplier is the maximum multiplier applied to the voting powers sent to a consumer chain,
+	// such that the scaled voting powers cannot overflow
+	MaxPowerMultiplier = 100
+
+	// IdempotencyTokenRetentionPeriod is the period during which the idempotency token
+	// of a handled consumer addition proposal is retained
+	IdempotencyTokenRetentionPeriod = 4 * 7 * 24 * time.Hour
+
+	// MaxClockDriftLimit is the maximum max clock drift of the clients of a consumer chain,
+	// such that the clients still reject headers from the far future
+	MaxClockDriftLimit = time.Hour
+
+	// MaxAdditionalGenesisStateLength is the maximum length in bytes of the genesis states
+	// of other modules of a consumer chain carried by a consumer addition proposal
+	MaxAdditionalGenesisStateLength = 64 * 1024
+
+	// MaxInitialBalances is the maximum number of accounts a consumer addition proposal
+	// can pre-fund in the consumer genesis
+	MaxInitialBalances = 100
 )
 
 var (
 	_ govtypes.Content = &ConsumerAdditionProposal{}
 	_ govtypes.Content = &ConsumerRemovalProposal{}
 	_ govtypes.Content = &EquivocationProposal{}
+	_ govtypes.Content = &ResetConsumerClientProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeConsumerAddition)
 	govtypes.RegisterProposalType(ProposalTypeConsumerRemoval)
 	govtypes.RegisterProposalType(ProposalTypeEquivocation)
+	govtypes.RegisterProposalType(ProposalTypeResetConsumerClient)
 }
 
 // NewConsumerAdditionProposal creates a new consumer addition proposal.
+// The optional fields of the proposal are left unset, i.e., they can be set
+// on the returned proposal or the proposal can be built as a struct literal.
 func NewConsumerAdditionProposal(title, description, chainID string,
 	initialHeight clienttypes.Height, genesisHash, binaryHash []byte,
 	spawnTime time.Time,
@@ -59,6 +98,51 @@ func NewConsumerAdditionProposal(title, description, chainID string,
 	}
 }
 
+// ParseConsumerPowerReduction parses the power reduction of a consumer chain,
+// which must be a positive integer.
+func ParseConsumerPowerReduction(powerReduction string) (sdk.Int, error) {
+	reduction, ok := sdk.NewIntFromString(powerReduction)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("consumer power reduction %q is not an integer", powerReduction)
+	}
+	if !reduction.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("consumer power reduction must be positive, got %s", reduction)
+	}
+	return reduction, nil
+}
+
+// ParsePowerMultiplier parses the multiplier applied to the voting powers sent to a consumer chain,
+// which must be a positive decimal of at most MaxPowerMultiplier.
+func ParsePowerMultiplier(powerMultiplier string) (sdk.Dec, error) {
+	multiplier, err := sdk.NewDecFromStr(powerMultiplier)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("power multiplier %q is not a decimal: %w", powerMultiplier, err)
+	}
+	if !multiplier.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("power multiplier must be positive, got %s", multiplier)
+	}
+	if multiplier.GT(sdk.NewDec(MaxPowerMultiplier)) {
+		return sdk.Dec{}, fmt.Errorf("power multiplier cannot exceed %d, got %s", MaxPowerMultiplier, multiplier)
+	}
+	return multiplier, nil
+}
+
+// ValidateRewardDenomAllowlist validates the denoms a consumer chain may send as rewards,
+// which must be valid and unique.
+func ValidateRewardDenomAllowlist(denoms []string) error {
+	seen := map[string]bool{}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid reward denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate reward denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
 // GetTitle returns the title of a consumer addition proposal.
 func (cccp *ConsumerAdditionProposal) GetTitle() string { return cccp.Title }
 
@@ -87,6 +171,28 @@ func (cccp *ConsumerAdditionProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "initial height cannot be zero")
 	}
 
+	// the consumer client of an existing standalone chain must track the revision of its chain id,
+	// otherwise the client cannot be updated with the headers of the standalone chain
+	if cccp.StandaloneChangeover && cccp.InitialHeight.RevisionNumber != clienttypes.ParseChainID(cccp.ChainId) {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal,
+			"initial height revision number %d does not match the revision of the standalone chain id %s",
+			cccp.InitialHeight.RevisionNumber, cccp.ChainId)
+	}
+
+	// the consumer client of an existing standalone chain starts at the initial height, i.e.,
+	// an initial height below the latest height of the standalone chain is implausible.
+	// Note that a new chain can start at any initial height, e.g., 1.
+	if cccp.StandaloneChangeover {
+		if cccp.StandaloneLatestHeight == 0 {
+			return sdkerrors.Wrap(ErrInvalidInitialHeight, "standalone latest height must be set for a standalone changeover")
+		}
+		if cccp.InitialHeight.RevisionHeight < cccp.StandaloneLatestHeight {
+			return sdkerrors.Wrapf(ErrInvalidInitialHeight,
+				"initial height %d is below the latest height %d of the standalone chain",
+				cccp.InitialHeight.RevisionHeight, cccp.StandaloneLatestHeight)
+		}
+	}
+
 	if len(cccp.GenesisHash) == 0 {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "genesis hash cannot be empty")
 	}
@@ -122,6 +228,120 @@ func (cccp *ConsumerAdditionProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "unbonding period cannot be zero")
 	}
 
+	// a zero consumer native unbonding period defaults to the unbonding period of the proposal
+	if cccp.ConsumerNativeUnbondingPeriod != 0 {
+		if err := ccvtypes.ValidateDuration(cccp.ConsumerNativeUnbondingPeriod); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "consumer native unbonding period must be positive")
+		}
+	}
+
+	// a zero consumer downtime jail duration defaults to the provider downtime jail duration
+	if cccp.ConsumerDowntimeJailDuration != 0 {
+		if err := ccvtypes.ValidateDuration(cccp.ConsumerDowntimeJailDuration); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "consumer downtime jail duration must be positive")
+		}
+	}
+
+	// a zero VSC packet timeout period defaults to the provider ccv timeout period
+	if cccp.VscPacketTimeoutPeriod != 0 {
+		if err := ccvtypes.ValidateDuration(cccp.VscPacketTimeoutPeriod); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "VSC packet timeout period must be positive")
+		}
+	}
+
+	// a zero max clock drift defaults to the max clock drift of the template client
+	if cccp.MaxClockDrift != 0 {
+		if err := ccvtypes.ValidateDuration(cccp.MaxClockDrift); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "max clock drift must be positive")
+		}
+		if cccp.MaxClockDrift > MaxClockDriftLimit {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "max clock drift cannot exceed %s", MaxClockDriftLimit)
+		}
+	}
+
+	// a nil trust level defaults to the trust level of the template client
+	if cccp.TrustLevel != nil {
+		if err := light.ValidateTrustLevel(cccp.TrustLevel.ToTendermint()); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "trust level is invalid: %s", err)
+		}
+	}
+
+	if cccp.GenesisTimeOffset < 0 {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "genesis time offset cannot be negative")
+	}
+	if cccp.GenesisTimeOffset > MaxGenesisTimeOffset {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "genesis time offset cannot exceed %s", MaxGenesisTimeOffset)
+	}
+
+	// a zero initial validator set height defaults to the validator set at spawn time
+	if cccp.InitialValSetHeight > math.MaxInt64 {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "initial validator set height is too large")
+	}
+
+	if len(cccp.IdempotencyToken) > MaxIdempotencyTokenLength {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "idempotency token cannot exceed %d characters", MaxIdempotencyTokenLength)
+	}
+
+	if cccp.MinProviderPower < 0 {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, "min provider power cannot be negative")
+	}
+
+	if cccp.ConsumerPowerReduction != "" {
+		if _, err := ParseConsumerPowerReduction(cccp.ConsumerPowerReduction); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if cccp.PowerMultiplier != "" {
+		if _, err := ParsePowerMultiplier(cccp.PowerMultiplier); err != nil {
+			return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if cccp.CcvConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(cccp.CcvConnectionId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "invalid ccv connection id: %s", err)
+		}
+	}
+
+	if cccp.CcvChannelId != "" {
+		if err := host.ChannelIdentifierValidator(cccp.CcvChannelId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "invalid ccv channel id: %s", err)
+		}
+	}
+
+	if cccp.ExpectedProviderConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(cccp.ExpectedProviderConnectionId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "invalid expected provider connection id: %s", err)
+		}
+	}
+
+	if cccp.ExpectedProviderChannelId != "" {
+		if err := host.ChannelIdentifierValidator(cccp.ExpectedProviderChannelId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "invalid expected provider channel id: %s", err)
+		}
+	}
+
+	if _, err := sdk.ParseDecCoins(cccp.ConsumerMinGasPrices); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConsumerAdditionProposal, "invalid consumer min gas prices: %s", err)
+	}
+
+	if err := ValidateAdditionalGenesisState(cccp.AdditionalGenesisState); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if err := ValidateRewardDenomAllowlist(cccp.RewardDenomAllowlist); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if _, err := ccvtypes.ParseRelayerAllowlist(cccp.RelayerAllowlist); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if err := ValidateInitialBalances(cccp.InitialBalances); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
 	return nil
 }
 
@@ -140,7 +360,33 @@ func (cccp *ConsumerAdditionProposal) String() string {
 	HistoricalEntries: %d
 	CcvTimeoutPeriod: %d
 	TransferTimeoutPeriod: %d
-	UnbondingPeriod: %d`,
+	UnbondingPeriod: %d
+	SlashEnabled: %t
+	ConsumerNativeUnbondingPeriod: %d
+	TopN: %d
+	GenesisTimeOffset: %d
+	IdempotencyToken: %s
+	MinProviderPower: %d
+	ConsumerPowerReduction: %s
+	PowerMultiplier: %s
+	CcvConnectionId: %s
+	CcvChannelId: %s
+	RewardDenomAllowlist: %s
+	RelayerAllowlist: %s
+	StandaloneChangeover: %t
+	ConsumerDowntimeJailDuration: %d
+	ExpectedProviderConnectionId: %s
+	ExpectedProviderChannelId: %s
+	MaxClockDrift: %d
+	ValidatorApprovalRequired: %t
+	ConsumerMinGasPrices: %s
+	AdditionalGenesisState: %s
+	VscPacketTimeoutPeriod: %d
+	InitialValSetHeight: %d
+	StandaloneLatestHeight: %d
+	TrustLevel: %s
+	InitialBalances: %s
+	UseRealCommitmentRoot: %t`,
 		cccp.Title,
 		cccp.Description,
 		cccp.ChainId,
@@ -153,16 +399,46 @@ func (cccp *ConsumerAdditionProposal) String() string {
 		cccp.HistoricalEntries,
 		cccp.CcvTimeoutPeriod,
 		cccp.TransferTimeoutPeriod,
-		cccp.UnbondingPeriod)
+		cccp.UnbondingPeriod,
+		cccp.SlashEnabled,
+		cccp.ConsumerNativeUnbondingPeriod,
+		cccp.TopN,
+		cccp.GenesisTimeOffset,
+		cccp.IdempotencyToken,
+		cccp.MinProviderPower,
+		cccp.ConsumerPowerReduction,
+		cccp.PowerMultiplier,
+		cccp.CcvConnectionId,
+		cccp.CcvChannelId,
+		strings.Join(cccp.RewardDenomAllowlist, ","),
+		strings.Join(cccp.RelayerAllowlist, ","),
+		cccp.StandaloneChangeover,
+		cccp.ConsumerDowntimeJailDuration,
+		cccp.ExpectedProviderConnectionId,
+		cccp.ExpectedProviderChannelId,
+		cccp.MaxClockDrift,
+		cccp.ValidatorApprovalRequired,
+		cccp.ConsumerMinGasPrices,
+		cccp.AdditionalGenesisState,
+		cccp.VscPacketTimeoutPeriod,
+		cccp.InitialValSetHeight,
+		cccp.StandaloneLatestHeight,
+		cccp.TrustLevel,
+		cccp.InitialBalances,
+		cccp.UseRealCommitmentRoot)
 }
 
 // NewConsumerRemovalProposal creates a new consumer removal proposal.
-func NewConsumerRemovalProposal(title, description, chainID string, stopTime time.Time) govtypes.Content {
+func NewConsumerRemovalProposal(title, description, chainID string, stopTime time.Time, preserveState bool,
+	cooldown time.Duration,
+) govtypes.Content {
 	return &ConsumerRemovalProposal{
-		Title:       title,
-		Description: description,
-		ChainId:     chainID,
-		StopTime:    stopTime,
+		Title:         title,
+		Description:   description,
+		ChainId:       chainID,
+		StopTime:      stopTime,
+		PreserveState: preserveState,
+		Cooldown:      cooldown,
 	}
 }
 
@@ -185,6 +461,10 @@ func (sccp *ConsumerRemovalProposal) ValidateBasic() error {
 	if sccp.StopTime.IsZero() {
 		return sdkerrors.Wrap(ErrInvalidConsumerRemovalProp, "spawn time cannot be zero")
 	}
+
+	if sccp.Cooldown < 0 {
+		return sdkerrors.Wrap(ErrInvalidConsumerRemovalProp, "cooldown cannot be negative")
+	}
 	return nil
 }
 
@@ -220,3 +500,48 @@ func (sp *EquivocationProposal) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewResetConsumerClientProposal creates a new reset consumer client proposal.
+func NewResetConsumerClientProposal(title, description, chainID string,
+	trustedHeight clienttypes.Height, trustedConsensusState *ibctmtypes.ConsensusState,
+) govtypes.Content {
+	return &ResetConsumerClientProposal{
+		Title:                 title,
+		Description:           description,
+		ChainId:               chainID,
+		TrustedHeight:         trustedHeight,
+		TrustedConsensusState: trustedConsensusState,
+	}
+}
+
+// ProposalRoute returns the routing key of a reset consumer client proposal.
+func (rccp *ResetConsumerClientProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reset consumer client proposal.
+func (rccp *ResetConsumerClientProposal) ProposalType() string {
+	return ProposalTypeResetConsumerClient
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (rccp *ResetConsumerClientProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rccp); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(rccp.ChainId) == "" {
+		return sdkerrors.Wrap(ErrInvalidResetConsumerClientProp, "consumer chain id must not be blank")
+	}
+
+	if rccp.TrustedHeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidResetConsumerClientProp, "trusted height cannot be zero")
+	}
+
+	if rccp.TrustedConsensusState == nil {
+		return sdkerrors.Wrap(ErrInvalidResetConsumerClientProp, "trusted consensus state cannot be empty")
+	}
+	if err := rccp.TrustedConsensusState.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidResetConsumerClientProp, "invalid trusted consensus state: %s", err)
+	}
+
+	return nil
+}
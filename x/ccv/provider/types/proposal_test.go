@@ -1,7 +1,11 @@
 package types_test
 
 import (
+	"encoding/json"
 	fmt "fmt"
+	"math"
+	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,15 +16,22 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/interchain-security/x/ccv/provider/types"
 )
 
 func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 	initialHeight := clienttypes.NewHeight(2, 3)
+	addr1 := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	addr2 := sdk.MustBech32ifyAddressBytes("consumer", []byte("initial_balance_addr"))
+	// the largest amount an sdk.Int can hold, i.e., 2^256 - 1
+	maxInt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
 
 	testCases := []struct {
 		name     string
@@ -190,6 +201,1120 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 				0),
 			false,
 		},
+		{
+			"consumer native unbonding period is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerNativeUnbondingPeriod:     -1,
+			},
+			false,
+		},
+		{
+			"genesis time offset is negative",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				GenesisTimeOffset:                 -1,
+			},
+			false,
+		},
+		{
+			"genesis time offset is too large",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				GenesisTimeOffset:                 types.MaxGenesisTimeOffset + 1,
+			},
+			false,
+		},
+		{
+			"valid proposal with genesis time offset",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				GenesisTimeOffset:                 types.MaxGenesisTimeOffset,
+			},
+			true,
+		},
+		{
+			"idempotency token is too long",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				IdempotencyToken:                  strings.Repeat("a", types.MaxIdempotencyTokenLength+1),
+			},
+			false,
+		},
+		{
+			"min provider power is negative",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				MinProviderPower:                  -1,
+			},
+			false,
+		},
+		{
+			"consumer power reduction is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerPowerReduction:            "1000",
+			},
+			true,
+		},
+		{
+			"consumer power reduction is zero",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerPowerReduction:            "0",
+			},
+			false,
+		},
+		{
+			"consumer power reduction is not an integer",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerPowerReduction:            "0.5",
+			},
+			false,
+		},
+		{
+			"power multiplier is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				PowerMultiplier:                   "1.5",
+			},
+			true,
+		},
+		{
+			"power multiplier is zero",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				PowerMultiplier:                   "0",
+			},
+			false,
+		},
+		{
+			"power multiplier is too large",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				PowerMultiplier:                   "100.1",
+			},
+			false,
+		},
+		{
+			"power multiplier is not a decimal",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				PowerMultiplier:                   "abc",
+			},
+			false,
+		},
+		{
+			"ccv connection and channel ids are valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				CcvConnectionId:                   "connection-0",
+				CcvChannelId:                      "channel-0",
+			},
+			true,
+		},
+		{
+			"ccv connection id is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				CcvConnectionId:                   "conn",
+			},
+			false,
+		},
+		{
+			"ccv channel id is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				CcvChannelId:                      "channel/0",
+			},
+			false,
+		},
+		{
+			"expected provider connection and channel ids are valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ExpectedProviderConnectionId:      "connection-1",
+				ExpectedProviderChannelId:         "channel-1",
+			},
+			true,
+		},
+		{
+			"expected provider connection id is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ExpectedProviderConnectionId:      "conn",
+			},
+			false,
+		},
+		{
+			"expected provider channel id is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ExpectedProviderChannelId:         "channel/1",
+			},
+			false,
+		},
+		{
+			"reward denom allowlist is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RewardDenomAllowlist:              []string{"ufoo", "transfer/channel-1/uatom"},
+			},
+			true,
+		},
+		{
+			"reward denom is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RewardDenomAllowlist:              []string{"1foo"},
+			},
+			false,
+		},
+		{
+			"reward denom is duplicated",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RewardDenomAllowlist:              []string{"ufoo", "ufoo"},
+			},
+			false,
+		},
+		{
+			"consumer downtime jail duration is negative",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerDowntimeJailDuration:      -time.Minute,
+			},
+			false,
+		},
+		{
+			"consumer downtime jail duration is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerDowntimeJailDuration:      time.Hour,
+			},
+			true,
+		},
+		{
+			"VSC packet timeout period is negative",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				VscPacketTimeoutPeriod:            -time.Minute,
+			},
+			false,
+		},
+		{
+			"VSC packet timeout period is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				VscPacketTimeoutPeriod:            time.Hour,
+			},
+			true,
+		},
+		{
+			"initial validator set height is too large",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialValSetHeight:               math.MaxInt64 + 1,
+			},
+			false,
+		},
+		{
+			"initial validator set height is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialValSetHeight:               100,
+			},
+			true,
+		},
+		{
+			"trust level is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				TrustLevel:                        &ibctmtypes.Fraction{Numerator: 2, Denominator: 3},
+			},
+			true,
+		},
+		{
+			"trust level is below 1/3",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				TrustLevel:                        &ibctmtypes.Fraction{Numerator: 1, Denominator: 4},
+			},
+			false,
+		},
+		{
+			"trust level is above 1",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				TrustLevel:                        &ibctmtypes.Fraction{Numerator: 3, Denominator: 2},
+			},
+			false,
+		},
+		{
+			"trust level has a zero denominator",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				TrustLevel:                        &ibctmtypes.Fraction{Numerator: 0, Denominator: 0},
+			},
+			false,
+		},
+		{
+			"initial balances are valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances: []banktypes.Balance{
+					{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))},
+					{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("ubar", 5), sdk.NewInt64Coin("ufoo", 50))},
+				},
+			},
+			true,
+		},
+		{
+			"initial balance address is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances:                   []banktypes.Balance{{Address: "invalid", Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))}},
+			},
+			false,
+		},
+		{
+			"initial balance address is duplicated",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances: []banktypes.Balance{
+					{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))},
+					{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ubar", 5))},
+				},
+			},
+			false,
+		},
+		{
+			"initial balance is empty",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances:                   []banktypes.Balance{{Address: addr1}},
+			},
+			false,
+		},
+		{
+			"initial balance is not positive",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances:                   []banktypes.Balance{{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("ufoo", 0)}}},
+			},
+			false,
+		},
+		{
+			"too many initial balances",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances:                   make([]banktypes.Balance, types.MaxInitialBalances+1),
+			},
+			false,
+		},
+		{
+			"total supply of the initial balances overflows",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				InitialBalances: []banktypes.Balance{
+					{Address: addr1, Coins: sdk.Coins{sdk.NewCoin("ufoo", maxInt)}},
+					{Address: addr2, Coins: sdk.Coins{sdk.NewCoin("ufoo", maxInt)}},
+				},
+			},
+			false,
+		},
+		{
+			"max clock drift is negative",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				MaxClockDrift:                     -time.Second,
+			},
+			false,
+		},
+		{
+			"max clock drift exceeds the limit",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				MaxClockDrift:                     types.MaxClockDriftLimit + time.Second,
+			},
+			false,
+		},
+		{
+			"max clock drift is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				MaxClockDrift:                     30 * time.Second,
+			},
+			true,
+		},
+		{
+			"consumer min gas prices are invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerMinGasPrices:              "0.01",
+			},
+			false,
+		},
+		{
+			"consumer min gas prices are valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				ConsumerMinGasPrices:              "0.01ufoo,0.5ubar",
+			},
+			true,
+		},
+		{
+			"additional genesis state is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				AdditionalGenesisState:            `{"tokenfactory":{"params":{}},"wasm":{}}`,
+			},
+			true,
+		},
+		{
+			"additional genesis state is not a JSON object",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				AdditionalGenesisState:            `["tokenfactory"]`,
+			},
+			false,
+		},
+		{
+			"additional genesis state overrides the consumer CCV module genesis",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				AdditionalGenesisState:            `{"ccvconsumer":{}}`,
+			},
+			false,
+		},
+		{
+			"additional genesis state is too long",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				AdditionalGenesisState:            `{"tokenfactory":"` + strings.Repeat("a", types.MaxAdditionalGenesisStateLength) + `"}`,
+			},
+			false,
+		},
+		{
+			"relayer allowlist is valid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RelayerAllowlist:                  []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "cosmos1wfjkcctev4eryh6lta047h6lta047h6lxlw5rj"},
+			},
+			true,
+		},
+		{
+			"relayer address is invalid",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RelayerAllowlist:                  []string{"cosmos1invalid"},
+			},
+			false,
+		},
+		{
+			"relayer address is duplicated",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chainID",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				RelayerAllowlist:                  []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", "neutron1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5ma9uum"},
+			},
+			false,
+		},
+		{
+			"standalone changeover with the revision of the chain id",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chain-2",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				StandaloneChangeover:              true,
+				StandaloneLatestHeight:            3,
+			},
+			true,
+		},
+		{
+			"standalone changeover without a standalone latest height",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chain-2",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				StandaloneChangeover:              true,
+			},
+			false,
+		},
+		{
+			"standalone changeover with an initial height below the standalone latest height",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chain-2",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				StandaloneChangeover:              true,
+				StandaloneLatestHeight:            4,
+			},
+			false,
+		},
+		{
+			"standalone changeover with a revision other than the one of the chain id",
+			&types.ConsumerAdditionProposal{
+				Title:                             "title",
+				Description:                       "description",
+				ChainId:                           "chain-1",
+				InitialHeight:                     initialHeight,
+				GenesisHash:                       []byte("gen_hash"),
+				BinaryHash:                        []byte("bin_hash"),
+				SpawnTime:                         time.Now(),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				CcvTimeoutPeriod:                  100000000000,
+				TransferTimeoutPeriod:             100000000000,
+				UnbondingPeriod:                   100000000000,
+				StandaloneChangeover:              true,
+				StandaloneLatestHeight:            3,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -238,20 +1363,46 @@ func TestMarshalConsumerAdditionProposal(t *testing.T) {
 func TestConsumerAdditionProposalString(t *testing.T) {
 	initialHeight := clienttypes.NewHeight(2, 3)
 	spawnTime := time.Now()
-	proposal := types.NewConsumerAdditionProposal(
-		"title",
-		"description",
-		"chainID",
-		initialHeight,
-		[]byte("gen_hash"),
-		[]byte("bin_hash"),
-		spawnTime,
-		"0.75",
-		10001,
-		500000,
-		100000000000,
-		10000000000,
-		100000000000)
+	proposal := &types.ConsumerAdditionProposal{
+		Title:                             "title",
+		Description:                       "description",
+		ChainId:                           "chainID",
+		InitialHeight:                     initialHeight,
+		GenesisHash:                       []byte("gen_hash"),
+		BinaryHash:                        []byte("bin_hash"),
+		SpawnTime:                         spawnTime,
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10001,
+		HistoricalEntries:                 500000,
+		CcvTimeoutPeriod:                  100000000000,
+		TransferTimeoutPeriod:             10000000000,
+		UnbondingPeriod:                   100000000000,
+		ConsumerNativeUnbondingPeriod:     1728000000000000,
+		TopN:                              50,
+		GenesisTimeOffset:                 600000000000,
+		IdempotencyToken:                  "token",
+		MinProviderPower:                  1000000,
+		ConsumerPowerReduction:            "1000",
+		PowerMultiplier:                   "1.5",
+		CcvConnectionId:                   "connection-0",
+		CcvChannelId:                      "channel-0",
+		RewardDenomAllowlist:              []string{"ufoo", "ubar"},
+		RelayerAllowlist:                  []string{"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"},
+		StandaloneChangeover:              true,
+		ConsumerDowntimeJailDuration:      time.Hour,
+		ExpectedProviderConnectionId:      "connection-1",
+		ExpectedProviderChannelId:         "channel-1",
+		MaxClockDrift:                     30 * time.Second,
+		ValidatorApprovalRequired:         true,
+		ConsumerMinGasPrices:              "0.01ufoo",
+		AdditionalGenesisState:            `{"tokenfactory":{}}`,
+		VscPacketTimeoutPeriod:            4 * time.Hour,
+		InitialValSetHeight:               7,
+		StandaloneLatestHeight:            2,
+		TrustLevel:                        &ibctmtypes.Fraction{Numerator: 1, Denominator: 2},
+		InitialBalances:                   []banktypes.Balance{{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))}},
+		UseRealCommitmentRoot:             true,
+	}
 
 	expect := fmt.Sprintf(`CreateConsumerChain Proposal
 	Title: title
@@ -266,17 +1417,157 @@ func TestConsumerAdditionProposalString(t *testing.T) {
 	HistoricalEntries: %d
 	CcvTimeoutPeriod: %d
 	TransferTimeoutPeriod: %d
-	UnbondingPeriod: %d`, initialHeight, []byte("gen_hash"), []byte("bin_hash"), spawnTime,
+	UnbondingPeriod: %d
+	SlashEnabled: %t
+	ConsumerNativeUnbondingPeriod: %d
+	TopN: %d
+	GenesisTimeOffset: %d
+	IdempotencyToken: %s
+	MinProviderPower: %d
+	ConsumerPowerReduction: %s
+	PowerMultiplier: %s
+	CcvConnectionId: %s
+	CcvChannelId: %s
+	RewardDenomAllowlist: %s
+	RelayerAllowlist: %s
+	StandaloneChangeover: %t
+	ConsumerDowntimeJailDuration: %d
+	ExpectedProviderConnectionId: %s
+	ExpectedProviderChannelId: %s
+	MaxClockDrift: %d
+	ValidatorApprovalRequired: %t
+	ConsumerMinGasPrices: %s
+	AdditionalGenesisState: %s
+	VscPacketTimeoutPeriod: %d
+	InitialValSetHeight: %d
+	StandaloneLatestHeight: %d
+	TrustLevel: %s
+	InitialBalances: %s
+	UseRealCommitmentRoot: %t`, initialHeight, []byte("gen_hash"), []byte("bin_hash"), spawnTime,
 		"0.75",
 		10001,
 		500000,
 		100000000000,
 		10000000000,
-		100000000000)
+		100000000000,
+		false,
+		1728000000000000,
+		50,
+		600000000000,
+		"token",
+		1000000,
+		"1000",
+		"1.5",
+		"connection-0",
+		"channel-0",
+		"ufoo,ubar",
+		"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		true,
+		time.Hour,
+		"connection-1",
+		"channel-1",
+		30*time.Second,
+		true,
+		"0.01ufoo",
+		`{"tokenfactory":{}}`,
+		4*time.Hour,
+		7,
+		2,
+		&ibctmtypes.Fraction{Numerator: 1, Denominator: 2},
+		[]banktypes.Balance{{Address: "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))}},
+		true)
 
 	require.Equal(t, expect, proposal.String(), "string method for ConsumerAdditionProposal returned unexpected string")
 }
 
+// TestMergeConsumerAppState tests that the genesis states of other modules are merged
+// into the consumer app_state, and that the consumer CCV module genesis is authoritative
+func TestMergeConsumerAppState(t *testing.T) {
+	ccvGenesis := json.RawMessage(`{"params":{"enabled":true}}`)
+	appState := map[string]json.RawMessage{
+		"bank":        json.RawMessage(`{"balances":[]}`),
+		"ccvconsumer": json.RawMessage(`{"params":{"enabled":false}}`),
+	}
+
+	merged, err := types.MergeConsumerAppState(appState, `{"tokenfactory":{"params":{}}}`, nil, ccvGenesis)
+	require.NoError(t, err)
+	require.Equal(t, map[string]json.RawMessage{
+		"bank":         json.RawMessage(`{"balances":[]}`),
+		"ccvconsumer":  ccvGenesis,
+		"tokenfactory": json.RawMessage(`{"params":{}}`),
+	}, merged)
+	// the given app_state is not modified
+	require.Equal(t, json.RawMessage(`{"params":{"enabled":false}}`), appState["ccvconsumer"])
+
+	merged, err = types.MergeConsumerAppState(nil, "", nil, ccvGenesis)
+	require.NoError(t, err)
+	require.Equal(t, map[string]json.RawMessage{"ccvconsumer": ccvGenesis}, merged)
+
+	_, err = types.MergeConsumerAppState(nil, `{"ccvconsumer":{}}`, nil, ccvGenesis)
+	require.Error(t, err)
+	_, err = types.MergeConsumerAppState(nil, `{"tokenfactory":`, nil, ccvGenesis)
+	require.Error(t, err)
+	_, err = types.MergeConsumerAppState(nil, `{" ":{}}`, nil, ccvGenesis)
+	require.Error(t, err)
+}
+
+// TestMergeInitialBalances tests that the initial balances are added to the bank module genesis
+// of the consumer app_state, and that the total supply is consistent with the balances
+func TestMergeInitialBalances(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ccvGenesis := json.RawMessage(`{"params":{"enabled":true}}`)
+	addr1 := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	addr2 := sdk.MustBech32ifyAddressBytes("consumer", []byte("initial_balance_addr"))
+	initialBalances := []banktypes.Balance{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100))},
+		{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin("ubar", 5), sdk.NewInt64Coin("ufoo", 50))},
+	}
+
+	// without a bank module genesis, the default one is used
+	merged, err := types.MergeConsumerAppState(nil, "", initialBalances, ccvGenesis)
+	require.NoError(t, err)
+	bankGenesis := banktypes.GenesisState{}
+	cdc.MustUnmarshalJSON(merged[banktypes.ModuleName], &bankGenesis)
+	require.Equal(t, banktypes.DefaultParams(), bankGenesis.Params)
+	require.Equal(t, initialBalances, bankGenesis.Balances)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubar", 5), sdk.NewInt64Coin("ufoo", 150)), bankGenesis.Supply)
+
+	// the initial balances are added to the bank module genesis of the additional genesis state
+	existing := banktypes.GenesisState{
+		Params:   banktypes.DefaultParams().SetSendEnabledParam("ufoo", false),
+		Balances: []banktypes.Balance{{Address: "cosmos1existing", Coins: sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10))}},
+		Supply:   sdk.NewCoins(sdk.NewInt64Coin("ufoo", 10)),
+	}
+	additional, err := json.Marshal(map[string]json.RawMessage{banktypes.ModuleName: cdc.MustMarshalJSON(&existing)})
+	require.NoError(t, err)
+	merged, err = types.MergeConsumerAppState(nil, string(additional), initialBalances, ccvGenesis)
+	require.NoError(t, err)
+	bankGenesis = banktypes.GenesisState{}
+	cdc.MustUnmarshalJSON(merged[banktypes.ModuleName], &bankGenesis)
+	require.Equal(t, existing.Params, bankGenesis.Params)
+	require.Equal(t, append(existing.Balances, initialBalances...), bankGenesis.Balances)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubar", 5), sdk.NewInt64Coin("ufoo", 160)), bankGenesis.Supply)
+
+	// without initial balances, the bank module genesis is not modified
+	merged, err = types.MergeConsumerAppState(nil, string(additional), nil, ccvGenesis)
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(cdc.MustMarshalJSON(&existing)), merged[banktypes.ModuleName])
+
+	// an account cannot be funded twice
+	existing.Balances[0].Address = addr1
+	_, err = types.MergeInitialBalances(cdc.MustMarshalJSON(&existing), initialBalances)
+	require.Error(t, err)
+
+	// the total supply of the bank module genesis must be consistent with its balances
+	existing.Balances[0].Address = "cosmos1existing"
+	existing.Supply = sdk.NewCoins(sdk.NewInt64Coin("ufoo", 11))
+	_, err = types.MergeInitialBalances(cdc.MustMarshalJSON(&existing), initialBalances)
+	require.Error(t, err)
+
+	_, err = types.MergeInitialBalances(json.RawMessage(`{"balances":`), initialBalances)
+	require.Error(t, err)
+}
+
 func TestEquivocationProposalValidateBasic(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -332,3 +1623,56 @@ func TestEquivocationProposalValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestResetConsumerClientProposalValidateBasic(t *testing.T) {
+	trustedHeight := clienttypes.NewHeight(1, 1000)
+	trustedConsensusState := ibctmtypes.NewConsensusState(time.Now(),
+		commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32))
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewResetConsumerClientProposal("title", "description", "chainID", trustedHeight, trustedConsensusState),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewResetConsumerClientProposal(" ", "description", "chainID", trustedHeight, trustedConsensusState),
+			false,
+		},
+		{
+			"chainID is blank",
+			types.NewResetConsumerClientProposal("title", "description", " ", trustedHeight, trustedConsensusState),
+			false,
+		},
+		{
+			"trusted height is zero",
+			types.NewResetConsumerClientProposal("title", "description", "chainID", clienttypes.ZeroHeight(), trustedConsensusState),
+			false,
+		},
+		{
+			"trusted consensus state is empty",
+			types.NewResetConsumerClientProposal("title", "description", "chainID", trustedHeight, nil),
+			false,
+		},
+		{
+			"trusted consensus state is invalid",
+			types.NewResetConsumerClientProposal("title", "description", "chainID", trustedHeight,
+				ibctmtypes.NewConsensusState(time.Now(), commitmenttypes.MerkleRoot{}, make([]byte, 32))),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+		} else {
+			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+		}
+	}
+}
@@ -1,13 +1,22 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// MaxValidatorUpdatesPerVSCPacket is the maximum number of validator updates carried by a single
+// VSC packet. Larger validator set changes are split across multiple packets with the same VSC ID,
+// such that the packets remain within the size limits of IBC packets.
+const MaxValidatorUpdatesPerVSCPacket = 500
+
 func NewValidatorSetChangePacketData(valUpdates []abci.ValidatorUpdate, valUpdateID uint64, slashAcks []string) ValidatorSetChangePacketData {
 	return ValidatorSetChangePacketData{
 		ValidatorUpdates: valUpdates,
@@ -27,11 +36,64 @@ func (vsc ValidatorSetChangePacketData) ValidateBasic() error {
 	return nil
 }
 
+// Split splits the validator set change into chunks of at most maxUpdates validator updates.
+// The chunks share the VSC ID and the slash acks are carried by the first chunk.
+// Every chunk carries its index and the number of chunks, such that the consumer chain applies
+// the validator set change only once its last chunk is received.
+// A validator set change with at most maxUpdates validator updates is returned as a single chunk.
+func (vsc ValidatorSetChangePacketData) Split(maxUpdates int) []ValidatorSetChangePacketChunkData {
+	if maxUpdates <= 0 || len(vsc.ValidatorUpdates) <= maxUpdates {
+		return []ValidatorSetChangePacketChunkData{{Data: vsc, ChunkIndex: 0, ChunkTotal: 1}}
+	}
+
+	total := uint64((len(vsc.ValidatorUpdates) + maxUpdates - 1) / maxUpdates)
+	chunks := []ValidatorSetChangePacketChunkData{}
+	for start := 0; start < len(vsc.ValidatorUpdates); start += maxUpdates {
+		end := start + maxUpdates
+		if end > len(vsc.ValidatorUpdates) {
+			end = len(vsc.ValidatorUpdates)
+		}
+		chunk := ValidatorSetChangePacketChunkData{
+			Data: ValidatorSetChangePacketData{
+				ValidatorUpdates: vsc.ValidatorUpdates[start:end],
+				ValsetUpdateId:   vsc.ValsetUpdateId,
+			},
+			ChunkIndex: uint64(len(chunks)),
+			ChunkTotal: total,
+		}
+		if start == 0 {
+			chunk.Data.SlashAcks = vsc.SlashAcks
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
 func (vsc ValidatorSetChangePacketData) GetBytes() []byte {
 	valUpdateBytes := ModuleCdc.MustMarshalJSON(&vsc)
 	return valUpdateBytes
 }
 
+// ValidateBasic is used for validating a chunk of the CCV packet data.
+func (chunk ValidatorSetChangePacketChunkData) ValidateBasic() error {
+	if err := chunk.Data.ValidateBasic(); err != nil {
+		return err
+	}
+	if chunk.ChunkIndex >= chunk.ChunkTotal {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "chunk index %d out of range for %d chunks", chunk.ChunkIndex, chunk.ChunkTotal)
+	}
+	return nil
+}
+
+// IsLastChunk returns true if the packet data is the last chunk of a validator set change
+func (chunk ValidatorSetChangePacketChunkData) IsLastChunk() bool {
+	return chunk.ChunkIndex+1 == chunk.ChunkTotal
+}
+
+func (chunk ValidatorSetChangePacketChunkData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&chunk)
+}
+
 func NewVSCMaturedPacketData(valUpdateID uint64) *VSCMaturedPacketData {
 	return &VSCMaturedPacketData{
 		ValsetUpdateId: valUpdateID,
@@ -76,6 +138,25 @@ func (vdt SlashPacketData) GetBytes() []byte {
 	return valDowntimeBytes
 }
 
+func NewGenesisAcceptedPacketData(genesisHash []byte) *GenesisAcceptedPacketData {
+	return &GenesisAcceptedPacketData{
+		GenesisHash: genesisHash,
+	}
+}
+
+// ValidateBasic is used for validating the GenesisAccepted packet data.
+func (gad GenesisAcceptedPacketData) ValidateBasic() error {
+	if len(gad.GenesisHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "genesis hash must be %d bytes long", sha256.Size)
+	}
+	return nil
+}
+
+func (gad GenesisAcceptedPacketData) GetBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(&gad)
+	return bytes
+}
+
 func (cp ConsumerPacketData) ValidateBasic() (err error) {
 	switch cp.Type {
 	case VscMaturedPacket:
@@ -92,6 +173,13 @@ func (cp ConsumerPacketData) ValidateBasic() (err error) {
 			return fmt.Errorf("invalid consumer packet data: SlashPacketData data cannot be empty")
 		}
 		err = slashPacket.ValidateBasic()
+	case GenesisAcceptedPacket:
+		// validate GenesisAcceptedPacket
+		genesisAcceptedPacket := cp.GetGenesisAcceptedPacketData()
+		if genesisAcceptedPacket == nil {
+			return fmt.Errorf("invalid consumer packet data: GenesisAcceptedPacketData data cannot be empty")
+		}
+		err = genesisAcceptedPacket.ValidateBasic()
 	default:
 		err = fmt.Errorf("invalid consumer packet type: %q", cp.Type)
 	}
@@ -103,3 +191,34 @@ func (cp ConsumerPacketData) GetBytes() []byte {
 	bytes := ModuleCdc.MustMarshalJSON(&cp)
 	return bytes
 }
+
+// CcvAckErrorPrefix is the prefix of the error of the error acknowledgements of rejected CCV packets
+const CcvAckErrorPrefix = "CCV ack error: "
+
+// NewCcvErrorAcknowledgement returns an error acknowledgement that carries the given reason code
+// in a machine-readable form, i.e., "CCV ack error: <code>: <name>". Like the ABCI code of
+// the error acknowledgements of IBC, the underlying error is not included, as it might not be deterministic.
+func NewCcvErrorAcknowledgement(reason CcvAckError) channeltypes.Acknowledgement {
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: fmt.Sprintf("%s%d: %s", CcvAckErrorPrefix, reason, reason),
+		},
+	}
+}
+
+// ParseCcvAckError returns the reason code carried by the error of an error acknowledgement
+// of a rejected CCV packet, and false if the error does not carry a known reason code.
+func ParseCcvAckError(ackError string) (CcvAckError, bool) {
+	if !strings.HasPrefix(ackError, CcvAckErrorPrefix) {
+		return CcvAckErrorUnspecified, false
+	}
+	code := strings.SplitN(strings.TrimPrefix(ackError, CcvAckErrorPrefix), ":", 2)[0]
+	reason, err := strconv.ParseInt(code, 10, 32)
+	if err != nil {
+		return CcvAckErrorUnspecified, false
+	}
+	if _, ok := CcvAckError_name[int32(reason)]; !ok {
+		return CcvAckErrorUnspecified, false
+	}
+	return CcvAckError(reason), true
+}
@@ -35,18 +35,22 @@ const (
 	SlashPacket ConsumerPacketDataType = 1
 	// VSCMatured packet
 	VscMaturedPacket ConsumerPacketDataType = 2
+	// GenesisAccepted packet
+	GenesisAcceptedPacket ConsumerPacketDataType = 3
 )
 
 var ConsumerPacketDataType_name = map[int32]string{
 	0: "CONSUMER_PACKET_TYPE_UNSPECIFIED",
 	1: "CONSUMER_PACKET_TYPE_SLASH",
 	2: "CONSUMER_PACKET_TYPE_VSCM",
+	3: "CONSUMER_PACKET_TYPE_GENESIS_ACCEPTED",
 }
 
 var ConsumerPacketDataType_value = map[string]int32{
-	"CONSUMER_PACKET_TYPE_UNSPECIFIED": 0,
-	"CONSUMER_PACKET_TYPE_SLASH":       1,
-	"CONSUMER_PACKET_TYPE_VSCM":        2,
+	"CONSUMER_PACKET_TYPE_UNSPECIFIED":      0,
+	"CONSUMER_PACKET_TYPE_SLASH":            1,
+	"CONSUMER_PACKET_TYPE_VSCM":             2,
+	"CONSUMER_PACKET_TYPE_GENESIS_ACCEPTED": 3,
 }
 
 func (x ConsumerPacketDataType) String() string {
@@ -57,6 +61,54 @@ func (ConsumerPacketDataType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_68bd5f3242e6f29c, []int{0}
 }
 
+// CcvAckError indicates the reason why a CCV packet was rejected with an error acknowledgement.
+type CcvAckError int32
+
+const (
+	// UNSPECIFIED reason
+	CcvAckErrorUnspecified CcvAckError = 0
+	// the packet data could not be decoded or is invalid
+	CcvAckErrorInvalidPacketData CcvAckError = 1
+	// the packet type is unknown
+	CcvAckErrorInvalidPacketType CcvAckError = 2
+	// the packet was received on a channel that is not an established CCV channel
+	CcvAckErrorUnknownChannel CcvAckError = 3
+	// the packet data could not be queued for throttling
+	CcvAckErrorThrottleQueue CcvAckError = 4
+	// the slash packet refers to a valset update id without a known infraction height
+	CcvAckErrorStaleInfraction CcvAckError = 5
+	// the slash packet has an invalid infraction type
+	CcvAckErrorInvalidInfraction CcvAckError = 6
+)
+
+var CcvAckError_name = map[int32]string{
+	0: "CCV_ACK_ERROR_UNSPECIFIED",
+	1: "CCV_ACK_ERROR_INVALID_PACKET_DATA",
+	2: "CCV_ACK_ERROR_INVALID_PACKET_TYPE",
+	3: "CCV_ACK_ERROR_UNKNOWN_CHANNEL",
+	4: "CCV_ACK_ERROR_THROTTLE_QUEUE",
+	5: "CCV_ACK_ERROR_STALE_INFRACTION",
+	6: "CCV_ACK_ERROR_INVALID_INFRACTION",
+}
+
+var CcvAckError_value = map[string]int32{
+	"CCV_ACK_ERROR_UNSPECIFIED":         0,
+	"CCV_ACK_ERROR_INVALID_PACKET_DATA": 1,
+	"CCV_ACK_ERROR_INVALID_PACKET_TYPE": 2,
+	"CCV_ACK_ERROR_UNKNOWN_CHANNEL":     3,
+	"CCV_ACK_ERROR_THROTTLE_QUEUE":      4,
+	"CCV_ACK_ERROR_STALE_INFRACTION":    5,
+	"CCV_ACK_ERROR_INVALID_INFRACTION":  6,
+}
+
+func (x CcvAckError) String() string {
+	return proto.EnumName(CcvAckError_name, int32(x))
+}
+
+func (CcvAckError) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68bd5f3242e6f29c, []int{1}
+}
+
 // This packet is sent from provider chain to consumer chain if the validator
 // set for consumer chain changes (due to new bonding/unbonding messages or
 // slashing events) A VSCMatured packet from consumer chain will be sent
@@ -281,6 +333,53 @@ func (m *SlashPacketData) GetInfraction() types1.InfractionType {
 	return types1.InfractionEmpty
 }
 
+// This packet is sent from the consumer chain to the provider chain
+// to confirm the genesis state the consumer chain started with.
+type GenesisAcceptedPacketData struct {
+	// the SHA256 hash of the canonical JSON encoding of the consumer CCV genesis state
+	GenesisHash []byte `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+}
+
+func (m *GenesisAcceptedPacketData) Reset()         { *m = GenesisAcceptedPacketData{} }
+func (m *GenesisAcceptedPacketData) String() string { return proto.CompactTextString(m) }
+func (*GenesisAcceptedPacketData) ProtoMessage()    {}
+func (*GenesisAcceptedPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68bd5f3242e6f29c, []int{4}
+}
+func (m *GenesisAcceptedPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAcceptedPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAcceptedPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAcceptedPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAcceptedPacketData.Merge(m, src)
+}
+func (m *GenesisAcceptedPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAcceptedPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAcceptedPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAcceptedPacketData proto.InternalMessageInfo
+
+func (m *GenesisAcceptedPacketData) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
 // MaturedUnbondingOps defines a list of ids corresponding to ids of matured unbonding operations.
 type MaturedUnbondingOps struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -290,7 +389,7 @@ func (m *MaturedUnbondingOps) Reset()         { *m = MaturedUnbondingOps{} }
 func (m *MaturedUnbondingOps) String() string { return proto.CompactTextString(m) }
 func (*MaturedUnbondingOps) ProtoMessage()    {}
 func (*MaturedUnbondingOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{4}
+	return fileDescriptor_68bd5f3242e6f29c, []int{5}
 }
 func (m *MaturedUnbondingOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,6 +431,7 @@ type ConsumerPacketData struct {
 	// Types that are valid to be assigned to Data:
 	//	*ConsumerPacketData_SlashPacketData
 	//	*ConsumerPacketData_VscMaturedPacketData
+	//	*ConsumerPacketData_GenesisAcceptedPacketData
 	Data isConsumerPacketData_Data `protobuf_oneof:"data"`
 }
 
@@ -339,7 +439,7 @@ func (m *ConsumerPacketData) Reset()         { *m = ConsumerPacketData{} }
 func (m *ConsumerPacketData) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketData) ProtoMessage()    {}
 func (*ConsumerPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{5}
+	return fileDescriptor_68bd5f3242e6f29c, []int{6}
 }
 func (m *ConsumerPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,9 +480,13 @@ type ConsumerPacketData_SlashPacketData struct {
 type ConsumerPacketData_VscMaturedPacketData struct {
 	VscMaturedPacketData *VSCMaturedPacketData `protobuf:"bytes,3,opt,name=vscMaturedPacketData,proto3,oneof" json:"vscMaturedPacketData,omitempty"`
 }
+type ConsumerPacketData_GenesisAcceptedPacketData struct {
+	GenesisAcceptedPacketData *GenesisAcceptedPacketData `protobuf:"bytes,4,opt,name=genesisAcceptedPacketData,proto3,oneof" json:"genesisAcceptedPacketData,omitempty"`
+}
 
-func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()      {}
-func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data() {}
+func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()           {}
+func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data()      {}
+func (*ConsumerPacketData_GenesisAcceptedPacketData) isConsumerPacketData_Data() {}
 
 func (m *ConsumerPacketData) GetData() isConsumerPacketData_Data {
 	if m != nil {
@@ -412,11 +516,19 @@ func (m *ConsumerPacketData) GetVscMaturedPacketData() *VSCMaturedPacketData {
 	return nil
 }
 
+func (m *ConsumerPacketData) GetGenesisAcceptedPacketData() *GenesisAcceptedPacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_GenesisAcceptedPacketData); ok {
+		return x.GenesisAcceptedPacketData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ConsumerPacketData_SlashPacketData)(nil),
 		(*ConsumerPacketData_VscMaturedPacketData)(nil),
+		(*ConsumerPacketData_GenesisAcceptedPacketData)(nil),
 	}
 }
 
@@ -429,7 +541,7 @@ func (m *ConsumerPacketDataList) Reset()         { *m = ConsumerPacketDataList{}
 func (m *ConsumerPacketDataList) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketDataList) ProtoMessage()    {}
 func (*ConsumerPacketDataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68bd5f3242e6f29c, []int{6}
+	return fileDescriptor_68bd5f3242e6f29c, []int{7}
 }
 func (m *ConsumerPacketDataList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,15 +577,85 @@ func (m *ConsumerPacketDataList) GetList() []ConsumerPacketData {
 	return nil
 }
 
+// This packet is sent from provider chain to consumer chain instead of
+// a ValidatorSetChangePacket if the validator set change is too large to
+// fit into a single packet. The validator set change is split into chunks
+// sent in order, and it is applied once its last chunk is received.
+// Only sent over CCV channels negotiated with version 3.
+type ValidatorSetChangePacketChunkData struct {
+	Data ValidatorSetChangePacketData `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	// the index of the chunk within the validator set change
+	ChunkIndex uint64 `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	// the number of chunks the validator set change is split into
+	ChunkTotal uint64 `protobuf:"varint,3,opt,name=chunk_total,json=chunkTotal,proto3" json:"chunk_total,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketChunkData) Reset()         { *m = ValidatorSetChangePacketChunkData{} }
+func (m *ValidatorSetChangePacketChunkData) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketChunkData) ProtoMessage()    {}
+func (*ValidatorSetChangePacketChunkData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68bd5f3242e6f29c, []int{8}
+}
+func (m *ValidatorSetChangePacketChunkData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketChunkData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketChunkData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketChunkData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketChunkData.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketChunkData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketChunkData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketChunkData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketChunkData proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketChunkData) GetData() ValidatorSetChangePacketData {
+	if m != nil {
+		return m.Data
+	}
+	return ValidatorSetChangePacketData{}
+}
+
+func (m *ValidatorSetChangePacketChunkData) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketChunkData) GetChunkTotal() uint64 {
+	if m != nil {
+		return m.ChunkTotal
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("interchain_security.ccv.v1.ConsumerPacketDataType", ConsumerPacketDataType_name, ConsumerPacketDataType_value)
+	proto.RegisterEnum("interchain_security.ccv.v1.CcvAckError", CcvAckError_name, CcvAckError_value)
 	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketData")
 	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.v1.ValidatorSetChangePackets")
 	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
 	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
+	proto.RegisterType((*GenesisAcceptedPacketData)(nil), "interchain_security.ccv.v1.GenesisAcceptedPacketData")
 	proto.RegisterType((*MaturedUnbondingOps)(nil), "interchain_security.ccv.v1.MaturedUnbondingOps")
 	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
 	proto.RegisterType((*ConsumerPacketDataList)(nil), "interchain_security.ccv.v1.ConsumerPacketDataList")
+	proto.RegisterType((*ValidatorSetChangePacketChunkData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketChunkData")
 }
 
 func init() {
@@ -481,51 +663,72 @@ func init() {
 }
 
 var fileDescriptor_68bd5f3242e6f29c = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xed, 0x60, 0x45, 0xca, 0x20, 0x25, 0x8e, 0x2f, 0xf7, 0x8a, 0xf8, 0xde, 0xeb, 0x58,
-	0x56, 0xd4, 0xa2, 0x56, 0xb5, 0x8b, 0xb3, 0xa9, 0xda, 0x4d, 0x03, 0x21, 0x02, 0xe5, 0x1f, 0xb2,
-	0x43, 0xaa, 0x76, 0x63, 0x0d, 0xf6, 0x04, 0x46, 0x80, 0x8d, 0x3c, 0x83, 0x55, 0xde, 0xa0, 0xca,
-	0xaa, 0x2f, 0x90, 0x55, 0xd5, 0x07, 0xe9, 0x2e, 0xcb, 0xec, 0x9a, 0x55, 0x54, 0x25, 0x6f, 0xd0,
-	0x27, 0xa8, 0x3c, 0x18, 0x42, 0xc0, 0x41, 0xca, 0x8a, 0xe1, 0xcc, 0x39, 0x1f, 0x7c, 0xbf, 0xf9,
-	0x74, 0xc0, 0x16, 0xf6, 0x29, 0x0a, 0xdd, 0x36, 0xc4, 0xbe, 0x43, 0x90, 0x3b, 0x08, 0x31, 0x1d,
-	0x1a, 0xae, 0x1b, 0x19, 0x51, 0x31, 0xfe, 0xd0, 0xfb, 0x61, 0x40, 0x03, 0x49, 0x4e, 0xe9, 0xd2,
-	0xe3, 0xeb, 0xa8, 0x28, 0x6f, 0xb9, 0x01, 0xe9, 0x05, 0xc4, 0x20, 0x14, 0x76, 0xb0, 0xdf, 0x32,
-	0xa2, 0x62, 0x13, 0x51, 0x58, 0x1c, 0x7f, 0x1f, 0x29, 0xc8, 0xb9, 0x56, 0xd0, 0x0a, 0xd8, 0xd1,
-	0x88, 0x4f, 0x49, 0xf5, 0x5f, 0x8a, 0x7c, 0x0f, 0x85, 0x3d, 0xec, 0x53, 0x03, 0x36, 0x5d, 0x6c,
-	0xd0, 0x61, 0x1f, 0x91, 0xd1, 0xa5, 0x76, 0xcd, 0x83, 0xff, 0x4e, 0x61, 0x17, 0x7b, 0x90, 0x06,
-	0xa1, 0x8d, 0x68, 0xb9, 0x0d, 0xfd, 0x16, 0xaa, 0x43, 0xb7, 0x83, 0xe8, 0x2e, 0xa4, 0x50, 0x0a,
-	0xc0, 0x7a, 0x34, 0xbe, 0x77, 0x06, 0x7d, 0x0f, 0x52, 0x44, 0xf2, 0xbc, 0x9a, 0x29, 0x64, 0x4d,
-	0x55, 0xbf, 0x57, 0xd6, 0x63, 0x65, 0x7d, 0xa2, 0xd4, 0x60, 0x8d, 0x25, 0xf5, 0xf2, 0x66, 0x93,
-	0xfb, 0x7d, 0xb3, 0x99, 0x1f, 0xc2, 0x5e, 0xf7, 0xad, 0x36, 0x27, 0xa4, 0x59, 0x62, 0xf4, 0x70,
-	0x84, 0x48, 0x05, 0x10, 0xd7, 0x08, 0xa2, 0x49, 0x93, 0x83, 0xbd, 0xfc, 0x92, 0xca, 0x17, 0x04,
-	0x6b, 0x75, 0x54, 0x1f, 0x35, 0xd6, 0x3c, 0xe9, 0x7f, 0x00, 0x48, 0x17, 0x92, 0xb6, 0x03, 0xdd,
-	0x0e, 0xc9, 0x67, 0xd4, 0x4c, 0x61, 0xc5, 0x5a, 0x61, 0x95, 0x1d, 0xb7, 0x43, 0xb4, 0x00, 0x6c,
-	0x3c, 0xe6, 0x8c, 0x48, 0x16, 0x10, 0xba, 0x98, 0xd0, 0xc4, 0xc9, 0x1b, 0xfd, 0x71, 0xf6, 0xfa,
-	0x22, 0x3c, 0x25, 0x21, 0x76, 0x68, 0x31, 0x2d, 0xed, 0x3d, 0xc8, 0x9d, 0xda, 0xe5, 0x43, 0x48,
-	0x07, 0x21, 0xf2, 0xa6, 0x10, 0xa6, 0x39, 0xe2, 0xd3, 0x1c, 0x69, 0x3f, 0x79, 0xb0, 0x66, 0xc7,
-	0x06, 0xa6, 0xa6, 0x2d, 0xb0, 0x32, 0x61, 0xc4, 0xc6, 0xb2, 0xa6, 0xfc, 0x38, 0xf8, 0x52, 0x3e,
-	0x41, 0x2e, 0xce, 0x20, 0xd7, 0xac, 0x7b, 0x99, 0x27, 0x30, 0xde, 0x03, 0x00, 0xfb, 0x67, 0x21,
-	0x74, 0x29, 0x0e, 0xfc, 0x7c, 0x46, 0xe5, 0x0b, 0xab, 0xe6, 0x33, 0x7d, 0x94, 0x46, 0x7d, 0x9c,
-	0xbe, 0x24, 0x8d, 0x7a, 0x6d, 0xd2, 0x79, 0x32, 0xec, 0x23, 0x6b, 0x6a, 0x52, 0x7b, 0x0e, 0xfe,
-	0x4a, 0xc0, 0x34, 0xfc, 0x66, 0xe0, 0x7b, 0xd8, 0x6f, 0x1d, 0xf7, 0x89, 0x24, 0x82, 0x0c, 0xf6,
-	0x46, 0x79, 0x12, 0xac, 0xf8, 0xa8, 0x7d, 0x5f, 0x02, 0x52, 0x39, 0xf0, 0xc9, 0xa0, 0x87, 0xc2,
-	0x29, 0x0a, 0x7b, 0x40, 0x88, 0x63, 0xcb, 0x00, 0xac, 0x9a, 0xe6, 0xa2, 0xf7, 0x9a, 0x9f, 0x66,
-	0xff, 0x86, 0xcd, 0x4b, 0x1f, 0xc0, 0x1a, 0x79, 0x08, 0x98, 0x19, 0xcf, 0x9a, 0x2f, 0x17, 0x49,
-	0xce, 0xbc, 0x49, 0x95, 0xb3, 0x66, 0x55, 0xa4, 0x33, 0x90, 0x8b, 0x88, 0x3b, 0xf7, 0xf8, 0x0c,
-	0x59, 0xd6, 0x7c, 0xbd, 0x30, 0x60, 0x29, 0xa1, 0xa9, 0x72, 0x56, 0xaa, 0x5e, 0x69, 0x19, 0x08,
-	0x1e, 0xa4, 0x50, 0x6b, 0x82, 0x7f, 0xe6, 0x8d, 0x1e, 0x60, 0x42, 0xa5, 0xea, 0x83, 0x68, 0xeb,
-	0x4f, 0x43, 0x35, 0x1d, 0xe8, 0x17, 0x3f, 0xf8, 0xb4, 0x1f, 0x89, 0x69, 0x4a, 0xef, 0x80, 0x5a,
-	0x3e, 0x3e, 0xb2, 0x1b, 0x87, 0x15, 0xcb, 0xa9, 0xef, 0x94, 0xf7, 0x2b, 0x27, 0xce, 0xc9, 0xc7,
-	0x7a, 0xc5, 0x69, 0x1c, 0xd9, 0xf5, 0x4a, 0xb9, 0xb6, 0x57, 0xab, 0xec, 0x8a, 0x9c, 0xfc, 0xf7,
-	0xf9, 0x85, 0xba, 0xde, 0xf0, 0x49, 0x1f, 0xb9, 0xf8, 0x0c, 0x8f, 0x7d, 0x48, 0x06, 0x90, 0x53,
-	0x87, 0xed, 0x83, 0x1d, 0xbb, 0x2a, 0xf2, 0xf2, 0xda, 0xf9, 0x85, 0x9a, 0x9d, 0x62, 0x2e, 0x6d,
-	0x83, 0x8d, 0xd4, 0x81, 0x98, 0x9c, 0xb8, 0x24, 0xe7, 0xce, 0x2f, 0x54, 0xf1, 0x74, 0x86, 0x96,
-	0x2c, 0x7c, 0xf9, 0xa6, 0x70, 0xa5, 0xfd, 0xcb, 0x5b, 0x85, 0xbf, 0xba, 0x55, 0xf8, 0x5f, 0xb7,
-	0x0a, 0xff, 0xf5, 0x4e, 0xe1, 0xae, 0xee, 0x14, 0xee, 0xfa, 0x4e, 0xe1, 0x3e, 0x15, 0x5b, 0x98,
-	0xb6, 0x07, 0x4d, 0xdd, 0x0d, 0x7a, 0x46, 0xb2, 0x5e, 0xef, 0x51, 0xbd, 0x9a, 0xec, 0xe9, 0xcf,
-	0x6c, 0x53, 0xb3, 0x9d, 0xd9, 0x5c, 0x66, 0x4b, 0x73, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0xaf, 0xdb, 0xaa, 0xd1, 0x05, 0x00, 0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xe2, 0x46,
+	0x14, 0xc6, 0x81, 0x46, 0xca, 0xb0, 0x4a, 0xbc, 0xd3, 0x6c, 0x04, 0x6e, 0x42, 0x1c, 0x6b, 0xdb,
+	0x46, 0x5b, 0xd5, 0x34, 0xac, 0x2a, 0xf5, 0x47, 0x5a, 0xad, 0x31, 0x4e, 0xb0, 0xc2, 0x42, 0xd6,
+	0x36, 0x59, 0xb5, 0x37, 0xd6, 0x60, 0x26, 0x60, 0x41, 0xc6, 0xc8, 0x33, 0xd0, 0xcd, 0x13, 0xb4,
+	0xe2, 0xaa, 0x0f, 0x50, 0xae, 0xfa, 0x14, 0x7d, 0x83, 0xbd, 0xdc, 0xbb, 0xee, 0xd5, 0xaa, 0x4a,
+	0xee, 0x7b, 0xd1, 0x27, 0xa8, 0x6c, 0x9c, 0x60, 0x88, 0xa1, 0x5a, 0xf5, 0x8a, 0xe1, 0xcc, 0x39,
+	0xdf, 0xf8, 0xfb, 0xce, 0x77, 0x46, 0x03, 0x1e, 0xbb, 0x84, 0x61, 0xdf, 0xe9, 0x22, 0x97, 0xd8,
+	0x14, 0x3b, 0x43, 0xdf, 0x65, 0x57, 0x45, 0xc7, 0x19, 0x15, 0x47, 0x47, 0xc1, 0x8f, 0x3c, 0xf0,
+	0x3d, 0xe6, 0x41, 0x21, 0x21, 0x4b, 0x0e, 0xb6, 0x47, 0x47, 0xc2, 0x63, 0xc7, 0xa3, 0x97, 0x1e,
+	0x2d, 0x52, 0x86, 0x7a, 0x2e, 0xe9, 0x14, 0x47, 0x47, 0x2d, 0xcc, 0xd0, 0xd1, 0xed, 0xff, 0x29,
+	0x82, 0xb0, 0xdd, 0xf1, 0x3a, 0x5e, 0xb8, 0x2c, 0x06, 0xab, 0x28, 0xfa, 0x09, 0xc3, 0xa4, 0x8d,
+	0xfd, 0x4b, 0x97, 0xb0, 0x22, 0x6a, 0x39, 0x6e, 0x91, 0x5d, 0x0d, 0x30, 0x9d, 0x6e, 0x4a, 0xef,
+	0x38, 0xb0, 0x7b, 0x8e, 0xfa, 0x6e, 0x1b, 0x31, 0xcf, 0x37, 0x31, 0x53, 0xbb, 0x88, 0x74, 0xf0,
+	0x19, 0x72, 0x7a, 0x98, 0x55, 0x10, 0x43, 0xd0, 0x03, 0x0f, 0x47, 0xb7, 0xfb, 0xf6, 0x70, 0xd0,
+	0x46, 0x0c, 0xd3, 0x1c, 0x27, 0xa6, 0x0f, 0xb3, 0x25, 0x51, 0x9e, 0x21, 0xcb, 0x01, 0xb2, 0x7c,
+	0x87, 0xd4, 0x0c, 0x13, 0xcb, 0xe2, 0x9b, 0xf7, 0xfb, 0xa9, 0x7f, 0xde, 0xef, 0xe7, 0xae, 0xd0,
+	0x65, 0xff, 0x3b, 0xe9, 0x1e, 0x90, 0x64, 0xf0, 0xa3, 0xf9, 0x12, 0x0a, 0x0f, 0x41, 0x10, 0xa3,
+	0x98, 0x45, 0x49, 0xb6, 0xdb, 0xce, 0xad, 0x89, 0xdc, 0x61, 0xc6, 0xd8, 0x9c, 0xc6, 0xa7, 0x89,
+	0x7a, 0x1b, 0xee, 0x01, 0x40, 0xfb, 0x88, 0x76, 0x6d, 0xe4, 0xf4, 0x68, 0x2e, 0x2d, 0xa6, 0x0f,
+	0x37, 0x8c, 0x8d, 0x30, 0xa2, 0x38, 0x3d, 0x2a, 0x79, 0x20, 0xbf, 0x8c, 0x19, 0x85, 0x06, 0xc8,
+	0xf4, 0x5d, 0xca, 0x22, 0x26, 0xdf, 0xc8, 0xcb, 0xb5, 0x97, 0x57, 0xc9, 0x53, 0xce, 0x04, 0x0c,
+	0x8d, 0x10, 0x4b, 0x7a, 0x0e, 0xb6, 0xcf, 0x4d, 0xf5, 0x05, 0x62, 0x43, 0x1f, 0xb7, 0x63, 0x12,
+	0x26, 0x31, 0xe2, 0x92, 0x18, 0x49, 0x7f, 0x72, 0x60, 0xcb, 0x0c, 0x08, 0xc4, 0xaa, 0x0d, 0xb0,
+	0x71, 0xa7, 0x51, 0x58, 0x96, 0x2d, 0x09, 0xcb, 0x85, 0x2f, 0xe7, 0x22, 0xc9, 0xf9, 0x05, 0xc9,
+	0x25, 0x63, 0x06, 0xf3, 0x01, 0x1a, 0x1f, 0x03, 0xe0, 0x92, 0x0b, 0x1f, 0x39, 0xcc, 0xf5, 0x48,
+	0x2e, 0x2d, 0x72, 0x87, 0x9b, 0xa5, 0xcf, 0xe4, 0xa9, 0x1b, 0xe5, 0x5b, 0xf7, 0x45, 0x6e, 0x94,
+	0xf5, 0xbb, 0x4c, 0xeb, 0x6a, 0x80, 0x8d, 0x58, 0xa5, 0xf4, 0x0c, 0xe4, 0x4f, 0x30, 0xc1, 0xd4,
+	0xa5, 0x8a, 0xe3, 0xe0, 0x01, 0x9b, 0x13, 0xe8, 0x00, 0x3c, 0xe8, 0x4c, 0x37, 0xed, 0x2e, 0xa2,
+	0xdd, 0x90, 0xe5, 0x03, 0x23, 0x1b, 0xc5, 0xaa, 0x88, 0x76, 0xa5, 0xcf, 0xc1, 0xc7, 0x91, 0xb0,
+	0x4d, 0xd2, 0xf2, 0x48, 0xdb, 0x25, 0x9d, 0xc6, 0x80, 0x42, 0x1e, 0xa4, 0xdd, 0xf6, 0xd4, 0x8f,
+	0x19, 0x23, 0x58, 0x4a, 0xbf, 0xa5, 0x01, 0x54, 0x3d, 0x42, 0x87, 0x97, 0xd8, 0x8f, 0x1d, 0x71,
+	0x0c, 0x32, 0x81, 0xed, 0x43, 0xe8, 0xcd, 0x52, 0x69, 0x55, 0xbf, 0xef, 0x57, 0x87, 0x6c, 0xc2,
+	0x7a, 0xf8, 0x0a, 0x6c, 0xd1, 0xf9, 0x06, 0x85, 0xc2, 0x65, 0x4b, 0x5f, 0xac, 0x82, 0x5c, 0xe8,
+	0x69, 0x35, 0x65, 0x2c, 0xa2, 0xc0, 0x0b, 0xb0, 0x3d, 0xa2, 0xce, 0x3d, 0xf3, 0x84, 0x92, 0x67,
+	0x4b, 0x5f, 0xad, 0x34, 0x68, 0x82, 0xe9, 0xaa, 0x29, 0x23, 0x11, 0x0f, 0x0e, 0x41, 0xbe, 0xb3,
+	0xac, 0x11, 0xb9, 0x4c, 0x78, 0xd8, 0xd7, 0xab, 0x0e, 0x5b, 0xda, 0xc5, 0x6a, 0xca, 0x58, 0x8e,
+	0x5c, 0x5e, 0x07, 0x99, 0x36, 0x62, 0x48, 0x6a, 0x81, 0x9d, 0xfb, 0xfa, 0xd6, 0x5c, 0xca, 0x60,
+	0x75, 0x6e, 0x22, 0xe5, 0x0f, 0xeb, 0xd0, 0xdc, 0x1c, 0xfe, 0xc1, 0x81, 0x83, 0x65, 0x43, 0xab,
+	0x76, 0x87, 0xa4, 0x17, 0xcd, 0x55, 0xf8, 0x45, 0xd1, 0x48, 0xfd, 0xef, 0x1b, 0x20, 0xc0, 0x82,
+	0xfb, 0x20, 0xeb, 0x04, 0x07, 0xd8, 0x2e, 0x69, 0xe3, 0xd7, 0xd1, 0x48, 0x81, 0x30, 0xa4, 0x07,
+	0x91, 0x59, 0x02, 0xf3, 0x18, 0xea, 0xe7, 0xd2, 0xb1, 0x04, 0x2b, 0x88, 0x3c, 0xf9, 0x79, 0x0d,
+	0xec, 0x24, 0x1b, 0x10, 0x7e, 0x0f, 0x44, 0xb5, 0x51, 0x37, 0x9b, 0x2f, 0x34, 0xc3, 0x3e, 0x53,
+	0xd4, 0x53, 0xcd, 0xb2, 0xad, 0x1f, 0xce, 0x34, 0xbb, 0x59, 0x37, 0xcf, 0x34, 0x55, 0x3f, 0xd6,
+	0xb5, 0x0a, 0x9f, 0x12, 0x1e, 0x8d, 0x27, 0xe2, 0xc3, 0x26, 0xa1, 0x03, 0xec, 0xb8, 0x17, 0xee,
+	0x6d, 0x0f, 0x60, 0x11, 0x08, 0x89, 0xc5, 0x66, 0x4d, 0x31, 0xab, 0x3c, 0x27, 0x6c, 0x8d, 0x27,
+	0x62, 0x36, 0x66, 0x53, 0xf8, 0x14, 0xe4, 0x13, 0x0b, 0x02, 0xb3, 0xf1, 0x6b, 0xc2, 0xf6, 0x78,
+	0x22, 0xf2, 0xe7, 0x0b, 0x06, 0x83, 0x15, 0xf0, 0x69, 0x62, 0xd1, 0x89, 0x56, 0xd7, 0x4c, 0xdd,
+	0xb4, 0x15, 0x55, 0xd5, 0xce, 0x2c, 0xad, 0xc2, 0xa7, 0x85, 0xfc, 0x78, 0x22, 0x3e, 0x4a, 0x34,
+	0x93, 0x90, 0xf9, 0xe5, 0xf7, 0x42, 0xea, 0xc9, 0xdf, 0x69, 0x90, 0x55, 0x9d, 0x91, 0xe2, 0xf4,
+	0x34, 0xdf, 0xf7, 0x7c, 0xf8, 0x2d, 0xc8, 0xab, 0xea, 0xb9, 0xad, 0xa8, 0xa7, 0xb6, 0x66, 0x18,
+	0x0d, 0x63, 0x81, 0xb7, 0x30, 0x9e, 0x88, 0x3b, 0xb1, 0xfc, 0x98, 0x04, 0xf0, 0x04, 0x1c, 0xcc,
+	0x97, 0xea, 0xf5, 0x73, 0xa5, 0xa6, 0x57, 0x6e, 0xbf, 0xb1, 0xa2, 0x58, 0x0a, 0xcf, 0x09, 0xe2,
+	0x78, 0x22, 0xee, 0xc6, 0x20, 0x74, 0x12, 0xde, 0x98, 0xb1, 0xe1, 0xf9, 0x2f, 0xa0, 0x80, 0x2c,
+	0xbf, 0xb6, 0x1a, 0x28, 0xec, 0xe5, 0x73, 0xb0, 0xb7, 0x48, 0xe6, 0xb4, 0xde, 0x78, 0x55, 0xb7,
+	0xd5, 0xaa, 0x52, 0xaf, 0x6b, 0x35, 0x3e, 0x2d, 0xec, 0x8d, 0x27, 0x62, 0x7e, 0x8e, 0x50, 0x8f,
+	0x78, 0x3f, 0x91, 0xc0, 0x7f, 0x04, 0xf7, 0xe1, 0x33, 0xb0, 0x3b, 0x8f, 0x60, 0x55, 0x8d, 0x86,
+	0x65, 0xd5, 0x34, 0xfb, 0x65, 0x53, 0x6b, 0x6a, 0x7c, 0x46, 0xd8, 0x1d, 0x4f, 0xc4, 0x5c, 0x0c,
+	0xc0, 0xea, 0xfa, 0x1e, 0x63, 0x7d, 0xfc, 0x72, 0x88, 0x87, 0x18, 0x96, 0x41, 0x61, 0xbe, 0xde,
+	0xb4, 0x94, 0x9a, 0x66, 0xeb, 0xf5, 0x63, 0x43, 0x51, 0x2d, 0xbd, 0x51, 0xe7, 0x3f, 0x12, 0x0a,
+	0xe3, 0x89, 0x28, 0xc4, 0x10, 0x4c, 0x86, 0xfa, 0x78, 0x76, 0xc9, 0xc3, 0x63, 0x20, 0x26, 0xcb,
+	0x11, 0x43, 0x59, 0x5f, 0xa6, 0xc6, 0x0c, 0x67, 0xda, 0xf0, 0xf2, 0xe9, 0x8f, 0x47, 0x1d, 0x97,
+	0x75, 0x87, 0x2d, 0xd9, 0xf1, 0x2e, 0x8b, 0xd1, 0x83, 0x67, 0x36, 0x95, 0x5f, 0xde, 0xbd, 0x9c,
+	0x5e, 0x87, 0x6f, 0xa7, 0xf0, 0x15, 0xf3, 0xe6, 0xba, 0xc0, 0xbd, 0xbd, 0x2e, 0x70, 0x7f, 0x5d,
+	0x17, 0xb8, 0x5f, 0x6f, 0x0a, 0xa9, 0xb7, 0x37, 0x85, 0xd4, 0xbb, 0x9b, 0x42, 0xaa, 0xb5, 0x1e,
+	0x3e, 0x6f, 0x9e, 0xfe, 0x3b, 0x00, 0xe4, 0xaa, 0x1a, 0xfb, 0x7b, 0x09, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -687,6 +890,36 @@ func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAcceptedPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAcceptedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAcceptedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintCcv(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MaturedUnbondingOps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -708,20 +941,20 @@ func (m *MaturedUnbondingOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA3 := make([]byte, len(m.Ids)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintCcv(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintCcv(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -807,6 +1040,27 @@ func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []by
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConsumerPacketData_GenesisAcceptedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData_GenesisAcceptedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GenesisAcceptedPacketData != nil {
+		{
+			size, err := m.GenesisAcceptedPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCcv(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ConsumerPacketDataList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -844,6 +1098,49 @@ func (m *ConsumerPacketDataList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorSetChangePacketChunkData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePacketChunkData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePacketChunkData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChunkTotal != 0 {
+		i = encodeVarintCcv(dAtA, i, uint64(m.ChunkTotal))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintCcv(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCcv(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCcv(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCcv(v)
 	base := offset
@@ -923,6 +1220,19 @@ func (m *SlashPacketData) Size() (n int) {
 	return n
 }
 
+func (m *GenesisAcceptedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovCcv(uint64(l))
+	}
+	return n
+}
+
 func (m *MaturedUnbondingOps) Size() (n int) {
 	if m == nil {
 		return 0
@@ -978,6 +1288,18 @@ func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
 	}
 	return n
 }
+func (m *ConsumerPacketData_GenesisAcceptedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisAcceptedPacketData != nil {
+		l = m.GenesisAcceptedPacketData.Size()
+		n += 1 + l + sovCcv(uint64(l))
+	}
+	return n
+}
 func (m *ConsumerPacketDataList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -993,6 +1315,23 @@ func (m *ConsumerPacketDataList) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorSetChangePacketChunkData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovCcv(uint64(l))
+	if m.ChunkIndex != 0 {
+		n += 1 + sovCcv(uint64(m.ChunkIndex))
+	}
+	if m.ChunkTotal != 0 {
+		n += 1 + sovCcv(uint64(m.ChunkTotal))
+	}
+	return n
+}
+
 func sovCcv(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1408,6 +1747,90 @@ func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisAcceptedPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCcv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAcceptedPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAcceptedPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCcv
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCcv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MaturedUnbondingOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1652,6 +2075,41 @@ func (m *ConsumerPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Data = &ConsumerPacketData_VscMaturedPacketData{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisAcceptedPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCcv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GenesisAcceptedPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_GenesisAcceptedPacketData{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCcv(dAtA[iNdEx:])
@@ -1757,6 +2215,127 @@ func (m *ConsumerPacketDataList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSetChangePacketChunkData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCcv
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketChunkData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketChunkData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCcv
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkTotal", wireType)
+			}
+			m.ChunkTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCcv
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCcv(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCcv
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCcv(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
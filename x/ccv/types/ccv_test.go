@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -90,3 +92,98 @@ func TestMarshalPacketData(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, vpd, recovered, "unmarshaled packet data does not equal original value")
 }
+
+// TestValidatorSetChangePacketDataBytes tests that the VSC packet data sent by the provider
+// only carries the fields known to consumer chains running an earlier version, as they reject unknown fields,
+// and that the VSC packet data and its chunks cannot be mistaken for each other
+func TestValidatorSetChangePacketDataBytes(t *testing.T) {
+	pk, err := cryptocodec.ToTmProtoPublicKey(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	vsc := types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk, Power: 30}}, 1, []string{"slashAck"})
+
+	// the VSC packet data as decoded by consumer chains running an earlier version
+	var legacy struct {
+		ValidatorUpdates []json.RawMessage `json:"validator_updates"`
+		ValsetUpdateId   string            `json:"valset_update_id"`
+		SlashAcks        []string          `json:"slash_acks"`
+	}
+	unmarshalLegacy := func(bz []byte) error {
+		dec := json.NewDecoder(bytes.NewReader(bz))
+		dec.DisallowUnknownFields()
+		return dec.Decode(&legacy)
+	}
+	require.NoError(t, unmarshalLegacy(vsc.GetBytes()))
+	require.Equal(t, "1", legacy.ValsetUpdateId)
+	require.Len(t, legacy.ValidatorUpdates, 1)
+	require.Equal(t, []string{"slashAck"}, legacy.SlashAcks)
+
+	chunk := vsc.Split(1)[0]
+	require.Error(t, unmarshalLegacy(chunk.GetBytes()))
+
+	var data types.ValidatorSetChangePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(chunk.GetBytes(), &data))
+	var chunkData types.ValidatorSetChangePacketChunkData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(vsc.GetBytes(), &chunkData))
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(chunk.GetBytes(), &chunkData))
+	require.Equal(t, chunk, chunkData)
+}
+
+// TestSplitValidatorSetChangePacketData tests that validator set changes are split into chunks
+// of at most the given number of validator updates with the same VSC ID
+func TestSplitValidatorSetChangePacketData(t *testing.T) {
+	updates := []abci.ValidatorUpdate{}
+	for i := 0; i < 5; i++ {
+		pk, err := cryptocodec.ToTmProtoPublicKey(ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		updates = append(updates, abci.ValidatorUpdate{PubKey: pk, Power: int64(i + 1)})
+	}
+	vsc := types.NewValidatorSetChangePacketData(updates, 7, []string{"slashAck"})
+
+	// validator set changes within the limit are not split
+	single := []types.ValidatorSetChangePacketChunkData{{Data: vsc, ChunkIndex: 0, ChunkTotal: 1}}
+	require.Equal(t, single, vsc.Split(5))
+	require.Equal(t, single, vsc.Split(0))
+	require.True(t, single[0].IsLastChunk())
+
+	chunks := vsc.Split(2)
+	require.Equal(t, []types.ValidatorSetChangePacketChunkData{
+		{Data: types.ValidatorSetChangePacketData{ValidatorUpdates: updates[0:2], ValsetUpdateId: 7, SlashAcks: []string{"slashAck"}}, ChunkIndex: 0, ChunkTotal: 3},
+		{Data: types.ValidatorSetChangePacketData{ValidatorUpdates: updates[2:4], ValsetUpdateId: 7}, ChunkIndex: 1, ChunkTotal: 3},
+		{Data: types.ValidatorSetChangePacketData{ValidatorUpdates: updates[4:5], ValsetUpdateId: 7}, ChunkIndex: 2, ChunkTotal: 3},
+	}, chunks)
+	for i, chunk := range chunks {
+		require.NoError(t, chunk.ValidateBasic())
+		require.Equal(t, i == len(chunks)-1, chunk.IsLastChunk())
+	}
+
+	// the chunk index must be lower than the number of chunks
+	chunks[2].ChunkIndex = 3
+	require.Error(t, chunks[2].ValidateBasic())
+}
+
+// TestCcvErrorAcknowledgement tests that the reason code of an error acknowledgement
+// of a rejected CCV packet can be parsed from the acknowledgement error
+func TestCcvErrorAcknowledgement(t *testing.T) {
+	for code := range types.CcvAckError_name {
+		reason := types.CcvAckError(code)
+		ack := types.NewCcvErrorAcknowledgement(reason)
+		require.False(t, ack.Success())
+
+		parsed, ok := types.ParseCcvAckError(ack.GetError())
+		require.True(t, ok)
+		require.Equal(t, reason, parsed)
+	}
+
+	ack := types.NewCcvErrorAcknowledgement(types.CcvAckErrorStaleInfraction)
+	require.Equal(t, "CCV ack error: 5: CCV_ACK_ERROR_STALE_INFRACTION", ack.GetError())
+
+	// errors without a known reason code
+	for _, ackError := range []string{
+		"ABCI code: 1: error handling packet: see events for details",
+		"CCV ack error: foo",
+		"CCV ack error: 99: unknown",
+	} {
+		_, ok := types.ParseCcvAckError(ackError)
+		require.False(t, ok, ackError)
+	}
+}
@@ -25,4 +25,5 @@ var (
 	ErrClientNotFound           = sdkerrors.Register(ModuleName, 18, "client not found")
 	ErrDuplicateConsumerChain   = sdkerrors.Register(ModuleName, 19, "consumer chain already exists")
 	ErrConsumerChainNotFound    = sdkerrors.Register(ModuleName, 20, "consumer chain not found")
+	ErrInvalidValidatorPower    = sdkerrors.Register(ModuleName, 21, "invalid validator power")
 )
@@ -2,17 +2,38 @@ package types
 
 // CCV events
 const (
-	EventTypeTimeout                  = "timeout"
-	EventTypePacket                   = "ccv_packet"
-	EventTypeChannelEstablished       = "channel_established"
-	EventTypeFeeTransferChannelOpened = "fee_transfer_channel_opened"
-	EventTypeConsumerClientCreated    = "consumer_client_created"
-	EventTypeAssignConsumerKey        = "assign_consumer_key"
+	EventTypeTimeout                         = "timeout"
+	EventTypePacket                          = "ccv_packet"
+	EventTypeChannelEstablished              = "channel_established"
+	EventTypeFeeTransferChannelOpened        = "fee_transfer_channel_opened"
+	EventTypeConsumerClientCreated           = "consumer_client_created"
+	EventTypeAssignConsumerKey               = "assign_consumer_key"
+	EventTypeRequeueConsumerAdditionProposal = "requeue_consumer_addition_proposal"
+	EventTypePurgeConsumerState              = "purge_consumer_state"
+	EventTypeConsumerSpawnDeferred           = "consumer_spawn_deferred"
+	EventTypeConsumerSpawnSummary            = "consumer_spawn_summary"
+	EventTypeResetConsumerClient             = "reset_consumer_client"
+	EventTypePurgeAllPendingClients          = "purge_all_pending_clients"
+	EventTypeUpdateParams                    = "update_params"
+	EventTypeConsumerGenesisCommitted        = "consumer_genesis_committed"
+	EventTypeGenesisHashChanged              = "genesis_hash_changed"
+	EventTypeUpdateRewardDenomAllowlist      = "update_reward_denom_allowlist"
+	EventTypeForceSpawnPendingClient         = "force_spawn_pending_client"
+	EventTypeConsumerChainStopping           = "consumer_chain_stopping"
+	EventTypeForceMatureVscPackets           = "force_mature_vsc_packets"
+	EventTypeApproveConsumerValidator        = "approve_consumer_validator"
+	EventTypeConsumerStateInconsistency      = "consumer_state_inconsistency"
+	EventTypeRenameConsumerChain             = "rename_consumer_chain"
+	EventTypePendingSpawnParamsChanged       = "pending_spawn_params_changed"
 
 	EventTypeExecuteConsumerChainSlash = "execute_consumer_chain_slash"
 	EventTypeFeeDistribution           = "fee_distribution"
 	EventTypeConsumerSlashRequest      = "consumer_slash_request"
 	EventTypeVSCMatured                = "vsc_matured"
+	EventTypeConsumerGenesisMismatch   = "consumer_genesis_mismatch"
+	EventTypeDropStaleSlashPacket      = "drop_stale_slash_packet"
+	EventTypeVSCSendFailure            = "vsc_send_failure"
+	EventTypeVSCPacketTimeout          = "vsc_packet_timeout"
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
@@ -30,8 +51,29 @@ const (
 	AttributeInitializationTimeout    = "initialization_timeout"
 	AttributeTrustingPeriod           = "trusting_period"
 	AttributeUnbondingPeriod          = "unbonding_period"
+	AttributeOldUnbondingPeriod       = "old_unbonding_period"
 	AttributeProviderValidatorAddress = "provider_validator_address"
 	AttributeConsumerConsensusPubKey  = "consumer_consensus_pub_key"
+	AttributeExpectedGenesisHash      = "expected_genesis_hash"
+	AttributeAcceptedGenesisHash      = "accepted_genesis_hash"
+	AttributeSpawned                  = "spawned"
+	AttributeFailed                   = "failed"
+	AttributeRemaining                = "remaining"
+	AttributeChannelID                = "channel_id"
+	AttributeConsecutiveFailures      = "consecutive_failures"
+	AttributeRetryHeight              = "retry_height"
+	AttributeError                    = "error"
+	AttributeSubstituteClientID       = "substitute_client_id"
+	AttributePurged                   = "purged"
+	AttributeCommittedGenesisHash     = "committed_genesis_hash"
+	AttributeGenesisHash              = "genesis_hash"
+	AttributeRewardDenoms             = "reward_denoms"
+	AttributeReleasedUnbondingOps     = "released_unbonding_ops"
+	AttributeClientID                 = "client_id"
+	AttributeInconsistency            = "inconsistency"
+	AttributeRemoved                  = "removed"
+	AttributeOldChainID               = "old_chain_id"
+	AttributeNewChainID               = "new_chain_id"
 
 	AttributeDistributionCurrentHeight = "current_distribution_height"
 	AttributeDistributionNextHeight    = "next_distribution_height"
@@ -6,7 +6,18 @@ const (
 
 	// Version defines the current version the IBC CCV provider and consumer
 	// module supports
-	Version = "1"
+	Version = "3"
+
+	// Version2 defines the previous CCV version, which the provider and consumer
+	// modules still accept during the channel handshake. Over CCV channels negotiated
+	// with Version2, the provider does not split VSC packets into chunks.
+	Version2 = "2"
+
+	// Version1 defines the initial CCV version, which the provider and consumer
+	// modules still accept during the channel handshake, so that CCV channels
+	// can be opened with chains that were not upgraded. CCV channels negotiated
+	// with Version1 do not support GenesisAccepted packets nor VSC packet chunks.
+	Version1 = "1"
 
 	// ProviderPortID is the default port id the provider CCV module binds to
 	ProviderPortID = "provider"
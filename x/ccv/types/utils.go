@@ -1,18 +1,21 @@
 package types
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
 	"time"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmprotocrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci.ValidatorUpdate {
@@ -44,6 +47,64 @@ func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci
 	return out
 }
 
+// ValidateValidatorUpdatesPower checks that the powers of the given validator updates
+// can be safely applied by Tendermint. If initialValSet is true, the updates are
+// considered to be a full validator set (e.g., the initial validator set of a consumer
+// chain), i.e., all powers must be positive and the total power must not exceed
+// tmtypes.MaxTotalVotingPower. Otherwise, the updates are considered to be changes
+// to a validator set, i.e., zero powers (removals) are allowed, but every power
+// must be non-negative and must not exceed tmtypes.MaxTotalVotingPower.
+func ValidateValidatorUpdatesPower(updates []abci.ValidatorUpdate, initialValSet bool) error {
+	totalPower := int64(0)
+	for _, update := range updates {
+		if update.Power < 0 || (initialValSet && update.Power == 0) {
+			return sdkerrors.Wrapf(ErrInvalidValidatorPower,
+				"power %d of validator %s", update.Power, update.PubKey.String())
+		}
+		if update.Power > tmtypes.MaxTotalVotingPower {
+			return sdkerrors.Wrapf(ErrInvalidValidatorPower,
+				"power %d of validator %s exceeds the maximum of %d", update.Power, update.PubKey.String(), tmtypes.MaxTotalVotingPower)
+		}
+		if initialValSet {
+			// both values are at most tmtypes.MaxTotalVotingPower, so the sum cannot overflow
+			totalPower += update.Power
+			if totalPower > tmtypes.MaxTotalVotingPower {
+				return sdkerrors.Wrapf(ErrInvalidValidatorPower,
+					"total power exceeds the maximum of %d", tmtypes.MaxTotalVotingPower)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseRelayerAllowlist parses the bech32 addresses of the relayers a consumer chain accepts
+// CCV packets from. Since the addresses are addresses on the consumer chain, any bech32 prefix
+// is accepted. The addresses must be unique, i.e., encode different account addresses.
+func ParseRelayerAllowlist(relayers []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, 0, len(relayers))
+	seen := map[string]bool{}
+	for _, relayer := range relayers {
+		_, bz, err := bech32.DecodeAndConvert(relayer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relayer address %s: %w", relayer, err)
+		}
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return nil, fmt.Errorf("invalid relayer address %s: %w", relayer, err)
+		}
+		if seen[string(bz)] {
+			return nil, fmt.Errorf("duplicate relayer address %s", relayer)
+		}
+		seen[string(bz)] = true
+		addrs = append(addrs, bz)
+	}
+	return addrs, nil
+}
+
+// IsSupportedVersion returns true if a CCV channel can be negotiated with the given version
+func IsSupportedVersion(version string) bool {
+	return version == Version || version == Version2 || version == Version1
+}
+
 // TMCryptoPublicKeyToConsAddr converts a TM public key to an SDK public key
 // and returns the associated consensus address
 func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/interchain-security/x/ccv/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestAccumulateChanges(t *testing.T) {
@@ -85,3 +86,74 @@ func TestAccumulateChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateValidatorUpdatesPower(t *testing.T) {
+	testKeys := ibcsimapp.CreateTestPubKeys(2)
+	tmPubKey0, _ := cryptocodec.ToTmProtoPublicKey(testKeys[0])
+	tmPubKey1, _ := cryptocodec.ToTmProtoPublicKey(testKeys[1])
+
+	testCases := []struct {
+		name          string
+		updates       []abci.ValidatorUpdate
+		initialValSet bool
+		expPass       bool
+	}{
+		{
+			"valid initial valset",
+			[]abci.ValidatorUpdate{{PubKey: tmPubKey0, Power: 10}, {PubKey: tmPubKey1, Power: 20}},
+			true,
+			true,
+		},
+		{
+			"valid updates with removal",
+			[]abci.ValidatorUpdate{{PubKey: tmPubKey0, Power: 0}, {PubKey: tmPubKey1, Power: 20}},
+			false,
+			true,
+		},
+		{
+			"zero power in initial valset",
+			[]abci.ValidatorUpdate{{PubKey: tmPubKey0, Power: 0}, {PubKey: tmPubKey1, Power: 20}},
+			true,
+			false,
+		},
+		{
+			"negative power",
+			[]abci.ValidatorUpdate{{PubKey: tmPubKey0, Power: -1}},
+			false,
+			false,
+		},
+		{
+			"power exceeds maximum",
+			[]abci.ValidatorUpdate{{PubKey: tmPubKey0, Power: tmtypes.MaxTotalVotingPower + 1}},
+			false,
+			false,
+		},
+		{
+			"powers at maximum in updates",
+			[]abci.ValidatorUpdate{
+				{PubKey: tmPubKey0, Power: tmtypes.MaxTotalVotingPower},
+				{PubKey: tmPubKey1, Power: tmtypes.MaxTotalVotingPower},
+			},
+			false,
+			true,
+		},
+		{
+			"total power exceeds maximum in initial valset",
+			[]abci.ValidatorUpdate{
+				{PubKey: tmPubKey0, Power: tmtypes.MaxTotalVotingPower},
+				{PubKey: tmPubKey1, Power: 1},
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateValidatorUpdatesPower(tc.updates, tc.initialValSet)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidValidatorPower, tc.name)
+		}
+	}
+}